@@ -13,12 +13,19 @@ export default [
   { text: 'kyma alpha diagnose cluster', link: './gen-docs/kyma_alpha_diagnose_cluster' },
   { text: 'kyma alpha diagnose istio', link: './gen-docs/kyma_alpha_diagnose_istio' },
   { text: 'kyma alpha diagnose logs', link: './gen-docs/kyma_alpha_diagnose_logs' },
+  { text: 'kyma alpha extension', link: './gen-docs/kyma_alpha_extension' },
+  { text: 'kyma alpha extension describe', link: './gen-docs/kyma_alpha_extension_describe' },
+  { text: 'kyma alpha extension list', link: './gen-docs/kyma_alpha_extension_list' },
+  { text: 'kyma alpha extension validate', link: './gen-docs/kyma_alpha_extension_validate' },
   { text: 'kyma alpha hana', link: './gen-docs/kyma_alpha_hana' },
   { text: 'kyma alpha hana map', link: './gen-docs/kyma_alpha_hana_map' },
   { text: 'kyma alpha kubeconfig', link: './gen-docs/kyma_alpha_kubeconfig' },
   { text: 'kyma alpha kubeconfig generate', link: './gen-docs/kyma_alpha_kubeconfig_generate' },
   { text: 'kyma alpha module', link: './gen-docs/kyma_alpha_module' },
   { text: 'kyma alpha module catalog', link: './gen-docs/kyma_alpha_module_catalog' },
+  { text: 'kyma alpha module index', link: './gen-docs/kyma_alpha_module_index' },
+  { text: 'kyma alpha module init', link: './gen-docs/kyma_alpha_module_init' },
+  { text: 'kyma alpha module lint', link: './gen-docs/kyma_alpha_module_lint' },
   { text: 'kyma alpha module list', link: './gen-docs/kyma_alpha_module_list' },
   { text: 'kyma alpha module pull', link: './gen-docs/kyma_alpha_module_pull' },
   { text: 'kyma alpha provision', link: './gen-docs/kyma_alpha_provision' },
@@ -33,11 +40,23 @@ export default [
   { text: 'kyma help', link: './gen-docs/kyma_help' },
   { text: 'kyma module', link: './gen-docs/kyma_module' },
   { text: 'kyma module add', link: './gen-docs/kyma_module_add' },
+  { text: 'kyma module apply', link: './gen-docs/kyma_module_apply' },
   { text: 'kyma module catalog', link: './gen-docs/kyma_module_catalog' },
+  { text: 'kyma module channel', link: './gen-docs/kyma_module_channel' },
+  { text: 'kyma module channel get', link: './gen-docs/kyma_module_channel_get' },
+  { text: 'kyma module channel set', link: './gen-docs/kyma_module_channel_set' },
+  { text: 'kyma module config', link: './gen-docs/kyma_module_config' },
+  { text: 'kyma module config edit', link: './gen-docs/kyma_module_config_edit' },
+  { text: 'kyma module config get', link: './gen-docs/kyma_module_config_get' },
+  { text: 'kyma module config set', link: './gen-docs/kyma_module_config_set' },
   { text: 'kyma module delete', link: './gen-docs/kyma_module_delete' },
+  { text: 'kyma module describe', link: './gen-docs/kyma_module_describe' },
   { text: 'kyma module list', link: './gen-docs/kyma_module_list' },
   { text: 'kyma module manage', link: './gen-docs/kyma_module_manage' },
   { text: 'kyma module pull', link: './gen-docs/kyma_module_pull' },
+  { text: 'kyma module resources', link: './gen-docs/kyma_module_resources' },
+  { text: 'kyma module restore', link: './gen-docs/kyma_module_restore' },
   { text: 'kyma module unmanage', link: './gen-docs/kyma_module_unmanage' },
+  { text: 'kyma module upgrade', link: './gen-docs/kyma_module_upgrade' },
   { text: 'kyma version', link: './gen-docs/kyma_version' },
 ];
@@ -4,16 +4,18 @@ import (
 	"github.com/kyma-project/cli.v3/internal/cmd/alpha/authorize"
 	"github.com/kyma-project/cli.v3/internal/cmd/alpha/dashboard"
 	"github.com/kyma-project/cli.v3/internal/cmd/alpha/diagnose"
+	"github.com/kyma-project/cli.v3/internal/cmd/alpha/extension"
 	"github.com/kyma-project/cli.v3/internal/cmd/alpha/hana"
 	"github.com/kyma-project/cli.v3/internal/cmd/alpha/kubeconfig"
 	"github.com/kyma-project/cli.v3/internal/cmd/alpha/module"
 	"github.com/kyma-project/cli.v3/internal/cmd/alpha/provision"
 	"github.com/kyma-project/cli.v3/internal/cmd/alpha/referenceinstance"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/extensions"
 	"github.com/spf13/cobra"
 )
 
-func NewAlphaCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+func NewAlphaCMD(kymaConfig *cmdcommon.KymaConfig, extensionsBuilder *extensions.Builder) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                   "alpha <command> [flags]",
 		Short:                 "Groups command prototypes for which the API may still change",
@@ -29,6 +31,7 @@ func NewAlphaCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 	cmd.AddCommand(diagnose.NewDiagnoseCMD(kymaConfig))
 	cmd.AddCommand(module.NewModuleCMD(kymaConfig))
 	cmd.AddCommand(dashboard.NewDashboardCMD(kymaConfig))
+	cmd.AddCommand(extension.NewExtensionCMD(extensionsBuilder))
 
 	return cmd
 }
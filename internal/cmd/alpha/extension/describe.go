@@ -0,0 +1,56 @@
+package extension
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/extensions"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+)
+
+type describeConfig struct {
+	builder *extensions.Builder
+	name    string
+}
+
+func NewDescribeCMD(builder *extensions.Builder) *cobra.Command {
+	cfg := describeConfig{
+		builder: builder,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "describe <name> [flags]",
+		Short: "Describes the extension",
+		Long: `Use this command to print the command tree of the loaded extension.
+The tree contains commands with their actions, and flags and arguments with config paths used to access their values in config templates.`,
+		Example: `  # Describe the function extension
+  kyma alpha extension describe function`,
+		Args: cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.name = args[0]
+			clierror.Check(runDescribe(&cfg))
+		},
+	}
+
+	return cmd
+}
+
+func runDescribe(cfg *describeConfig) clierror.Error {
+	summaries := cfg.builder.GetSummary(cfg.name)
+	if len(summaries) == 0 {
+		return clierror.New(
+			fmt.Sprintf("extension '%s' not found", cfg.name),
+			"to list loaded extensions, call the `kyma alpha extension list` command",
+		)
+	}
+
+	for i, summary := range summaries {
+		if i > 0 {
+			out.Msgln("")
+		}
+		extensions.RenderSummaryTree(summary)
+	}
+
+	return nil
+}
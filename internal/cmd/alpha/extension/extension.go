@@ -0,0 +1,21 @@
+package extension
+
+import (
+	"github.com/kyma-project/cli.v3/internal/extensions"
+	"github.com/spf13/cobra"
+)
+
+func NewExtensionCMD(builder *extensions.Builder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                   "extension <command> [flags]",
+		Short:                 "Manages CLI extensions",
+		Long:                  "Use this command to list, validate, and describe extensions loaded from local files and cluster ConfigMaps.",
+		DisableFlagsInUseLine: true,
+	}
+
+	cmd.AddCommand(NewListCMD(builder))
+	cmd.AddCommand(NewValidateCMD(builder))
+	cmd.AddCommand(NewDescribeCMD(builder))
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package extension
+
+import (
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/extensions"
+	"github.com/spf13/cobra"
+)
+
+type listConfig struct {
+	builder      *extensions.Builder
+	outputFormat types.Format
+}
+
+func NewListCMD(builder *extensions.Builder) *cobra.Command {
+	cfg := listConfig{
+		builder: builder,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list [flags]",
+		Short: "Lists loaded extensions",
+		Long: `Use this command to list extensions loaded from local files and cluster ConfigMaps.
+The list contains the source of every extension, actions used by its commands, its subcommands, and the validation status:
+  - Valid - commands are built and available
+  - Invalid - commands can't be built, see the error below the list
+  - Unsupported - commands are built, but some actions are not supported by this CLI version
+  - Overridden - commands are not built because the local extension with the same name takes precedence`,
+		Example: `  # List extensions
+  kyma alpha extension list
+
+  # List extensions in the JSON format
+  kyma alpha extension list -o json`,
+		Args: cobra.NoArgs,
+		Run: func(_ *cobra.Command, _ []string) {
+			clierror.Check(runList(&cfg))
+		},
+	}
+
+	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format (Possible values: json, yaml)")
+
+	return cmd
+}
+
+func runList(cfg *listConfig) clierror.Error {
+	err := extensions.RenderSummaries(cfg.builder.Summaries(), cfg.outputFormat)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to render extensions"))
+	}
+
+	return nil
+}
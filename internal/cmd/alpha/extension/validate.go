@@ -0,0 +1,59 @@
+package extension
+
+import (
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/extensions"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+)
+
+type validateConfig struct {
+	builder *extensions.Builder
+	file    string
+}
+
+func NewValidateCMD(builder *extensions.Builder) *cobra.Command {
+	cfg := validateConfig{
+		builder: builder,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "validate [flags]",
+		Short: "Validates the extension file",
+		Long: `Use this command to validate the extension before you share it or apply it to a cluster.
+The file can contain the extension definition or the ConfigMap with the extension definition under the kyma-commands.yaml key.
+This command checks that:
+  - the extension definition is valid
+  - all actions used by commands are supported by this CLI version
+  - config templates of commands can be rendered with sample flag and argument values`,
+		Example: `  # Validate the extension file
+  kyma alpha extension validate -f ./my-extension.yaml`,
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			clierror.Check(flags.Validate(cmd.Flags(),
+				flags.MarkRequired("file"),
+			))
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			clierror.Check(runValidate(&cfg))
+		},
+	}
+
+	cmd.Flags().StringVarP(&cfg.file, "file", "f", "", "Path to the extension file")
+
+	return cmd
+}
+
+func runValidate(cfg *validateConfig) clierror.Error {
+	err := cfg.builder.ValidateExtensionFile(cfg.file)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(
+			"extension is not valid",
+			"fix the listed errors and run the command again",
+		))
+	}
+
+	out.Msgfln("The extension from the %s file is valid", cfg.file)
+	return nil
+}
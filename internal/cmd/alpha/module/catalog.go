@@ -39,6 +39,9 @@ func NewCatalogV2CMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
   # List available community modules from multiple remote URLs
   kyma alpha module catalog --remote-url=https://example.com/modules1.json,https://example.com/modules2.json
 
+  # List available community modules from a local catalog directory and an OCI artifact
+  kyma alpha module catalog --remote-url=./community-modules,oci://registry.local/kyma/community-modules:latest
+
   # Output catalog as JSON
   kyma alpha module catalog -o json
 
@@ -56,7 +59,7 @@ func NewCatalogV2CMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 
 	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format (Possible values: table, json, yaml)")
 	cmd.Flags().BoolVar(&cfg.remote, "remote", false, "Fetch modules from the official repository")
-	cmd.Flags().StringSliceVar(&cfg.remoteUrl, "remote-url", []string{}, "List of URLs to files that contain ModuleTemplate CRs (community modules)")
+	cmd.Flags().StringSliceVar(&cfg.remoteUrl, "remote-url", []string{}, "List of catalog sources that contain ModuleTemplate CRs (community modules): http(s) or file:// URLs, local files or directories, or oci:// references")
 
 	return cmd
 }
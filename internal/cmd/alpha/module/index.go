@@ -0,0 +1,94 @@
+package module
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/modulesv2"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+)
+
+type indexConfig struct {
+	*cmdcommon.KymaConfig
+
+	manifestsDir  string
+	indexFile     string
+	merge         bool
+	skipLinkCheck bool
+}
+
+func NewIndexV2CMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := indexConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "index <manifests-dir> [flags]",
+		Short: "Builds a community modules catalog from ModuleTemplate manifests",
+		Long: `Builds a community modules catalog from ModuleTemplate manifests.
+
+This command reads ModuleTemplate manifests (JSON or YAML) from the directory, validates them,
+and writes them to the index file in the format consumed by the '--remote-url' flag of the
+'kyma alpha module catalog' and 'kyma alpha module pull' commands.
+
+Every ModuleTemplate must define the module name, a semantic version, the manager, and the rawManifest resource.
+Resource links must be resolvable and the rawManifest resource must contain the manager.
+The index file is not written if any ModuleTemplate is invalid.`,
+		Example: `  # Build the all-modules.json index from manifests in the ./module-templates directory
+  kyma alpha module index ./module-templates
+
+  # Add new module versions to an existing index
+  kyma alpha module index ./module-templates --index-file ./catalog/all-modules.json --merge
+
+  # Validate only required fields without downloading resources
+  kyma alpha module index ./module-templates --skip-link-check`,
+		Args: cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.manifestsDir = args[0]
+			clierror.Check(indexModules(&cfg))
+		},
+	}
+
+	cmd.Flags().StringVar(&cfg.indexFile, "index-file", "all-modules.json", "Path to the index file")
+	cmd.Flags().BoolVar(&cfg.merge, "merge", false, "Merges module versions into the existing index file instead of overwriting it")
+	cmd.Flags().BoolVar(&cfg.skipLinkCheck, "skip-link-check", false, "Skips downloading resources to check links and the manager")
+
+	return cmd
+}
+
+func indexModules(cfg *indexConfig) clierror.Error {
+	moduleOperations := modulesv2.NewModuleOperations(cfg.KymaConfig)
+
+	indexService, err := moduleOperations.Index()
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to execute the index command"))
+	}
+
+	result, err := indexService.Run(dtos.NewIndexConfig(cfg.manifestsDir, cfg.indexFile, cfg.merge, cfg.skipLinkCheck))
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to build the module index"))
+	}
+
+	if len(result.ValidationErrors) > 0 {
+		for _, validationError := range result.ValidationErrors {
+			out.Errfln("%s", validationError)
+		}
+		return clierror.New(
+			fmt.Sprintf("module templates are not valid (errors: %d)", len(result.ValidationErrors)),
+			"fix the listed errors and run the command again",
+		)
+	}
+
+	for _, added := range result.Added {
+		out.Msgfln("added %s", added)
+	}
+	for _, updated := range result.Updated {
+		out.Msgfln("updated %s", updated)
+	}
+	out.Msgfln("Index saved to %s (modules: %d)", result.IndexPath, result.Total)
+
+	return nil
+}
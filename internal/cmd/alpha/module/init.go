@@ -0,0 +1,208 @@
+package module
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/prompt"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	"github.com/kyma-project/cli.v3/internal/modulesv2"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type initConfig struct {
+	*cmdcommon.KymaConfig
+
+	manifestPath  string
+	sampleCRPath  string
+	moduleName    string
+	version       string
+	resourceLink  string
+	managerName   string
+	repository    string
+	documentation string
+	outputFile    string
+	interactive   bool
+
+	versionChanged bool
+}
+
+func NewInitV2CMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := initConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "init [flags]",
+		Short: "Generates a ModuleTemplate for a community module",
+		Long: `Generates a ModuleTemplate for a community module from the module manifest and a sample custom resource.
+
+The manager Deployment, the resources defined by CRDs from the manifest, and the default configuration
+are inferred from the provided files. The generated ModuleTemplate can be pulled with the 'kyma alpha module pull'
+command or added to the community modules catalog with the 'kyma alpha module index' command.`,
+		Example: `  # Generate the ModuleTemplate for the module with the manager and CRDs in the manifest.yaml file
+  kyma alpha module init --manifest ./manifest.yaml --sample-cr ./default-cr.yaml --name my-module --version 1.0.0
+
+  # Generate the ModuleTemplate with the manifest hosted on GitHub and save it to the file
+  kyma alpha module init --manifest ./manifest.yaml --sample-cr ./default-cr.yaml --name my-module --version 1.0.0 \
+    --resource-link https://github.com/my-org/my-module/releases/download/1.0.0/manifest.yaml \
+    --output-file ./module-templates/my-module-1.0.0.yaml
+
+  # Answer questions about missing values
+  kyma alpha module init --manifest ./manifest.yaml --sample-cr ./default-cr.yaml --interactive`,
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			clierror.Check(flags.Validate(cmd.Flags(),
+				flags.MarkRequired("manifest"),
+			))
+		},
+		Run: func(cmd *cobra.Command, _ []string) {
+			cfg.versionChanged = cmd.Flags().Changed("version")
+			clierror.Check(initModuleTemplate(&cfg))
+		},
+	}
+
+	cmd.Flags().StringVar(&cfg.manifestPath, "manifest", "", "Path to the manifest with the module manager and CRDs")
+	cmd.Flags().StringVar(&cfg.sampleCRPath, "sample-cr", "", "Path to the custom resource used as the default module configuration")
+	cmd.Flags().StringVar(&cfg.moduleName, "name", "", "Name of the module (defaults to the lowercase kind of the sample custom resource)")
+	cmd.Flags().StringVar(&cfg.version, "version", "0.1.0", "Version of the module")
+	cmd.Flags().StringVar(&cfg.resourceLink, "resource-link", "", "Link to the hosted manifest (defaults to the file:// link to the manifest)")
+	cmd.Flags().StringVar(&cfg.managerName, "manager", "", "Name of the manager deployment if the manifest contains more than one deployment")
+	cmd.Flags().StringVar(&cfg.repository, "repository", "", "Link to the module repository")
+	cmd.Flags().StringVar(&cfg.documentation, "documentation", "", "Link to the module documentation")
+	cmd.Flags().StringVar(&cfg.outputFile, "output-file", "", "Path to the file where the ModuleTemplate is saved (prints the ModuleTemplate by default)")
+	cmd.Flags().BoolVar(&cfg.interactive, "interactive", false, "Prompts for values that are not provided with flags")
+
+	return cmd
+}
+
+func initModuleTemplate(cfg *initConfig) clierror.Error {
+	moduleOperations := modulesv2.NewModuleOperations(cfg.KymaConfig)
+
+	initService, err := moduleOperations.Init()
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to execute the init command"))
+	}
+
+	initConfigDto, clierr := newInitConfigDto(cfg)
+	if clierr != nil {
+		return clierr
+	}
+
+	if cfg.interactive {
+		clierr = promptInitConfig(cfg, initService, initConfigDto)
+		if clierr != nil {
+			return clierr
+		}
+	}
+
+	moduleTemplate, err := initService.Run(initConfigDto)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to generate the module template", "use the --interactive flag to provide missing values"))
+	}
+
+	moduleTemplateYAML, err := modulesv2.ModuleTemplateToYAML(moduleTemplate)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to marshal the module template"))
+	}
+
+	if cfg.outputFile == "" {
+		out.Msg(string(moduleTemplateYAML))
+		return nil
+	}
+
+	err = os.WriteFile(cfg.outputFile, moduleTemplateYAML, 0644)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to write the module template"))
+	}
+
+	out.Msgfln("Module template %s saved to %s", moduleTemplate.GetName(), cfg.outputFile)
+	return nil
+}
+
+func newInitConfigDto(cfg *initConfig) (*dtos.InitConfig, clierror.Error) {
+	moduleResources, err := resources.ReadFromFiles(cfg.manifestPath)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to read the module manifest"))
+	}
+
+	var sampleCR *unstructured.Unstructured
+	if cfg.sampleCRPath != "" {
+		crs, err := resources.ReadFromFiles(cfg.sampleCRPath)
+		if err != nil {
+			return nil, clierror.Wrap(err, clierror.New("failed to read the sample custom resource"))
+		}
+		if len(crs) != 1 {
+			return nil, clierror.New(fmt.Sprintf("expected one sample custom resource, found %d", len(crs)))
+		}
+		sampleCR = &crs[0]
+	}
+
+	resourceLink := cfg.resourceLink
+	if resourceLink == "" {
+		absPath, err := filepath.Abs(cfg.manifestPath)
+		if err != nil {
+			return nil, clierror.Wrap(err, clierror.New("failed to resolve the manifest path"))
+		}
+		resourceLink = "file://" + absPath
+	}
+
+	moduleName := cfg.moduleName
+	if moduleName == "" && sampleCR != nil {
+		moduleName = strings.ToLower(sampleCR.GetKind())
+	}
+
+	return &dtos.InitConfig{
+		ModuleName:    moduleName,
+		Version:       cfg.version,
+		ResourceLink:  resourceLink,
+		ManagerName:   cfg.managerName,
+		Repository:    cfg.repository,
+		Documentation: cfg.documentation,
+		Resources:     moduleResources,
+		SampleCR:      sampleCR,
+	}, nil
+}
+
+// promptInitConfig asks for values that can't be inferred from the provided files
+func promptInitConfig(cfg *initConfig, initService *modulesv2.InitService, initConfigDto *dtos.InitConfig) clierror.Error {
+	var err error
+	if cfg.moduleName == "" {
+		initConfigDto.ModuleName, err = prompt.NewString("Module name", initConfigDto.ModuleName).Prompt()
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to read the module name"))
+		}
+	}
+
+	if !cfg.versionChanged {
+		initConfigDto.Version, err = prompt.NewString("Module version", initConfigDto.Version).Prompt()
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to read the module version"))
+		}
+	}
+
+	if cfg.resourceLink == "" {
+		initConfigDto.ResourceLink, err = prompt.NewString("Link to the hosted manifest", initConfigDto.ResourceLink).Prompt()
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to read the link to the manifest"))
+		}
+	}
+
+	candidates := initService.ManagerCandidates(initConfigDto.Resources)
+	if cfg.managerName == "" && len(candidates) > 1 {
+		initConfigDto.ManagerName, err = prompt.NewOneOfStringList("Deployments found in the manifest:", "Type the name of the manager deployment: ", candidates).Prompt()
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to read the manager deployment"))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package module
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/modulesv2"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+type lintConfig struct {
+	*cmdcommon.KymaConfig
+
+	source        string
+	skipLinkCheck bool
+	outputFormat  modulesv2.LintFormat
+}
+
+func NewLintV2CMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := lintConfig{
+		KymaConfig:   kymaConfig,
+		outputFormat: modulesv2.LintTextFormat,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "lint <path|namespace/name> [flags]",
+		Short: "Checks ModuleTemplates for common mistakes",
+		Long: `Checks ModuleTemplates for common mistakes that break module commands.
+
+The ModuleTemplate can be read from a file, a directory, a URL, or from the cluster using the <namespace>/<name> format.
+This command checks that:
+  - the manager is defined and can be found in the rawManifest resource
+  - the module version can be read from the manager label or the manager container image
+  - custom state checks use valid field paths, known module states, and map a value to the Ready state
+  - the default custom resource in spec.data defines apiVersion and kind
+  - the module doesn't use namespaces reserved for Kyma
+
+The command fails if any error is found. Use the SARIF output to upload results to code scanning tools in CI.`,
+		Example: `  # Check ModuleTemplates from the ./module-templates directory
+  kyma alpha module lint ./module-templates
+
+  # Check the ModuleTemplate from the cluster
+  kyma alpha module lint default/my-module-1.0.0
+
+  # Check required fields without downloading resources and print results in the SARIF format
+  kyma alpha module lint ./module-templates --skip-link-check --output sarif`,
+		Args: cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.source = args[0]
+			clierror.Check(lintModules(&cfg))
+		},
+	}
+
+	cmd.Flags().BoolVar(&cfg.skipLinkCheck, "skip-link-check", false, "Skips downloading the rawManifest resource to check the manager")
+	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format (Possible values: text, sarif)")
+
+	return cmd
+}
+
+func lintModules(cfg *lintConfig) clierror.Error {
+	moduleOperations := modulesv2.NewModuleOperations(cfg.KymaConfig)
+
+	lintService, err := moduleOperations.Lint()
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to execute the lint command"))
+	}
+
+	result, err := lintService.Run(cfg.Ctx, newLintConfigDto(cfg))
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to lint module templates"))
+	}
+
+	err = modulesv2.RenderLint(result, cfg.outputFormat, out.Default)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to render lint results"))
+	}
+
+	if errors := result.Errors(); errors > 0 {
+		return clierror.New(
+			fmt.Sprintf("module templates are not valid (errors: %d)", errors),
+			"fix the listed errors and run the command again",
+		)
+	}
+
+	return nil
+}
+
+// newLintConfigDto treats the source as the cluster module template only if it's not a local path or URL
+func newLintConfigDto(cfg *lintConfig) *dtos.LintConfig {
+	if _, err := os.Stat(cfg.source); err == nil || strings.Contains(cfg.source, "://") {
+		return dtos.NewLintConfig(cfg.source, cfg.skipLinkCheck)
+	}
+
+	namespace, name, found := strings.Cut(cfg.source, "/")
+	if found && len(validation.IsDNS1123Label(namespace)) == 0 && len(validation.IsDNS1123Subdomain(name)) == 0 {
+		return dtos.NewClusterLintConfig(namespace, name, cfg.skipLinkCheck)
+	}
+
+	return dtos.NewLintConfig(cfg.source, cfg.skipLinkCheck)
+}
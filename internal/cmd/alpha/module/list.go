@@ -12,6 +12,7 @@ import (
 type listConfig struct {
 	*cmdcommon.KymaConfig
 	outputFormat types.Format
+	allContexts  bool
 }
 
 func NewListV2CMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
@@ -22,13 +23,25 @@ func NewListV2CMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list [flags]",
 		Short: "Lists installed modules",
-		Long:  `Use this command to list the installed Kyma modules.`,
+		Long: `Use this command to list the installed Kyma modules.
+
+To compare modules across multiple clusters, pass the '--context' flag more than once or use the '--all-contexts' flag.
+Modules are then displayed as rows and clusters as columns, with the version drift marked for every module.`,
+		Example: `  # List modules installed in the cluster
+  kyma alpha module list
+
+  # Compare modules installed in the dev, stage, and prod clusters
+  kyma alpha module list --context dev --context stage --context prod
+
+  # Compare modules installed in clusters of all kubeconfig contexts in the YAML format
+  kyma alpha module list --all-contexts --output yaml`,
 		Run: func(_ *cobra.Command, _ []string) {
 			clierror.Check(listModulesV2(&cfg))
 		},
 	}
 
 	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format (Possible values: table, json, yaml)")
+	cmd.Flags().BoolVar(&cfg.allContexts, "all-contexts", false, "Lists modules from clusters of all kubeconfig contexts")
 
 	return cmd
 }
@@ -41,6 +54,15 @@ func listModulesV2(cfg *listConfig) clierror.Error {
 		return clierror.Wrap(err, clierror.New("failed to execute the list command"))
 	}
 
+	kubeContexts, err := cmdcommon.GetFleetContexts(cfg.allContexts)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to read kubeconfig contexts"))
+	}
+
+	if len(kubeContexts) > 0 {
+		return listFleetModulesV2(cfg, listService, kubeContexts)
+	}
+
 	results, communityResults, err := listService.Run(cfg.Ctx)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to list installed modules"))
@@ -53,3 +75,26 @@ func listModulesV2(cfg *listConfig) clierror.Error {
 
 	return nil
 }
+
+func listFleetModulesV2(cfg *listConfig, listService *modulesv2.ListService, kubeContexts []string) clierror.Error {
+	result := listService.RunForContexts(cfg.Ctx, kubeContexts)
+
+	failed := 0
+	for _, contextResult := range result.Contexts {
+		if contextResult.Err != nil {
+			out.Errfln("failed to list installed modules from the %s context: %v", contextResult.Context, contextResult.Err)
+			failed++
+		}
+	}
+
+	err := modulesv2.RenderFleetList(result, cfg.outputFormat, out.Default)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to render module list"))
+	}
+
+	if failed == len(result.Contexts) {
+		return clierror.New("failed to list installed modules from all contexts", "make sure that kubeconfig contexts are correct")
+	}
+
+	return nil
+}
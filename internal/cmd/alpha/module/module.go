@@ -16,6 +16,9 @@ func NewModuleCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 	cmd.AddCommand(NewCatalogV2CMD(kymaConfig))
 	cmd.AddCommand(NewPullV2CMD(kymaConfig))
 	cmd.AddCommand(NewListV2CMD(kymaConfig))
+	cmd.AddCommand(NewIndexV2CMD(kymaConfig))
+	cmd.AddCommand(NewInitV2CMD(kymaConfig))
+	cmd.AddCommand(NewLintV2CMD(kymaConfig))
 
 	return cmd
 }
@@ -16,11 +16,12 @@ import (
 type pullConfig struct {
 	*cmdcommon.KymaConfig
 
-	moduleName string
-	namespace  string
-	remote     string
-	version    string
-	force      bool
+	moduleName      string
+	namespace       string
+	remote          string
+	version         string
+	force           bool
+	resourceMirrors map[string]string
 }
 
 func NewPullV2CMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
@@ -46,7 +47,11 @@ must be pulled before they can be installed using the 'kyma module add' command.
   kyma alpha module pull community-module-name --version v1.0.0 --namespace module-namespace
 
   # Pull a module from a custom remote repository URL
-  kyma alpha module pull community-module-name --remote-url https://example.com/modules.json`,
+  kyma alpha module pull community-module-name --remote-url https://example.com/modules.json
+
+  # Pull a module from a local catalog directory and download its resources from a local mirror
+  kyma alpha module pull community-module-name --remote-url ./community-modules \
+    --resource-mirror https://github.com/=file:///opt/mirror/github/`,
 		Args: cobra.ExactArgs(1),
 		PreRun: func(cmd *cobra.Command, args []string) {
 			clierror.Check(precheck.EnsureCRD(kymaConfig, cfg.force))
@@ -58,9 +63,10 @@ must be pulled before they can be installed using the 'kyma module add' command.
 	}
 
 	cmd.Flags().StringVarP(&cfg.namespace, "namespace", "n", "default", "Destination namespace where the module is stored")
-	cmd.Flags().StringVar(&cfg.remote, "remote-url", "", "URL to a file that contains ModuleTemplate CRs (defaults to official community catalog)")
+	cmd.Flags().StringVar(&cfg.remote, "remote-url", "", "Catalog source that contains ModuleTemplate CRs: http(s) or file:// URL, local file or directory, or oci:// reference (defaults to official community catalog)")
 	cmd.Flags().StringVarP(&cfg.version, "version", "v", "", "Specifies the version of the community module to pull")
 	cmd.Flags().BoolVar(&cfg.force, "force", false, "Forces application of the module template, overwriting if it already exists")
+	cmd.Flags().StringToStringVar(&cfg.resourceMirrors, "resource-mirror", map[string]string{}, "Rewrites resource links of the module with the given prefix to the local mirror, in format <url-prefix>=<mirror-prefix>")
 
 	return cmd
 }
@@ -72,7 +78,7 @@ func pull(cfg *pullConfig) clierror.Error {
 		return clierror.Wrap(err, clierror.New("failed to execute the pull command"))
 	}
 
-	pullConfigDto := dtos.NewPullConfig(cfg.moduleName, cfg.namespace, cfg.remote, cfg.version)
+	pullConfigDto := dtos.NewPullConfig(cfg.moduleName, cfg.namespace, cfg.remote, cfg.version, cfg.resourceMirrors)
 
 	if shouldAbort, clierr := confirmOverwriteIfNeeded(cfg, pullOperation, pullConfigDto); clierr != nil || shouldAbort {
 		return clierr
@@ -30,15 +30,15 @@ func NewKymaCMD() *cobra.Command {
 	cmd.PersistentFlags().BoolP("help", "h", false, "Help for the command")
 
 	kymaConfig := cmdcommon.NewKymaConfig()
+	builder := extensions.NewBuilder(kymaConfig)
 
-	alpha := alpha.NewAlphaCMD(kymaConfig)
+	alpha := alpha.NewAlphaCMD(kymaConfig, builder)
 
 	cmd.AddCommand(alpha)
 	cmd.AddCommand(version.NewCmd())
 	cmd.AddCommand(module.NewModuleCMD(kymaConfig))
 	cmd.AddCommand(app.NewAppCMD(kymaConfig))
 
-	builder := extensions.NewBuilder(kymaConfig)
 	builder.Build(cmd, extensionstypes.ActionsMap{
 		"function_init":         actions.NewFunctionInit(kymaConfig),
 		"registry_config":       actions.NewRegistryConfig(kymaConfig),
@@ -46,10 +46,14 @@ func NewKymaCMD() *cobra.Command {
 		"resource_create":       actions.NewResourceCreate(kymaConfig),
 		"resource_get":          actions.NewResourceGet(kymaConfig),
 		"resource_delete":       actions.NewResourceDelete(kymaConfig),
+		"resource_wait":         actions.NewResourceWait(kymaConfig),
+		"resource_watch":        actions.NewResourceWatch(kymaConfig),
 		"resource_explain":      actions.NewResourceExplain(),
 		"call_files_to_save":    actions.NewCallFilesToSaveAction(kymaConfig),
 	})
 	builder.DisplayWarnings()
+	// the cache of cluster extensions is revalidated in the background until the command finishes
+	cobra.OnFinalize(builder.WaitForCacheRevalidation)
 
 	return cmd
 }
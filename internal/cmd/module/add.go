@@ -3,14 +3,17 @@ package module
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/kyma-project/cli.v3/internal/clierror"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon/prompt"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
 	"github.com/kyma-project/cli.v3/internal/flags"
 	"github.com/kyma-project/cli.v3/internal/kube"
 	"github.com/kyma-project/cli.v3/internal/kube/resources"
 	"github.com/kyma-project/cli.v3/internal/modules"
+	"github.com/kyma-project/cli.v3/internal/modules/integrity"
 	"github.com/kyma-project/cli.v3/internal/modules/repo"
 	"github.com/kyma-project/cli.v3/internal/modulesv2/precheck"
 	"github.com/kyma-project/cli.v3/internal/out"
@@ -20,13 +23,23 @@ import (
 
 type addConfig struct {
 	*cmdcommon.KymaConfig
-	module      string
-	modulePath  string
-	channel     string
-	crPath      string
-	defaultCR   bool
-	autoApprove bool
-	community   bool
+	module        string
+	modulePath    string
+	channel       string
+	crPath        string
+	defaultCR     bool
+	autoApprove   bool
+	community     bool
+	wait          bool
+	timeout       time.Duration
+	skipPreflight bool
+
+	dryRun       types.DryRun
+	outputFormat types.Format
+
+	requireVerified     bool
+	publicKeys          []string
+	allowLocalResources bool
 }
 
 func newAddCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
@@ -37,17 +50,42 @@ func newAddCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add <module> [flags]",
 		Short: "Add a module",
-		Long:  "Use this command to add a module.",
+		Long: `Use this command to add a module.
+
+Modules can declare modules they require and modules they conflict with using the ` + "`" + modules.RequiresAnnotation + "`" + ` and ` + "`" + modules.ConflictsAnnotation + "`" + ` annotations of their ModuleTemplates.
+Before the module is added, the command checks conflicts with installed modules and offers to add missing dependencies first.
+
+The command also runs preflight checks that estimate whether the cluster can run the module. They compare resource requests of the module workloads with free capacity of nodes,
+the Kubernetes server version with the minimal version from the ` + "`" + modules.MinKubernetesVersionAnnotation + "`" + ` annotation, and check that APIs used by the module and CRDs listed in the ` + "`" + modules.RequiredCRDsAnnotation + "`" + ` annotation exist.
+The module is not added if any check fails. To skip the checks, use the --skip-preflight flag.`,
 		Example: `  # Add the Keda module with the default CR
   kyma module add keda --default-config-cr
 
   # Add the Keda module with a custom CR from a file
   kyma module add keda --config-cr-path ./keda-cr.yaml
 
+  # Add the Keda module, add its missing dependencies without asking, and wait until all are ready
+  kyma module add keda --default-config-cr --auto-approve --wait
+
+  # Add the Keda module without checking if the cluster can run it
+  kyma module add keda --default-config-cr --skip-preflight
+
+  # Add the Keda module and wait until it's ready
+  kyma module add keda --default-config-cr --wait --timeout 10m
+
+  # Print changes of the Kyma CR and the default CR without applying them
+  kyma module add keda --default-config-cr --dry-run
+
+  # Validate changes on the server and print them in the JSON format
+  kyma module add keda --default-config-cr --dry-run=server -o json
+
   ## Add a community module with a default CR and auto-approve the SLA
   #  passed argument must be in the format <namespace>/<module-template-name>
   #  the module must be pulled from the catalog first using the 'kyma module pull' command
-  kyma module add my-namespace/my-module-template-name --default-config-cr --auto-approve`,
+  kyma module add my-namespace/my-module-template-name --default-config-cr --auto-approve
+
+  # Add a community module only if all its resources match their digests or signatures
+  kyma module add my-namespace/my-module-template-name --require-verified --public-key ./cosign.pub`,
 
 		Args: cobra.ExactArgs(1),
 		PreRun: func(cmd *cobra.Command, _ []string) {
@@ -55,6 +93,9 @@ func newAddCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 				flags.MarkMutuallyExclusive("cr-path", "default-cr", "config-cr-path", "default-config-cr"),
 				flags.MarkUnsupported("community", "the --community flag is no longer supported - community modules need to be pulled first using 'kyma module pull' command, then installed"),
 				flags.MarkUnsupported("origin", "the --origin flag is no longer supported - use commands argument instead"),
+				flags.MarkPrerequisites("timeout", "wait"),
+				flags.MarkPrerequisites("output", "dry-run"),
+				flags.MarkExclusive("dry-run", "wait"),
 			))
 			clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
 		},
@@ -71,11 +112,20 @@ func newAddCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 	cmd.Flags().BoolVar(&cfg.defaultCR, "default-cr", false, "Deploys the module with the default CR")
 	_ = cmd.Flags().MarkHidden("default-cr")
 	cmd.Flags().BoolVar(&cfg.defaultCR, "default-config-cr", false, "Deploys the module with default configuration (alias: --default-cr)")
-	cmd.Flags().BoolVar(&cfg.autoApprove, "auto-approve", false, "Automatically approve community module installation")
+	cmd.Flags().BoolVar(&cfg.autoApprove, "auto-approve", false, "Automatically approve community module installation and adding of missing dependencies")
 	cmd.Flags().StringVar(&cfg.modulePath, "origin", "", "Specifies the source of the module (kyma or custom name)")
 	_ = cmd.Flags().MarkHidden("origin")
 	cmd.Flags().BoolVar(&cfg.community, "community", false, "Install a community module (no official support, no binding SLA)")
 	_ = cmd.Flags().MarkHidden("community")
+	cmd.Flags().BoolVar(&cfg.wait, "wait", false, "Waits until the module is ready")
+	cmd.Flags().DurationVar(&cfg.timeout, "timeout", modules.DefaultWaitTimeout, "Maximum time to wait for the module (used with --wait)")
+	cmd.Flags().BoolVar(&cfg.skipPreflight, "skip-preflight", false, "Skips checks of cluster capacity, Kubernetes version, and APIs required by the module")
+	cmd.Flags().Var(&cfg.dryRun, "dry-run", "Prints changes without applying them (Possible values: client, server)")
+	cmd.Flags().Lookup("dry-run").NoOptDefVal = string(types.ClientDryRun)
+	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format of printed changes (Possible values: json, yaml; used with --dry-run)")
+	cmd.Flags().BoolVar(&cfg.requireVerified, "require-verified", false, "Refuses to install community module resources without a digest or a signature")
+	cmd.Flags().StringSliceVar(&cfg.publicKeys, "public-key", []string{}, "Paths to PEM-encoded public keys used to check signatures of community module resources")
+	cmd.Flags().BoolVar(&cfg.allowLocalResources, "allow-local-resources", false, "Allows reading community module resources linked as local files (use only for modules pulled from trusted local catalogs or mirrors)")
 
 	return cmd
 }
@@ -120,16 +170,52 @@ func loadCustomCRs(crPath string) ([]unstructured.Unstructured, clierror.Error)
 }
 
 func addModule(cfg *addConfig, client *kube.Client, crs ...unstructured.Unstructured) clierror.Error {
+	if cfg.modulePath != "" {
+		verifier, err := integrity.NewVerifier(cfg.requireVerified, cfg.publicKeys...)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to load public keys"))
+		}
+		if cfg.allowLocalResources {
+			// module templates from the cluster can't read local files unless the user trusts them
+			verifier = verifier.WithLocalLinks()
+		}
+
+		// dry run and preflight read module resources through the repo, so they are verified as well
+		return installCommunityModule(cfg, client, repo.NewModuleTemplatesRepoWithVerifier(*client, verifier), verifier, crs...)
+	}
+
 	moduleTemplatesRepo := repo.NewModuleTemplatesRepo(*client)
 
-	if cfg.modulePath != "" {
-		return installCommunityModule(cfg, client, moduleTemplatesRepo, crs...)
+	if cfg.dryRun.Enabled() {
+		return modules.DryRunEnable(cfg.Ctx, *client, moduleTemplatesRepo, cfg.module, cfg.channel, cfg.defaultCR, newDryRunOptions(cfg.dryRun, cfg.outputFormat), crs...)
+	}
+
+	plan, clierr := modules.PlanCoreModuleInstall(cfg.Ctx, *client, moduleTemplatesRepo, cfg.module, cfg.channel)
+	if clierr != nil {
+		return clierr
+	}
+
+	if !cfg.skipPreflight {
+		clierr = modules.CoreModulePreflight(cfg.Ctx, *client, moduleTemplatesRepo, cfg.module, cfg.channel, plan.Dependencies...)
+		if clierr != nil {
+			return clierr
+		}
 	}
 
-	return modules.Enable(cfg.Ctx, *client, moduleTemplatesRepo, cfg.module, cfg.channel, cfg.defaultCR, crs...)
+	proceed, clierr := addDependencies(cfg, client, moduleTemplatesRepo, plan)
+	if clierr != nil || !proceed {
+		return clierr
+	}
+
+	clierr = modules.Enable(cfg.Ctx, *client, moduleTemplatesRepo, cfg.module, cfg.channel, cfg.defaultCR, modules.ModuleCRTimeout(cfg.wait, cfg.timeout), crs...)
+	if clierr != nil || !cfg.wait {
+		return clierr
+	}
+
+	return modules.WaitForModuleState(cfg.Ctx, *client, cfg.module, cfg.timeout, "Ready", "Warning")
 }
 
-func installCommunityModule(cfg *addConfig, client *kube.Client, repo repo.ModuleTemplatesRepository, crs ...unstructured.Unstructured) clierror.Error {
+func installCommunityModule(cfg *addConfig, client *kube.Client, repo repo.ModuleTemplatesRepository, verifier *integrity.Verifier, crs ...unstructured.Unstructured) clierror.Error {
 	namespace, moduleTemplateName, err := validateOrigin(cfg.modulePath)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to identify the community module"))
@@ -140,6 +226,29 @@ func installCommunityModule(cfg *addConfig, client *kube.Client, repo repo.Modul
 		return clierror.Wrap(err, clierror.New("failed to install the community module"))
 	}
 
+	installData := modules.InstallCommunityModuleData{
+		CommunityModuleTemplate: communityModuleTemplate,
+		IsDefaultCRApplicable:   cfg.defaultCR,
+		CustomResources:         crs,
+		Verifier:                verifier,
+	}
+
+	if cfg.dryRun.Enabled() {
+		return modules.DryRunInstall(cfg.Ctx, *client, repo, installData, newDryRunOptions(cfg.dryRun, cfg.outputFormat))
+	}
+
+	plan, clierr := modules.PlanCommunityModuleInstall(cfg.Ctx, *client, repo, communityModuleTemplate)
+	if clierr != nil {
+		return clierr
+	}
+
+	if !cfg.skipPreflight {
+		clierr = modules.CommunityModulePreflight(cfg.Ctx, *client, repo, communityModuleTemplate, plan.Dependencies...)
+		if clierr != nil {
+			return clierr
+		}
+	}
+
 	out.Msgln("Warning:\n  You are about to install a community module.\n" +
 		"  Community modules are not officially supported and come with no binding Service Level Agreement (SLA).\n" +
 		"  There is no guarantee of support, maintenance, or compatibility.")
@@ -155,13 +264,69 @@ func installCommunityModule(cfg *addConfig, client *kube.Client, repo repo.Modul
 		}
 	}
 
-	installData := modules.InstallCommunityModuleData{
-		CommunityModuleTemplate: communityModuleTemplate,
-		IsDefaultCRApplicable:   cfg.defaultCR,
-		CustomResources:         crs,
+	proceed, clierr := addDependencies(cfg, client, repo, plan)
+	if clierr != nil || !proceed {
+		return clierr
 	}
 
-	return modules.Install(cfg.Ctx, *client, repo, installData)
+	clierr = modules.Install(cfg.Ctx, *client, repo, installData)
+	if clierr != nil || !cfg.wait {
+		return clierr
+	}
+
+	return modules.WaitForCommunityModuleReadiness(cfg.Ctx, *client, communityModuleTemplate, cfg.timeout)
+}
+
+// addDependencies adds missing dependencies from the plan with the default configuration
+// it returns false if the user doesn't want to add them
+func addDependencies(cfg *addConfig, client *kube.Client, repo repo.ModuleTemplatesRepository, plan *modules.InstallPlan) (bool, clierror.Error) {
+	if len(plan.Conflicts) > 0 {
+		return false, clierror.New(
+			fmt.Sprintf("failed to add the %s module because of conflicts: %s", plan.Module, strings.Join(plan.Conflicts, ", ")),
+			"delete conflicting modules first",
+		)
+	}
+
+	if len(plan.Dependencies) == 0 {
+		return true, nil
+	}
+
+	out.Msgfln("The %s module requires modules that are not installed: %s", plan.Module, strings.Join(plan.Dependencies, ", "))
+
+	if !cfg.autoApprove {
+		dependenciesPrompt := prompt.NewBool("Do you want to add them with the default configuration first?", true)
+		addDependencies, err := dependenciesPrompt.Prompt()
+		if err != nil {
+			return false, clierror.Wrap(err, clierror.New("failed to prompt for the user confirmation", "if error repeats, consider running the command with --auto-approve flag"))
+		}
+		if !addDependencies {
+			return false, nil
+		}
+	}
+
+	for _, dependency := range plan.Dependencies {
+		clierr := modules.Enable(cfg.Ctx, *client, repo, dependency, "", true, modules.ModuleCRTimeout(cfg.wait, cfg.timeout))
+		if clierr != nil {
+			return false, clierr
+		}
+
+		if cfg.wait {
+			// next modules can rely on the dependency so it must be ready first
+			clierr = modules.WaitForModuleState(cfg.Ctx, *client, dependency, cfg.timeout, "Ready", "Warning")
+			if clierr != nil {
+				return false, clierr
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func newDryRunOptions(dryRun types.DryRun, outputFormat types.Format) modules.DryRunOptions {
+	return modules.DryRunOptions{
+		Server: dryRun == types.ServerDryRun,
+		Format: outputFormat,
+	}
 }
 
 func validateOrigin(origin string) (string, string, error) {
@@ -0,0 +1,138 @@
+package module
+
+import (
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/prompt"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/modules"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/precheck"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+)
+
+type applyConfig struct {
+	*cmdcommon.KymaConfig
+	filePath    string
+	dryRun      bool
+	prune       bool
+	autoApprove bool
+}
+
+func newApplyCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := applyConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "apply [flags]",
+		Short: "Applies a set of modules described in a file",
+		Long: `Use this command to align modules in the cluster with the set of modules described in a file.
+The file lists core modules (name, channel, managed, customResourcePolicy) and community modules (template in format <namespace>/<module-template-name>, version, defaultConfigCR, configCR).`,
+		Example: `  # Preview changes needed to align the cluster with the modules.yaml file
+  kyma module apply -f modules.yaml --dry-run
+
+  # Add and update modules described in the modules.yaml file
+  kyma module apply -f modules.yaml
+
+  # Add, update, and remove modules so the cluster contains only modules described in the modules.yaml file
+  kyma module apply -f modules.yaml --prune --auto-approve
+
+  ## Example modules.yaml file
+  #  modules:
+  #    - name: keda
+  #      channel: fast
+  #      customResourcePolicy: CreateAndDelete
+  #    - name: serverless
+  #      managed: true
+  #  communityModules:
+  #    - template: my-namespace/my-module-1.0.0
+  #      version: 1.0.0
+  #      configCR:
+  #        apiVersion: operator.kyma-project.io/v1alpha1
+  #        kind: MyModule
+  #        metadata:
+  #          name: default
+  #          namespace: kyma-system`,
+
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			clierror.Check(flags.Validate(cmd.Flags(),
+				flags.MarkRequired("file"),
+			))
+			clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			clierror.Check(runApply(&cfg))
+		},
+	}
+
+	cmd.Flags().StringVarP(&cfg.filePath, "file", "f", "", "Path to the file with the set of modules")
+	cmd.Flags().BoolVar(&cfg.dryRun, "dry-run", false, "Prints planned changes without applying them")
+	cmd.Flags().BoolVar(&cfg.prune, "prune", false, "Removes modules that are not described in the file")
+	cmd.Flags().BoolVar(&cfg.autoApprove, "auto-approve", false, "Automatically approves community module installation and module removal")
+
+	return cmd
+}
+
+func runApply(cfg *applyConfig) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	moduleSet, err := modules.ReadModuleSet(cfg.filePath)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to read the module set", "make sure the file contains valid modules and communityModules lists"))
+	}
+
+	moduleTemplatesRepo := repo.NewModuleTemplatesRepo(client)
+
+	plan, err := modules.PlanApply(cfg.Ctx, client, moduleTemplatesRepo, moduleSet, cfg.prune)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to compare the module set with the cluster"))
+	}
+
+	modules.PrintApplyPlan(plan)
+
+	if cfg.dryRun || plan.IsEmpty() {
+		return nil
+	}
+
+	if !cfg.autoApprove {
+		proceed, clierr := confirmApplyPlan(plan)
+		if clierr != nil || !proceed {
+			return clierr
+		}
+	}
+
+	return modules.ExecuteApplyPlan(cfg.Ctx, client, moduleTemplatesRepo, plan)
+}
+
+func confirmApplyPlan(plan *modules.ApplyPlan) (bool, clierror.Error) {
+	removesModules := len(plan.CoreModulesToRemove) > 0 || len(plan.CommunityModulesToRemove) > 0
+	if !plan.InstallsCommunityModules() && !removesModules {
+		// nothing risky to confirm
+		return true, nil
+	}
+
+	if plan.InstallsCommunityModules() {
+		out.Msgln("\nWarning:\n  You are about to install a community module.\n" +
+			"  Community modules are not officially supported and come with no binding Service Level Agreement (SLA).\n" +
+			"  There is no guarantee of support, maintenance, or compatibility.")
+	}
+
+	if removesModules {
+		out.Msgln("\nWarning:\n  You are about to delete modules.\n" +
+			"  Before you delete modules, ensure the module resources are no longer needed.")
+	}
+
+	proceedPrompt := prompt.NewBool("\nAre you sure you want to proceed?", false)
+	proceed, err := proceedPrompt.Prompt()
+	if err != nil {
+		return false, clierror.Wrap(err, clierror.New("failed to prompt for the user confirmation", "if error repeats, consider running the command with --auto-approve flag"))
+	}
+
+	return proceed, nil
+}
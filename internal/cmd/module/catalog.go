@@ -4,7 +4,10 @@ import (
 	"github.com/kyma-project/cli.v3/internal/clierror"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/kube"
 	"github.com/kyma-project/cli.v3/internal/modules"
+	"github.com/kyma-project/cli.v3/internal/modules/integrity"
 	"github.com/kyma-project/cli.v3/internal/modules/repo"
 	"github.com/spf13/cobra"
 )
@@ -12,6 +15,9 @@ import (
 type catalogConfig struct {
 	*cmdcommon.KymaConfig
 	outputFormat types.Format
+	remoteUrl    []string
+	verify       bool
+	publicKeys   []string
 }
 
 func newCatalogCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
@@ -23,12 +29,31 @@ func newCatalogCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 		Use:   "catalog [flags]",
 		Short: "Lists modules catalog",
 		Long:  `Use this command to list all available Kyma modules.`,
+		Example: `  # List all available modules
+  kyma module catalog
+
+  # List community modules from a local catalog directory
+  kyma module catalog --remote-url ./community-modules
+
+  # List community modules from a catalog stored as an OCI artifact
+  kyma module catalog --remote-url oci://registry.local/kyma/community-modules:latest
+
+  # Check digests and signatures of community module resources
+  kyma module catalog --verify --public-key ./cosign.pub`,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			clierror.Check(flags.Validate(cmd.Flags(),
+				flags.MarkPrerequisites("public-key", "verify"),
+			))
+		},
 		Run: func(_ *cobra.Command, _ []string) {
 			clierror.Check(catalogModules(&cfg))
 		},
 	}
 
 	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format (Possible values: table, json, yaml)")
+	cmd.Flags().StringSliceVar(&cfg.remoteUrl, "remote-url", []string{}, "List of catalog sources that contain ModuleTemplate CRs (community modules): http(s) or file:// URLs, local files or directories, or oci:// references")
+	cmd.Flags().BoolVar(&cfg.verify, "verify", false, "Downloads resources of community modules and checks their digests and signatures")
+	cmd.Flags().StringSliceVar(&cfg.publicKeys, "public-key", []string{}, "Paths to PEM-encoded public keys used to check signatures of community module resources (used with --verify)")
 
 	return cmd
 }
@@ -38,7 +63,11 @@ func catalogModules(cfg *catalogConfig) clierror.Error {
 	if clierr != nil {
 		return clierr
 	}
-	moduleTemplatesRepo := repo.NewModuleTemplatesRepo(client)
+	moduleTemplatesRepo := repo.NewModuleTemplatesRepoWithSources(client, cfg.remoteUrl, nil)
+
+	if cfg.verify {
+		return catalogVerifiedModules(cfg, client, moduleTemplatesRepo)
+	}
 
 	modulesList, err := modules.ListCatalog(cfg.Ctx, client, moduleTemplatesRepo)
 	if err != nil {
@@ -52,3 +81,22 @@ func catalogModules(cfg *catalogConfig) clierror.Error {
 
 	return nil
 }
+
+func catalogVerifiedModules(cfg *catalogConfig, client kube.Client, moduleTemplatesRepo repo.ModuleTemplatesRepository) clierror.Error {
+	verifier, err := integrity.NewVerifier(false, cfg.publicKeys...)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to load public keys"))
+	}
+
+	modulesList, err := modules.ListVerifiedCatalog(cfg.Ctx, client, moduleTemplatesRepo, verifier)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to list available modules from the target Kyma environment"))
+	}
+
+	err = modules.Render(modulesList, modules.VerifiedCatalogTableInfo, cfg.outputFormat)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to list module catalog"))
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package module
+
+import (
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/spf13/cobra"
+)
+
+func newChannelCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel <command> [flags]",
+		Short: "Manages the default channel of modules",
+		Long:  `Use this command to inspect and change the default channel of the Kyma CR. Core modules without their own channel are installed from the default channel.`,
+	}
+
+	cmd.AddCommand(newChannelGetCMD(kymaConfig))
+	cmd.AddCommand(newChannelSetCMD(kymaConfig))
+
+	return cmd
+}
@@ -0,0 +1,64 @@
+package module
+
+import (
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/modules"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/precheck"
+	"github.com/spf13/cobra"
+)
+
+type channelGetConfig struct {
+	*cmdcommon.KymaConfig
+
+	outputFormat types.Format
+}
+
+func newChannelGetCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := channelGetConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "get [flags]",
+		Short: "Prints the default channel",
+		Long:  `Use this command to print the default channel of the Kyma CR and channels with versions available for each module.`,
+		Example: `  # Print the default channel and channels available for modules
+  kyma module channel get
+
+  # Print the default channel in the JSON format
+  kyma module channel get -o json`,
+
+		Args: cobra.NoArgs,
+		PreRun: func(_ *cobra.Command, _ []string) {
+			clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			clierror.Check(runChannelGet(&cfg))
+		},
+	}
+
+	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format (Possible values: json, yaml)")
+
+	return cmd
+}
+
+func runChannelGet(cfg *channelGetConfig) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	description, clierr := modules.GetDefaultChannel(cfg.Ctx, client)
+	if clierr != nil {
+		return clierr
+	}
+
+	err := modules.RenderDefaultChannel(description, cfg.outputFormat)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to render the default channel"))
+	}
+
+	return nil
+}
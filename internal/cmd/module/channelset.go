@@ -0,0 +1,119 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/prompt"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/modules"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/precheck"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+)
+
+type channelSetConfig struct {
+	*cmdcommon.KymaConfig
+
+	channel     string
+	dryRun      bool
+	autoApprove bool
+	wait        bool
+	timeout     time.Duration
+}
+
+func newChannelSetCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := channelSetConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "set <channel> [flags]",
+		Short: "Sets the default channel",
+		Long: `Use this command to change the default channel of the Kyma CR.
+Before the change, the command previews versions of modules from the Kyma CR in the new channel. Modules with their own channel set in the Kyma CR and unmanaged modules are not affected.`,
+		Example: `  # Preview versions of modules in the fast channel without changing the Kyma CR
+  kyma module channel set fast --dry-run
+
+  # Switch the default channel to fast and wait until affected modules are ready again
+  kyma module channel set fast --auto-approve --wait`,
+
+		Args: cobra.ExactArgs(1),
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			clierror.Check(flags.Validate(cmd.Flags(),
+				flags.MarkPrerequisites("timeout", "wait"),
+				flags.MarkExclusive("dry-run", "wait"),
+			))
+			clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
+		},
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.channel = args[0]
+			clierror.Check(runChannelSet(&cfg))
+		},
+	}
+
+	cmd.Flags().BoolVar(&cfg.dryRun, "dry-run", false, "Previews versions of modules in the new channel without changing the Kyma CR")
+	cmd.Flags().BoolVar(&cfg.autoApprove, "auto-approve", false, "Automatically approves the change of the default channel")
+	cmd.Flags().BoolVar(&cfg.wait, "wait", false, "Waits until modules affected by the change are ready again")
+	cmd.Flags().DurationVar(&cfg.timeout, "timeout", modules.DefaultWaitTimeout, "Maximum time to wait for modules (used with --wait)")
+
+	return cmd
+}
+
+func runChannelSet(cfg *channelSetConfig) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	plan, clierr := modules.PlanDefaultChannelChange(cfg.Ctx, client, cfg.channel)
+	if clierr != nil {
+		return clierr
+	}
+
+	modules.RenderChannelChangePlan(plan)
+
+	unavailable := []string{}
+	for _, module := range plan.Modules {
+		if module.Unavailable() {
+			unavailable = append(unavailable, module.Name)
+		}
+	}
+	if len(unavailable) > 0 {
+		return clierror.New(
+			fmt.Sprintf("modules are not available in the %s channel: %s", cfg.channel, strings.Join(unavailable, ", ")),
+			"to keep a module in its current channel, call the `kyma module upgrade <module> --channel <channel>` command first",
+		)
+	}
+
+	if cfg.dryRun {
+		return nil
+	}
+
+	if !cfg.autoApprove && len(plan.AffectedModules()) > 0 {
+		proceedPrompt := prompt.NewBool("\nAre you sure you want to change versions of these modules?", true)
+		proceed, err := proceedPrompt.Prompt()
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to prompt for the user confirmation", "if error repeats, consider running the command with --auto-approve flag"))
+		}
+		if !proceed {
+			return nil
+		}
+	}
+
+	clierr = modules.SetDefaultChannel(cfg.Ctx, client, cfg.channel)
+	if clierr != nil {
+		return clierr
+	}
+
+	out.Msgfln("\ndefault channel set to %s", cfg.channel)
+
+	if !cfg.wait {
+		return nil
+	}
+
+	return modules.WaitForChannelChange(cfg.Ctx, client, plan, cfg.timeout)
+}
@@ -0,0 +1,65 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modules"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newConfigCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config <command> [flags]",
+		Short: "Manages the module configuration",
+		Long:  `Use this command to inspect and change the configuration CR of a module.`,
+	}
+
+	cmd.AddCommand(newConfigGetCMD(kymaConfig))
+	cmd.AddCommand(newConfigSetCMD(kymaConfig))
+	cmd.AddCommand(newConfigEditCMD(kymaConfig))
+
+	return cmd
+}
+
+// getModuleConfig returns the config CR of the core module or of the community module in format <namespace>/<module-template-name>
+func getModuleConfig(kymaConfig *cmdcommon.KymaConfig, client kube.Client, module string) (*unstructured.Unstructured, clierror.Error) {
+	moduleTemplate, clierr := findModuleTemplate(kymaConfig, client, module)
+	if clierr != nil {
+		return nil, clierr
+	}
+
+	return modules.GetModuleConfig(kymaConfig.Ctx, client, moduleTemplate)
+}
+
+func findModuleTemplate(kymaConfig *cmdcommon.KymaConfig, client kube.Client, module string) (*kyma.ModuleTemplate, clierror.Error) {
+	if !strings.Contains(module, "/") {
+		moduleTemplate, err := modules.FindInstalledModuleTemplate(kymaConfig.Ctx, client, module)
+		if err != nil {
+			return nil, clierror.Wrap(err, clierror.New(
+				fmt.Sprintf("failed to find the %s module", module),
+				"to list installed modules, call the `kyma module list` command",
+			))
+		}
+
+		return moduleTemplate, nil
+	}
+
+	namespace, moduleTemplateName, err := validateOrigin(module)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to identify the community module"))
+	}
+
+	moduleTemplate, err := modules.FindCommunityModuleTemplate(kymaConfig.Ctx, namespace, moduleTemplateName, repo.NewModuleTemplatesRepo(client))
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to retrieve the module '%s/%s'", namespace, moduleTemplateName)))
+	}
+
+	return moduleTemplate, nil
+}
@@ -0,0 +1,146 @@
+package module
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	"github.com/kyma-project/cli.v3/internal/modules"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/precheck"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultEditor = "vi"
+
+type configEditConfig struct {
+	*cmdcommon.KymaConfig
+
+	module string
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func newConfigEditCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := configEditConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "edit <module> [flags]",
+		Short: "Edits the module configuration",
+		Long: `Use this command to edit the configuration CR of a module in the editor defined by the EDITOR environment variable.
+The result is validated against the CR's CRD schema and applied when the file is saved and the editor is closed.`,
+		Example: `  # Edit the Keda module configuration
+  kyma module config edit keda
+
+  # Edit the Keda module configuration using nano
+  EDITOR=nano kyma module config edit keda`,
+
+		Args: cobra.ExactArgs(1),
+		PreRun: func(_ *cobra.Command, _ []string) {
+			clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg.module = args[0]
+			cfg.stdin = cmd.InOrStdin()
+			cfg.stdout = cmd.OutOrStdout()
+			cfg.stderr = cmd.ErrOrStderr()
+			clierror.Check(runConfigEdit(&cfg))
+		},
+	}
+
+	return cmd
+}
+
+func runConfigEdit(cfg *configEditConfig) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	cr, clierr := getModuleConfig(cfg.KymaConfig, client, cfg.module)
+	if clierr != nil {
+		return clierr
+	}
+
+	original, err := yaml.Marshal(cr.Object)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to render the module config CR"))
+	}
+
+	edited, clierr := editInEditor(cfg, original)
+	if clierr != nil {
+		return clierr
+	}
+
+	if bytes.Equal(original, edited) {
+		out.Msgln("edit cancelled, no changes made")
+		return nil
+	}
+
+	editedCRs, err := resources.DecodeYaml(bytes.NewReader(edited))
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to decode the edited module config CR"))
+	}
+	if len(editedCRs) != 1 {
+		return clierror.New(fmt.Sprintf("expected exactly one module config CR, found %d", len(editedCRs)))
+	}
+
+	editedCR := editedCRs[0]
+	if editedCR.GetName() != cr.GetName() || editedCR.GetNamespace() != cr.GetNamespace() || editedCR.GetKind() != cr.GetKind() {
+		return clierror.New(
+			"the name, namespace, or kind of the module config CR can't be changed",
+			fmt.Sprintf("make sure you edit the %s %s/%s CR", cr.GetKind(), cr.GetNamespace(), cr.GetName()),
+		)
+	}
+
+	return modules.ApplyModuleConfig(cfg.Ctx, client, &editedCR)
+}
+
+// editInEditor opens the content in the editor and returns the content of the saved file
+func editInEditor(cfg *configEditConfig, content []byte) ([]byte, clierror.Error) {
+	file, err := os.CreateTemp("", fmt.Sprintf("kyma-module-config-%s-*.yaml", strings.ReplaceAll(cfg.module, "/", "-")))
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to create a temporary file"))
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(content)
+	file.Close()
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to write the module config CR to a temporary file"))
+	}
+
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{defaultEditor}
+	}
+
+	editorCmd := exec.CommandContext(cfg.Ctx, editor[0], append(editor[1:], file.Name())...)
+	editorCmd.Stdin = cfg.stdin
+	editorCmd.Stdout = cfg.stdout
+	editorCmd.Stderr = cfg.stderr
+	err = editorCmd.Run()
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New(
+			fmt.Sprintf("failed to run the %s editor", editor[0]),
+			"set the EDITOR environment variable to the editor you want to use",
+		))
+	}
+
+	edited, err := os.ReadFile(file.Name())
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to read the edited module config CR"))
+	}
+
+	return edited, nil
+}
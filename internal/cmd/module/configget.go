@@ -0,0 +1,77 @@
+package module
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/precheck"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type configGetConfig struct {
+	*cmdcommon.KymaConfig
+
+	module       string
+	outputFormat types.Format
+}
+
+func newConfigGetCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := configGetConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "get <module> [flags]",
+		Short: "Prints the module configuration",
+		Long:  `Use this command to print the live configuration CR of a module.`,
+		Example: `  # Print the Keda module configuration
+  kyma module config get keda
+
+  ## Print a community module configuration in the JSON format
+  #  passed argument must be in the format <namespace>/<module-template-name>
+  kyma module config get my-namespace/my-module-template-name -o json`,
+
+		Args: cobra.ExactArgs(1),
+		PreRun: func(_ *cobra.Command, _ []string) {
+			clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
+		},
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.module = args[0]
+			clierror.Check(runConfigGet(&cfg))
+		},
+	}
+
+	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format (Possible values: json, yaml)")
+
+	return cmd
+}
+
+func runConfigGet(cfg *configGetConfig) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	cr, clierr := getModuleConfig(cfg.KymaConfig, client, cfg.module)
+	if clierr != nil {
+		return clierr
+	}
+
+	var bytes []byte
+	var err error
+	if cfg.outputFormat == types.JSONFormat {
+		bytes, err = json.MarshalIndent(cr.Object, "", "  ")
+	} else {
+		bytes, err = yaml.Marshal(cr.Object)
+	}
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to render the module config CR"))
+	}
+
+	out.Msgln(string(bytes))
+	return nil
+}
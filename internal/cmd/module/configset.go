@@ -0,0 +1,65 @@
+package module
+
+import (
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/modules"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/precheck"
+	"github.com/spf13/cobra"
+)
+
+type configSetConfig struct {
+	*cmdcommon.KymaConfig
+
+	module string
+	values []string
+}
+
+func newConfigSetCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := configSetConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "set <module> <path>=<value>... [flags]",
+		Short: "Sets values in the module configuration",
+		Long: `Use this command to set values in the configuration CR of a module.
+The value type is based on the field type from the CR's CRD schema. The result is validated against the schema before it's applied.`,
+		Example: `  # Set the log level of the Keda module
+  kyma module config set keda spec.logging.operator.level=debug
+
+  # Set multiple values at once
+  kyma module config set keda spec.resources.operator.limits.cpu=1 spec.istio.enabledSidecarInjection=true`,
+
+		Args: cobra.MinimumNArgs(2),
+		PreRun: func(_ *cobra.Command, _ []string) {
+			clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
+		},
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.module = args[0]
+			cfg.values = args[1:]
+			clierror.Check(runConfigSet(&cfg))
+		},
+	}
+
+	return cmd
+}
+
+func runConfigSet(cfg *configSetConfig) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	cr, clierr := getModuleConfig(cfg.KymaConfig, client, cfg.module)
+	if clierr != nil {
+		return clierr
+	}
+
+	clierr = modules.SetModuleConfigValues(cfg.Ctx, client, cr, cfg.values)
+	if clierr != nil {
+		return clierr
+	}
+
+	return modules.ApplyModuleConfig(cfg.Ctx, client, cr)
+}
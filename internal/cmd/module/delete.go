@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/kyma-project/cli.v3/internal/clierror"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon/prompt"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
 	"github.com/kyma-project/cli.v3/internal/flags"
 	"github.com/kyma-project/cli.v3/internal/kube"
 	"github.com/kyma-project/cli.v3/internal/modules"
@@ -20,6 +22,13 @@ type deleteConfig struct {
 	*cmdcommon.KymaConfig
 	autoApprove bool
 	community   bool
+	wait        bool
+	timeout     time.Duration
+	backupDir   string
+	force       bool
+
+	dryRun       types.DryRun
+	outputFormat types.Format
 
 	module     string
 	modulePath string
@@ -33,10 +42,24 @@ func newDeleteCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete <module> [flags]",
 		Short: "Deletes a module",
-		Long:  "Use this command to delete a module.",
+		Long: `Use this command to delete a module.
+
+The command refuses to delete a module that installed modules require (see the ` + "`" + modules.RequiresAnnotation + "`" + ` ModuleTemplate annotation) unless the --force flag is used.`,
 		Example: `  # Delete the Keda module
   kyma module delete keda
 
+  # Delete the Keda module and wait until it's removed
+  kyma module delete keda --auto-approve --wait
+
+  # Print changes of the Kyma CR without applying them
+  kyma module delete keda --dry-run
+
+  # Delete the Istio module even if installed modules require it
+  kyma module delete istio --force
+
+  # Save the Keda CR and resources created by users before deleting the module
+  kyma module delete keda --backup-dir ./keda-backup
+
   ## Delete a community module and auto-approve the deletion
   #  passed argument must be in the format <namespace>/<module-template-name>
   #  the format of the passed argument can be read from the 'kyma module catalog' command from the 'origin' column
@@ -47,6 +70,9 @@ func newDeleteCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 		PreRun: func(cmd *cobra.Command, _ []string) {
 			clierror.Check(flags.Validate(cmd.Flags(),
 				flags.MarkUnsupported("community", "the --community flag is no longer supported - specify community module to delete using argument"),
+				flags.MarkPrerequisites("timeout", "wait"),
+				flags.MarkPrerequisites("output", "dry-run"),
+				flags.MarkExclusive("dry-run", "wait", "backup-dir"),
 			))
 			clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
 		},
@@ -59,6 +85,13 @@ func newDeleteCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 	cmd.Flags().BoolVar(&cfg.autoApprove, "auto-approve", false, "Automatically approves module removal")
 	cmd.Flags().BoolVar(&cfg.community, "community", false, "Delete the community module (if set, the operation targets a community module instead of a core module)")
 	_ = cmd.Flags().MarkHidden("community")
+	cmd.Flags().BoolVar(&cfg.wait, "wait", false, "Waits until the module is removed")
+	cmd.Flags().DurationVar(&cfg.timeout, "timeout", modules.DefaultWaitTimeout, "Maximum time to wait for the module removal (used with --wait)")
+	cmd.Flags().StringVar(&cfg.backupDir, "backup-dir", "", "Saves the module CR and user-defined resources to the directory before the deletion (to restore them, use the 'kyma module restore' command)")
+	cmd.Flags().BoolVar(&cfg.force, "force", false, "Deletes the module even if installed modules require it")
+	cmd.Flags().Var(&cfg.dryRun, "dry-run", "Prints changes without applying them (Possible values: client, server)")
+	cmd.Flags().Lookup("dry-run").NoOptDefVal = string(types.ClientDryRun)
+	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format of printed changes (Possible values: json, yaml; used with --dry-run)")
 
 	return cmd
 }
@@ -84,6 +117,11 @@ func runDelete(cfg *deleteConfig) clierror.Error {
 		return uninstallCommunityModule(cfg, client)
 	}
 
+	clierr = checkDependents(cfg, client, cfg.module)
+	if clierr != nil {
+		return clierr
+	}
+
 	return disableModule(cfg, client)
 }
 
@@ -99,6 +137,15 @@ func uninstallCommunityModule(cfg *deleteConfig, client kube.Client) clierror.Er
 		return clierror.Wrap(err, clierror.New("failed to retrieve the module '%s/%s'", namespace, moduleTemplateName))
 	}
 
+	clierr := checkDependents(cfg, client, communityModuleTemplate.Spec.ModuleName)
+	if clierr != nil {
+		return clierr
+	}
+
+	if cfg.dryRun.Enabled() {
+		return modules.DryRunUninstall(cfg.Ctx, client, repo, communityModuleTemplate, newDryRunOptions(cfg.dryRun, cfg.outputFormat))
+	}
+
 	if !cfg.autoApprove {
 		runningResources, clierr := modules.GetRunningResourcesOfCommunityModule(cfg.Ctx, repo, *communityModuleTemplate)
 		if clierr != nil {
@@ -117,10 +164,26 @@ func uninstallCommunityModule(cfg *deleteConfig, client kube.Client) clierror.Er
 		}
 	}
 
-	return modules.Uninstall(cfg.Ctx, repo, communityModuleTemplate)
+	if cfg.backupDir != "" {
+		clierr := modules.BackupCommunityModule(cfg.Ctx, client, repo, communityModuleTemplate, cfg.backupDir)
+		if clierr != nil {
+			return clierr
+		}
+	}
+
+	clierr = modules.Uninstall(cfg.Ctx, repo, communityModuleTemplate)
+	if clierr != nil || !cfg.wait {
+		return clierr
+	}
+
+	return modules.WaitForCommunityModuleRemoval(cfg.Ctx, client, communityModuleTemplate, cfg.timeout)
 }
 
 func disableModule(cfg *deleteConfig, client kube.Client) clierror.Error {
+	if cfg.dryRun.Enabled() {
+		return modules.DryRunDisable(cfg.Ctx, client, cfg.module, newDryRunOptions(cfg.dryRun, cfg.outputFormat))
+	}
+
 	if !cfg.autoApprove {
 		confirmationPrompt := prompt.NewBool(prepareCorePromptMessage(cfg.module), false)
 		confirmation, err := confirmationPrompt.Prompt()
@@ -133,7 +196,27 @@ func disableModule(cfg *deleteConfig, client kube.Client) clierror.Error {
 		}
 	}
 
-	return modules.Disable(cfg.Ctx, client, cfg.module)
+	if cfg.backupDir != "" {
+		clierr := modules.BackupModule(cfg.Ctx, client, repo.NewModuleTemplatesRepo(client), cfg.module, cfg.backupDir)
+		if clierr != nil {
+			return clierr
+		}
+	}
+
+	clierr := modules.Disable(cfg.Ctx, client, cfg.module, modules.ModuleCRTimeout(cfg.wait, cfg.timeout))
+	if clierr != nil || !cfg.wait {
+		return clierr
+	}
+
+	return modules.WaitForModuleRemoval(cfg.Ctx, client, cfg.module, cfg.timeout)
+}
+
+func checkDependents(cfg *deleteConfig, client kube.Client, module string) clierror.Error {
+	if cfg.force {
+		return nil
+	}
+
+	return modules.CheckDependents(cfg.Ctx, client, repo.NewModuleTemplatesRepo(client), module)
 }
 
 func prepareCommunityPromptMessage(resourcesNames []string) string {
@@ -0,0 +1,116 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/modules"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/precheck"
+	"github.com/spf13/cobra"
+)
+
+type describeConfig struct {
+	*cmdcommon.KymaConfig
+
+	module       string
+	modulePath   string
+	outputFormat types.Format
+}
+
+func newDescribeCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := describeConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "describe <module> [flags]",
+		Short: "Describes a module",
+		Long: `Use this command to show details of a core or community module.
+The description contains the module entry from the Kyma CR, its module template, the manager and its replicas, the module CRs with conditions, the associated resources, and the recent events.`,
+		Example: `  # Describe the Keda module
+  kyma module describe keda
+
+  # Describe the Keda module in the YAML format
+  kyma module describe keda -o yaml
+
+  ## Describe a community module
+  #  passed argument must be in the format <namespace>/<module-template-name>
+  kyma module describe my-namespace/my-module-template-name`,
+
+		Args: cobra.ExactArgs(1),
+		PreRun: func(_ *cobra.Command, _ []string) {
+			clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
+		},
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.complete(args)
+			clierror.Check(runDescribe(&cfg))
+		},
+	}
+
+	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format (Possible values: json, yaml)")
+
+	return cmd
+}
+
+func (c *describeConfig) complete(args []string) {
+	if strings.Contains(args[0], "/") {
+		// arg is module location in format <namespace>/<module-template-name>
+		c.modulePath = args[0]
+		return
+	}
+
+	// arg is module name
+	c.module = args[0]
+}
+
+func runDescribe(cfg *describeConfig) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	moduleTemplatesRepo := repo.NewModuleTemplatesRepo(client)
+
+	description, clierr := describeModule(cfg, client, moduleTemplatesRepo)
+	if clierr != nil {
+		return clierr
+	}
+
+	err := modules.RenderModuleDescription(description, cfg.outputFormat)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to render the module description"))
+	}
+
+	return nil
+}
+
+func describeModule(cfg *describeConfig, client kube.Client, moduleTemplatesRepo repo.ModuleTemplatesRepository) (*modules.ModuleDescription, clierror.Error) {
+	if cfg.modulePath == "" {
+		description, err := modules.DescribeModule(cfg.Ctx, client, moduleTemplatesRepo, cfg.module)
+		if err != nil {
+			return nil, clierror.Wrap(err, clierror.New(
+				fmt.Sprintf("failed to describe the %s module", cfg.module),
+				"to list installed modules, call the `kyma module list` command",
+			))
+		}
+
+		return description, nil
+	}
+
+	namespace, moduleTemplateName, err := validateOrigin(cfg.modulePath)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to identify the community module"))
+	}
+
+	moduleTemplate, err := modules.FindCommunityModuleTemplate(cfg.Ctx, namespace, moduleTemplateName, moduleTemplatesRepo)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to retrieve the module '%s/%s'", namespace, moduleTemplateName)))
+	}
+
+	return modules.DescribeCommunityModule(cfg.Ctx, client, moduleTemplatesRepo, moduleTemplate), nil
+}
@@ -4,9 +4,14 @@ import (
 	"github.com/kyma-project/cli.v3/internal/clierror"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/kube/snapshot"
 	"github.com/kyma-project/cli.v3/internal/modules"
 	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/modulesv2"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
 	"github.com/kyma-project/cli.v3/internal/modulesv2/precheck"
+	"github.com/kyma-project/cli.v3/internal/out"
 	"github.com/spf13/cobra"
 )
 
@@ -14,6 +19,11 @@ type modulesConfig struct {
 	*cmdcommon.KymaConfig
 	outputFormat types.Format
 	showErrors   bool
+	allContexts  bool
+	watch        bool
+
+	// contexts is set when modules are listed from multiple clusters
+	contexts []string
 }
 
 func newListCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
@@ -24,9 +34,47 @@ func newListCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list [flags]",
 		Short: "Lists the installed modules",
-		Long:  `Use this command to list the installed Kyma modules.`,
+		Long: `Use this command to list the installed Kyma modules.
+
+To compare modules across multiple clusters, pass the '--context' flag more than once or use the '--all-contexts' flag.
+Modules are then displayed as rows and clusters as columns, with the version drift marked for every module.
+
+Use the '--watch' flag to follow a module rollout. The command watches the default Kyma custom resource, module custom resources,
+and module managers, and redraws the table whenever the installation or module state changes. With the JSON output,
+every change is printed as a separate JSON object in a new line.`,
+		Example: `  # List modules installed in the cluster
+  kyma module list
+
+  # Compare modules installed in the dev, stage, and prod clusters
+  kyma module list --context dev --context stage --context prod
+
+  # Compare modules installed in clusters of all kubeconfig contexts in the JSON format
+  kyma module list --all-contexts --output json
+
+  # Watch installed modules and print changes in the JSON format
+  kyma module list --watch --output json`,
 		PreRun: func(cmd *cobra.Command, args []string) {
-			clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
+			clierror.Check(flags.Validate(cmd.Flags(),
+				flags.MarkExclusive("watch", "all-contexts"),
+			))
+
+			contexts, err := cmdcommon.GetFleetContexts(cfg.allContexts)
+			if err != nil {
+				clierror.Check(clierror.Wrap(err, clierror.New("failed to read kubeconfig contexts")))
+			}
+			cfg.contexts = contexts
+
+			if cfg.watch && len(cfg.contexts) > 0 {
+				clierror.Check(clierror.New("the --watch flag can't be used with multiple contexts", "pass only one --context flag"))
+			}
+
+			if cfg.watch && cfg.outputFormat == types.YAMLFormat {
+				clierror.Check(clierror.New("the --watch flag supports only the table and json output formats"))
+			}
+
+			if len(cfg.contexts) == 0 {
+				clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
+			}
 		},
 		Run: func(_ *cobra.Command, _ []string) {
 			clierror.Check(listModules(&cfg))
@@ -35,15 +83,27 @@ func newListCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 
 	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format (Possible values: table, json, yaml)")
 	cmd.Flags().BoolVar(&cfg.showErrors, "show-errors", false, "Indicates whether to show errors outputted by misconfigured modules")
+	cmd.Flags().BoolVar(&cfg.allContexts, "all-contexts", false, "Lists modules from clusters of all kubeconfig contexts")
+	cmd.Flags().BoolVar(&cfg.watch, "watch", false, "Watches installed modules and prints changes until the command is stopped")
 
 	return cmd
 }
 
 func listModules(cfg *modulesConfig) clierror.Error {
+	if len(cfg.contexts) > 0 {
+		return listFleetModules(cfg)
+	}
+
+	if cfg.watch {
+		return watchModules(cfg)
+	}
+
 	client, clierr := cfg.GetKubeClientWithClierr()
 	if clierr != nil {
 		return clierr
 	}
+	// share cluster reads between the repo and the list
+	client = snapshot.NewClient(client)
 	moduleTemplatesRepo := repo.NewModuleTemplatesRepo(client)
 
 	modulesList, err := modules.ListInstalled(cfg.Ctx, client, moduleTemplatesRepo, cfg.showErrors)
@@ -58,3 +118,42 @@ func listModules(cfg *modulesConfig) clierror.Error {
 
 	return nil
 }
+
+func listFleetModules(cfg *modulesConfig) clierror.Error {
+	fleet := modules.ListInstalledInFleet(cfg.Ctx, cfg.contexts, cmdcommon.NewKubeClientForContext, cfg.showErrors)
+
+	failed := 0
+	for _, cluster := range fleet {
+		if cluster.Err != nil {
+			out.Errfln("failed to list installed modules from the %s context: %v", cluster.Context, cluster.Err)
+			failed++
+		}
+	}
+
+	err := modules.RenderFleet(fleet, cfg.outputFormat)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to render module list"))
+	}
+
+	if failed == len(fleet) {
+		return clierror.New("failed to list installed modules from all contexts", "make sure that kubeconfig contexts are correct")
+	}
+
+	return nil
+}
+
+func watchModules(cfg *modulesConfig) clierror.Error {
+	watchService, err := modulesv2.NewModuleOperations(cfg.KymaConfig).Watch()
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to execute the list command"))
+	}
+
+	err = watchService.Run(cfg.Ctx, func(result *dtos.WatchResult) error {
+		return modulesv2.RenderWatch(result, cfg.outputFormat, out.Default)
+	})
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to watch installed modules from the target Kyma environment"))
+	}
+
+	return nil
+}
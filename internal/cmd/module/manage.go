@@ -4,10 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"time"
 
 	"github.com/kyma-project/cli.v3/internal/clierror"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon/prompt"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/flags"
 	"github.com/kyma-project/cli.v3/internal/kube"
 	"github.com/kyma-project/cli.v3/internal/kube/kyma"
 	"github.com/kyma-project/cli.v3/internal/modules"
@@ -21,8 +24,13 @@ import (
 type manageConfig struct {
 	*cmdcommon.KymaConfig
 
-	module string
-	policy string
+	module  string
+	policy  string
+	wait    bool
+	timeout time.Duration
+
+	dryRun       types.DryRun
+	outputFormat types.Format
 }
 
 func newManageCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
@@ -34,8 +42,19 @@ func newManageCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 		Use:   "manage <module> [flags]",
 		Short: "Sets the module to the managed state",
 		Long:  "Use this command to set an existing module to the managed state.",
-		Args:  cobra.ExactArgs(1),
-		PreRun: func(_ *cobra.Command, args []string) {
+		Example: `  # Set the Keda module to the managed state
+  kyma module manage keda
+
+  # Print changes of the Kyma CR without applying them
+  kyma module manage keda --policy Ignore --dry-run`,
+
+		Args: cobra.ExactArgs(1),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			clierror.Check(flags.Validate(cmd.Flags(),
+				flags.MarkPrerequisites("timeout", "wait"),
+				flags.MarkPrerequisites("output", "dry-run"),
+				flags.MarkExclusive("dry-run", "wait"),
+			))
 			clierror.Check(cfg.validate())
 			clierror.Check(precheck.RequireKLMManaged(kymaConfig, precheck.CmdGroupStable))
 		},
@@ -46,6 +65,11 @@ func newManageCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&cfg.policy, "policy", "CreateAndDelete", "Sets a custom resource policy (Possible values: CreateAndDelete, Ignore)")
+	cmd.Flags().BoolVar(&cfg.wait, "wait", false, "Waits until the module is ready")
+	cmd.Flags().DurationVar(&cfg.timeout, "timeout", modules.DefaultWaitTimeout, "Maximum time to wait for the module (used with --wait)")
+	cmd.Flags().Var(&cfg.dryRun, "dry-run", "Prints changes without applying them (Possible values: client, server)")
+	cmd.Flags().Lookup("dry-run").NoOptDefVal = string(types.ClientDryRun)
+	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format of printed changes (Possible values: json, yaml; used with --dry-run)")
 
 	return cmd
 }
@@ -64,6 +88,10 @@ func runManage(cfg *manageConfig) clierror.Error {
 		return clierr
 	}
 
+	if cfg.dryRun.Enabled() {
+		return modules.DryRunManage(cfg.Ctx, client, repo.NewModuleTemplatesRepo(client), cfg.module, cfg.policy, newDryRunOptions(cfg.dryRun, cfg.outputFormat))
+	}
+
 	exists, err := modules.ModuleExistsInKymaCR(cfg.Ctx, client, cfg.module)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to check if module exists in the target Kyma environment"))
@@ -77,10 +105,26 @@ func runManage(cfg *manageConfig) clierror.Error {
 		return clierr
 	}
 
-	return nil
+	return waitForManagedModule(cfg, client)
 }
 
 func manageModuleInKyma(cfg *manageConfig, client kube.Client) clierror.Error {
+	if cfg.wait {
+		// the module state is checked with progress output and timeout instead of the default wait
+		err := client.Kyma().ManageModule(cfg.Ctx, cfg.module, cfg.policy)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to manage module in the target Kyma environment"))
+		}
+
+		clierr := waitForManagedModule(cfg, client)
+		if clierr != nil {
+			return clierr
+		}
+
+		out.Msgfln("Module %s set to managed", cfg.module)
+		return nil
+	}
+
 	err := modules.ManageModuleInKymaCR(cfg.Ctx, client, cfg.module, cfg.policy)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to manage module in the target Kyma environment"))
@@ -90,6 +134,14 @@ func manageModuleInKyma(cfg *manageConfig, client kube.Client) clierror.Error {
 	return nil
 }
 
+func waitForManagedModule(cfg *manageConfig, client kube.Client) clierror.Error {
+	if !cfg.wait {
+		return nil
+	}
+
+	return modules.WaitForModuleState(cfg.Ctx, client, cfg.module, cfg.timeout, "Ready", "Warning")
+}
+
 func manageModuleMissingInKyma(cfg *manageConfig, client kube.Client) clierror.Error {
 	moduleTemplatesRepo := repo.NewModuleTemplatesRepo(client)
 
@@ -118,7 +170,7 @@ func manageModuleMissingInKyma(cfg *manageConfig, client kube.Client) clierror.E
 
 	defaultCrFlag := cfg.policy == kyma.CustomResourcePolicyCreateAndDelete
 
-	clierr = modules.Enable(cfg.Ctx, client, moduleTemplatesRepo, cfg.module, selectedChannel, defaultCrFlag, []unstructured.Unstructured{}...)
+	clierr = modules.Enable(cfg.Ctx, client, moduleTemplatesRepo, cfg.module, selectedChannel, defaultCrFlag, modules.ModuleCRTimeout(cfg.wait, cfg.timeout), []unstructured.Unstructured{}...)
 	if clierr != nil {
 		return clierr
 	}
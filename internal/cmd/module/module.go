@@ -15,11 +15,18 @@ func NewModuleCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 
 	cmd.AddCommand(newListCMD(kymaConfig))
 	cmd.AddCommand(newCatalogCMD(kymaConfig))
+	cmd.AddCommand(newDescribeCMD(kymaConfig))
+	cmd.AddCommand(newResourcesCMD(kymaConfig))
 	cmd.AddCommand(newAddCMD(kymaConfig))
 	cmd.AddCommand(newDeleteCMD(kymaConfig))
+	cmd.AddCommand(newRestoreCMD(kymaConfig))
 	cmd.AddCommand(newManageCMD(kymaConfig))
 	cmd.AddCommand(newUnmanageCMD(kymaConfig))
 	cmd.AddCommand(newPullCMD(kymaConfig))
+	cmd.AddCommand(newApplyCMD(kymaConfig))
+	cmd.AddCommand(newUpgradeCMD(kymaConfig))
+	cmd.AddCommand(newConfigCMD(kymaConfig))
+	cmd.AddCommand(newChannelCMD(kymaConfig))
 
 	return cmd
 }
@@ -16,10 +16,12 @@ import (
 type pullConfig struct {
 	*cmdcommon.KymaConfig
 
-	moduleName string
-	namespace  string
-	version    string
-	force      bool
+	moduleName      string
+	namespace       string
+	version         string
+	force           bool
+	remote          string
+	resourceMirrors map[string]string
 }
 
 func newPullCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
@@ -34,7 +36,13 @@ func newPullCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 
 This command downloads module templates and resources from remote repositories,
 making them available locally for subsequent installation. Community modules
-must be pulled before they can be installed using the 'kyma module add' command.`,
+must be pulled before they can be installed using the 'kyma module add' command.
+
+Module resources can be linked as http(s) URLs or as oci://registry/repository:tag references
+to OCI artifacts. Artifacts are pulled with credentials from the Docker config, and the module manifest
+is read from the single artifact layer with the YAML media type (application/yaml, application/x-yaml, or text/yaml).
+Local file links are allowed only in module templates from local catalogs. Modules with resources linked as local files,
+for example, from a local mirror, can be added only with the --allow-local-resources flag of the 'kyma module add' command.`,
 		Example: `  # Pull a specific community module
   kyma module pull community-module-name
 
@@ -42,7 +50,14 @@ must be pulled before they can be installed using the 'kyma module add' command.
   kyma module pull community-module-name --namespace module-namespace
 
   # Pull a module with a specific version into specific namespace
-  kyma module pull community-module-name --version v1.0.0 --namespace module-namespace`,
+  kyma module pull community-module-name --version v1.0.0 --namespace module-namespace
+
+  # Pull a module from a local catalog and download its resources from a local mirror
+  kyma module pull community-module-name --remote-url file:///opt/catalog/all-modules.json \
+    --resource-mirror https://github.com/=file:///opt/mirror/github/
+
+  # Add the module pulled with resources from a local mirror
+  kyma module add module-namespace/community-module-template-name --allow-local-resources`,
 
 		Args: cobra.ExactArgs(1),
 		PreRun: func(cmd *cobra.Command, args []string) {
@@ -57,6 +72,8 @@ must be pulled before they can be installed using the 'kyma module add' command.
 	cmd.Flags().StringVarP(&cfg.namespace, "namespace", "n", "default", "Destination namespace where the module is stored")
 	cmd.Flags().StringVarP(&cfg.version, "version", "v", "", "Specifies version of the community module to pull")
 	cmd.Flags().BoolVar(&cfg.force, "force", false, "Automatically approves the installation of dependencies for clusters that are not managed by KLM.")
+	cmd.Flags().StringVar(&cfg.remote, "remote-url", "", "Catalog source that contains ModuleTemplate CRs: http(s) or file:// URL, local file or directory, or oci:// reference (defaults to official community catalog)")
+	cmd.Flags().StringToStringVar(&cfg.resourceMirrors, "resource-mirror", map[string]string{}, "Rewrites resource links of the module with the given prefix to the local mirror, in format <url-prefix>=<mirror-prefix>")
 
 	return cmd
 }
@@ -71,7 +88,12 @@ func pullModule(cfg *pullConfig) clierror.Error {
 		return clierror.New(getErrorTextForInvalidNamespace(cfg.moduleName))
 	}
 
-	moduleTemplatesRepo := repo.NewModuleTemplatesRepo(client)
+	var remoteUrls []string
+	if cfg.remote != "" {
+		remoteUrls = []string{cfg.remote}
+	}
+
+	moduleTemplatesRepo := repo.NewModuleTemplatesRepoWithSources(client, remoteUrls, cfg.resourceMirrors)
 	moduleTemplate, err := modules.GetModuleTemplateFromRemote(cfg.Ctx, moduleTemplatesRepo, cfg.moduleName, cfg.version)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to pull image from the community modules repository"))
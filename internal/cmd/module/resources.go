@@ -0,0 +1,123 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/modules"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/precheck"
+	"github.com/spf13/cobra"
+)
+
+type resourcesConfig struct {
+	*cmdcommon.KymaConfig
+
+	module       string
+	modulePath   string
+	outputFormat types.Format
+	drift        bool
+}
+
+func newResourcesCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := resourcesConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "resources <module> [flags]",
+		Short: "Lists resources owned by a module",
+		Long: `Use this command to list all resources owned by an installed core or community module.
+The list contains resources from the module manifest with their live status (Exists, Missing, Drifted, or Unknown if the resource can't be read), and the module CRs and associated resources from all namespaces.
+For core modules, the list also contains resources labeled with the kyma-project.io/module label and resources owned by other module resources.
+Use the --drift flag to show fields of live resources that differ from the module manifest. Values of Secret data are not shown.`,
+		Example: `  # List resources of the Keda module
+  kyma module resources keda
+
+  # Show fields of the Keda module resources that differ from the manifest
+  kyma module resources keda --drift
+
+  # List resources of the Keda module in the JSON format
+  kyma module resources keda -o json
+
+  ## List resources of a community module
+  #  passed argument must be in the format <namespace>/<module-template-name>
+  kyma module resources my-namespace/my-module-template-name`,
+
+		Args: cobra.ExactArgs(1),
+		PreRun: func(_ *cobra.Command, _ []string) {
+			clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
+		},
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.complete(args)
+			clierror.Check(runResources(&cfg))
+		},
+	}
+
+	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format (Possible values: json, yaml)")
+	cmd.Flags().BoolVar(&cfg.drift, "drift", false, "Shows fields of live resources that differ from the module manifest")
+
+	return cmd
+}
+
+func (c *resourcesConfig) complete(args []string) {
+	if strings.Contains(args[0], "/") {
+		// arg is module location in format <namespace>/<module-template-name>
+		c.modulePath = args[0]
+		return
+	}
+
+	// arg is module name
+	c.module = args[0]
+}
+
+func runResources(cfg *resourcesConfig) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	moduleTemplatesRepo := repo.NewModuleTemplatesRepo(client)
+
+	inventory, clierr := inventoryModule(cfg, client, moduleTemplatesRepo)
+	if clierr != nil {
+		return clierr
+	}
+
+	err := modules.RenderModuleInventory(inventory, cfg.outputFormat, cfg.drift)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to render the module resources"))
+	}
+
+	return nil
+}
+
+func inventoryModule(cfg *resourcesConfig, client kube.Client, moduleTemplatesRepo repo.ModuleTemplatesRepository) (*modules.ModuleInventory, clierror.Error) {
+	if cfg.modulePath == "" {
+		inventory, err := modules.InventoryModule(cfg.Ctx, client, moduleTemplatesRepo, cfg.module)
+		if err != nil {
+			return nil, clierror.Wrap(err, clierror.New(
+				fmt.Sprintf("failed to list resources of the %s module", cfg.module),
+				"to list installed modules, call the `kyma module list` command",
+			))
+		}
+
+		return inventory, nil
+	}
+
+	namespace, moduleTemplateName, err := validateOrigin(cfg.modulePath)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to identify the community module"))
+	}
+
+	moduleTemplate, err := modules.FindCommunityModuleTemplate(cfg.Ctx, namespace, moduleTemplateName, moduleTemplatesRepo)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to retrieve the module '%s/%s'", namespace, moduleTemplateName)))
+	}
+
+	return modules.InventoryCommunityModule(cfg.Ctx, client, moduleTemplatesRepo, moduleTemplate), nil
+}
@@ -0,0 +1,59 @@
+package module
+
+import (
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/modules"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/precheck"
+	"github.com/spf13/cobra"
+)
+
+type restoreConfig struct {
+	*cmdcommon.KymaConfig
+
+	backupDir string
+	timeout   time.Duration
+}
+
+func newRestoreCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := restoreConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "restore <backup-dir> [flags]",
+		Short: "Restores a deleted module from a backup",
+		Long: `Use this command to add a module saved with the 'kyma module delete --backup-dir' command.
+The module is added with the saved config CR, and the saved user-defined resources are re-created once their CRDs exist again.`,
+		Example: `  # Delete the Keda module with a backup
+  kyma module delete keda --backup-dir ./keda-backup
+
+  # Restore the Keda module with its configuration and resources
+  kyma module restore ./keda-backup`,
+
+		Args: cobra.ExactArgs(1),
+		PreRun: func(_ *cobra.Command, _ []string) {
+			clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
+		},
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.backupDir = args[0]
+			clierror.Check(runRestore(&cfg))
+		},
+	}
+
+	cmd.Flags().DurationVar(&cfg.timeout, "timeout", modules.DefaultWaitTimeout, "Maximum time to wait for the CRDs of the saved resources")
+
+	return cmd
+}
+
+func runRestore(cfg *restoreConfig) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	return modules.RestoreModule(cfg.Ctx, client, repo.NewModuleTemplatesRepo(client), cfg.backupDir, cfg.timeout)
+}
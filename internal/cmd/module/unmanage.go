@@ -1,8 +1,13 @@
 package module
 
 import (
+	"time"
+
 	"github.com/kyma-project/cli.v3/internal/clierror"
 	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/modules"
 	"github.com/kyma-project/cli.v3/internal/modulesv2/precheck"
 	"github.com/kyma-project/cli.v3/internal/out"
 	"github.com/spf13/cobra"
@@ -11,7 +16,12 @@ import (
 type unmanageConfig struct {
 	*cmdcommon.KymaConfig
 
-	module string
+	module  string
+	wait    bool
+	timeout time.Duration
+
+	dryRun       types.DryRun
+	outputFormat types.Format
 }
 
 func newUnmanageCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
@@ -23,8 +33,19 @@ func newUnmanageCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 		Use:   "unmanage <module> [flags]",
 		Short: "Sets a module to the unmanaged state",
 		Long:  "Use this command to set an existing module to the unmanaged state.",
-		Args:  cobra.ExactArgs(1),
+		Example: `  # Set the Keda module to the unmanaged state
+  kyma module unmanage keda
+
+  # Print changes of the Kyma CR without applying them
+  kyma module unmanage keda --dry-run`,
+
+		Args: cobra.ExactArgs(1),
 		PreRun: func(cmd *cobra.Command, args []string) {
+			clierror.Check(flags.Validate(cmd.Flags(),
+				flags.MarkPrerequisites("timeout", "wait"),
+				flags.MarkPrerequisites("output", "dry-run"),
+				flags.MarkExclusive("dry-run", "wait"),
+			))
 			clierror.Check(precheck.RequireKLMManaged(kymaConfig, precheck.CmdGroupStable))
 		},
 		Run: func(cmd *cobra.Command, args []string) {
@@ -33,6 +54,12 @@ func newUnmanageCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().BoolVar(&cfg.wait, "wait", false, "Waits until the module is unmanaged and prints its state changes")
+	cmd.Flags().DurationVar(&cfg.timeout, "timeout", modules.DefaultWaitTimeout, "Maximum time to wait for the module (used with --wait)")
+	cmd.Flags().Var(&cfg.dryRun, "dry-run", "Prints changes without applying them (Possible values: client, server)")
+	cmd.Flags().Lookup("dry-run").NoOptDefVal = string(types.ClientDryRun)
+	cmd.Flags().VarP(&cfg.outputFormat, "output", "o", "Output format of printed changes (Possible values: json, yaml; used with --dry-run)")
+
 	return cmd
 }
 
@@ -42,14 +69,25 @@ func runUnmanage(cfg *unmanageConfig) clierror.Error {
 		return clierr
 	}
 
+	if cfg.dryRun.Enabled() {
+		return modules.DryRunUnmanage(cfg.Ctx, client, cfg.module, newDryRunOptions(cfg.dryRun, cfg.outputFormat))
+	}
+
 	err := client.Kyma().UnmanageModule(cfg.Ctx, cfg.module)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to set the module as unmanaged"))
 	}
 
-	err = client.Kyma().WaitForModuleState(cfg.Ctx, cfg.module, "Unmanaged")
-	if err != nil {
-		return clierror.Wrap(err, clierror.New("failed to check the module state"))
+	if cfg.wait {
+		clierr = modules.WaitForModuleState(cfg.Ctx, client, cfg.module, cfg.timeout, "Unmanaged")
+		if clierr != nil {
+			return clierr
+		}
+	} else {
+		err = client.Kyma().WaitForModuleState(cfg.Ctx, cfg.module, "Unmanaged")
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to check the module state"))
+		}
 	}
 
 	out.Msgfln("Module %s set to unmanaged", cfg.module)
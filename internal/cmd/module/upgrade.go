@@ -0,0 +1,162 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/modules"
+	"github.com/kyma-project/cli.v3/internal/modules/integrity"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/modulesv2"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/precheck"
+	"github.com/spf13/cobra"
+)
+
+type upgradeConfig struct {
+	*cmdcommon.KymaConfig
+
+	module              string
+	modulePath          string
+	channel             string
+	version             string
+	remote              string
+	resourceMirrors     map[string]string
+	requireVerified     bool
+	publicKeys          []string
+	allowLocalResources bool
+}
+
+func newUpgradeCMD(kymaConfig *cmdcommon.KymaConfig) *cobra.Command {
+	cfg := upgradeConfig{
+		KymaConfig: kymaConfig,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "upgrade <module> [flags]",
+		Short: "Upgrades a module",
+		Long: `Use this command to upgrade an installed module without removing it.
+For core modules, the command switches the module to another channel.
+For community modules, the command pulls the module template in the given version and applies its resources. The module configuration (CR) is kept.`,
+		Example: `  # Switch the Keda module to the fast channel
+  kyma module upgrade keda --channel fast
+
+  ## Upgrade a community module to the given version
+  #  passed argument must be in the format <namespace>/<module-template-name> of the installed module
+  kyma module upgrade my-namespace/my-module-template-name --version 1.1.0
+
+  ## Upgrade a community module only if its resources are signed with the given key
+  kyma module upgrade my-namespace/my-module-template-name --version 1.1.0 --require-verified --public-key ./cosign.pub`,
+
+		Args: cobra.ExactArgs(1),
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			clierror.Check(flags.Validate(cmd.Flags(),
+				flags.MarkExactlyOneRequired("channel", "version"),
+				flags.MarkPrerequisites("remote-url", "version"),
+				flags.MarkPrerequisites("resource-mirror", "version"),
+				flags.MarkPrerequisites("require-verified", "version"),
+				flags.MarkPrerequisites("public-key", "version"),
+				flags.MarkPrerequisites("allow-local-resources", "version"),
+			))
+			clierror.Check(precheck.RequireCRD(kymaConfig, precheck.CmdGroupStable))
+		},
+		Run: func(_ *cobra.Command, args []string) {
+			cfg.complete(args)
+			clierror.Check(runUpgrade(&cfg))
+		},
+	}
+
+	cmd.Flags().StringVarP(&cfg.channel, "channel", "c", "", "Name of the Kyma channel to switch the core module to")
+	cmd.Flags().StringVarP(&cfg.version, "version", "v", "", "Version of the community module to upgrade to")
+	cmd.Flags().StringVar(&cfg.remote, "remote-url", "", "Catalog source that contains ModuleTemplate CRs: http(s) or file:// URL, local file or directory, or oci:// reference (defaults to official community catalog)")
+	cmd.Flags().StringToStringVar(&cfg.resourceMirrors, "resource-mirror", map[string]string{}, "Rewrites resource links of the module with the given prefix to the local mirror, in format <url-prefix>=<mirror-prefix>")
+	cmd.Flags().BoolVar(&cfg.requireVerified, "require-verified", false, "Refuses to upgrade community module resources without a digest or a signature")
+	cmd.Flags().StringSliceVar(&cfg.publicKeys, "public-key", []string{}, "Paths to PEM-encoded public keys used to check signatures of community module resources")
+	cmd.Flags().BoolVar(&cfg.allowLocalResources, "allow-local-resources", false, "Allows reading community module resources linked as local files (use only for modules pulled from trusted local catalogs or mirrors)")
+
+	return cmd
+}
+
+func (c *upgradeConfig) complete(args []string) {
+	if strings.Contains(args[0], "/") {
+		// arg is module location in format <namespace>/<module-template-name>
+		c.modulePath = args[0]
+		return
+	}
+
+	// arg is module name
+	c.module = args[0]
+}
+
+func runUpgrade(cfg *upgradeConfig) clierror.Error {
+	client, clierr := cfg.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	if cfg.modulePath != "" {
+		verifier, err := integrity.NewVerifier(cfg.requireVerified, cfg.publicKeys...)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to load public keys"))
+		}
+		if cfg.allowLocalResources {
+			// module templates from the cluster can't read local files unless the user trusts them
+			verifier = verifier.WithLocalLinks()
+		}
+
+		// resources of both versions are verified before anything is applied
+		return upgradeCommunityModule(cfg, client, repo.NewModuleTemplatesRepoWithVerifier(client, verifier))
+	}
+
+	return modules.UpgradeChannel(cfg.Ctx, client, repo.NewModuleTemplatesRepo(client), cfg.module, cfg.channel)
+}
+
+func upgradeCommunityModule(cfg *upgradeConfig, client kube.Client, moduleTemplatesRepo repo.ModuleTemplatesRepository) clierror.Error {
+	namespace, moduleTemplateName, err := validateOrigin(cfg.modulePath)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to identify the community module"))
+	}
+
+	moduleTemplate, err := modules.FindCommunityModuleTemplate(cfg.Ctx, namespace, moduleTemplateName, moduleTemplatesRepo)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to retrieve the module '%s/%s'", namespace, moduleTemplateName)))
+	}
+
+	// resources are restored from the module template of the version running in the cluster, not the one passed in the argument
+	installedModuleTemplate, err := modules.FindInstalledCommunityModuleTemplate(cfg.Ctx, moduleTemplatesRepo, moduleTemplate.Spec.ModuleName)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to check if the community module is installed"))
+	}
+	if installedModuleTemplate == nil {
+		return clierror.New(
+			fmt.Sprintf("the %s community module is not installed", moduleTemplate.Spec.ModuleName),
+			"to install the module, call the `kyma module add` command",
+		)
+	}
+
+	if installedModuleTemplate.Spec.Version == cfg.version {
+		return clierror.New(fmt.Sprintf("the %s community module is already in version %s", installedModuleTemplate.Spec.ModuleName, cfg.version))
+	}
+
+	pullOperation, err := modulesv2.NewModuleOperations(cfg.KymaConfig).Pull()
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to prepare the pull operation"))
+	}
+
+	pullConfigDto := dtos.NewPullConfig(installedModuleTemplate.Spec.ModuleName, namespace, cfg.remote, cfg.version, cfg.resourceMirrors)
+	pulledModule, err := pullOperation.Run(cfg.Ctx, pullConfigDto)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to pull the community module template into the target Kyma environment"))
+	}
+
+	newModuleTemplate, err := client.Kyma().GetModuleTemplate(cfg.Ctx, pulledModule.Namespace, pulledModule.ModuleTemplateName)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to get the pulled module template"))
+	}
+
+	return modules.UpgradeCommunityModule(cfg.Ctx, client, moduleTemplatesRepo, installedModuleTemplate, newModuleTemplate)
+}
@@ -0,0 +1,34 @@
+package cmdcommon
+
+import (
+	"slices"
+
+	"github.com/kyma-project/cli.v3/internal/kube"
+)
+
+// GetFleetContexts returns kubeconfig contexts for commands running against multiple clusters
+// it returns all contexts from the kubeconfig if allContexts is true, or contexts passed with repeated --context flags
+// nil is returned if the command runs against a single cluster
+func GetFleetContexts(allContexts bool) ([]string, error) {
+	if allContexts {
+		return kube.ListContexts(getStringFlagValue("--kubeconfig"))
+	}
+
+	contexts := []string{}
+	for _, context := range getStringFlagValues("--context") {
+		if !slices.Contains(contexts, context) {
+			contexts = append(contexts, context)
+		}
+	}
+
+	if len(contexts) < 2 {
+		return nil, nil
+	}
+
+	return contexts, nil
+}
+
+// NewKubeClientForContext creates the kube.Client for the context from the kubeconfig passed with the --kubeconfig flag
+func NewKubeClientForContext(context string) (kube.Client, error) {
+	return kube.NewClient(getStringFlagValue("--kubeconfig"), context)
+}
@@ -58,18 +58,28 @@ func (kcc *kubeClientConfig) complete() {
 // search os.Args manually to find if user pass --<flag_name> path and return its value
 func getStringFlagValue(flag string) string {
 	value := ""
+	if values := getStringFlagValues(flag); len(values) > 0 {
+		value = values[len(values)-1]
+	}
+
+	return value
+}
+
+// search os.Args manually to find all values of the repeated --<flag_name> flag
+func getStringFlagValues(flag string) []string {
+	values := []string{}
 	for i, arg := range os.Args {
 		// example: --kubeconfig /path/to/file
 		if arg == flag && len(os.Args) > i+1 {
-			value = os.Args[i+1]
+			values = append(values, os.Args[i+1])
 		}
 
 		// example: --kubeconfig=/path/to/file
 		argFields := strings.Split(arg, "=")
 		if strings.HasPrefix(arg, fmt.Sprintf("%s=", flag)) && len(argFields) == 2 {
-			value = argFields[1]
+			values = append(values, argFields[1])
 		}
 	}
 
-	return value
+	return values
 }
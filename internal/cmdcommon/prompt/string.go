@@ -0,0 +1,62 @@
+package prompt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/out"
+)
+
+type String struct {
+	reader       io.Reader
+	printer      *out.Printer
+	message      string
+	defaultValue string
+}
+
+func NewString(message, defaultValue string) *String {
+	return &String{
+		reader:       os.Stdin,
+		printer:      out.Default,
+		message:      message,
+		defaultValue: defaultValue,
+	}
+}
+
+func (s *String) Prompt() (string, error) {
+	s.printer.Msgf("%s%s: ", s.message, s.defaultValueDisplay())
+	scanner := bufio.NewScanner(s.reader)
+	scanner.Scan()
+	err := scanner.Err()
+	userInput := scanner.Text()
+	s.printer.Msg("\n")
+
+	if err != nil {
+		return "", err
+	}
+
+	return s.validateUserInput(userInput)
+}
+
+func (s *String) defaultValueDisplay() string {
+	if s.defaultValue == "" {
+		return ""
+	}
+	return fmt.Sprintf(" [%s]", s.defaultValue)
+}
+
+func (s *String) validateUserInput(userInput string) (string, error) {
+	trimmed := strings.TrimSpace(userInput)
+	if trimmed != "" {
+		return trimmed, nil
+	}
+
+	if s.defaultValue == "" {
+		return "", fmt.Errorf("no value was provided")
+	}
+
+	return s.defaultValue, nil
+}
@@ -0,0 +1,70 @@
+package prompt
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringPrompt_Table(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputReader  io.Reader
+		defaultValue string
+		expectResult string
+		expectOutput string
+		expectErr    string
+	}{
+		{
+			name:         "User input",
+			inputReader:  bytes.NewBufferString("  keda \n"),
+			defaultValue: "serverless",
+			expectResult: "keda",
+			expectOutput: "Module name [serverless]: \n",
+		},
+		{
+			name:         "Default value with empty input",
+			inputReader:  bytes.NewBufferString("\n"),
+			defaultValue: "serverless",
+			expectResult: "serverless",
+			expectOutput: "Module name [serverless]: \n",
+		},
+		{
+			name:         "No default value with empty input",
+			inputReader:  bytes.NewBufferString("\n"),
+			expectOutput: "Module name: \n",
+			expectErr:    "no value was provided",
+		},
+		{
+			name:         "Erroneous input",
+			inputReader:  iotest.ErrReader(errors.New("test error")),
+			expectOutput: "Module name: \n",
+			expectErr:    "test error",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			output := bytes.NewBuffer([]byte{})
+			s := String{
+				reader:       tc.inputReader,
+				printer:      out.NewToWriter(output),
+				message:      "Module name",
+				defaultValue: tc.defaultValue,
+			}
+
+			result, err := s.Prompt()
+			if tc.expectErr != "" {
+				require.EqualError(t, err, tc.expectErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectResult, result)
+			require.Equal(t, tc.expectOutput, output.String())
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	NoneDryRun   DryRun = ""
+	ClientDryRun DryRun = "client"
+	ServerDryRun DryRun = "server"
+)
+
+var (
+	availableDryRuns = []DryRun{
+		NoneDryRun,
+		ClientDryRun,
+		ServerDryRun,
+	}
+)
+
+// DryRun defines if and how changes are previewed instead of being applied
+type DryRun string
+
+func (d *DryRun) String() string {
+	return string(*d)
+}
+
+func (d *DryRun) Set(v string) error {
+	for _, dryRun := range availableDryRuns {
+		if v == dryRun.String() {
+			*d = DryRun(v)
+			return nil
+		}
+	}
+
+	return errors.New(fmt.Sprintf("invalid dry run strategy '%s', allowed values: client, server", v))
+}
+
+func (d *DryRun) Type() string {
+	return "string"
+}
+
+// Enabled returns true if changes should be previewed only
+func (d *DryRun) Enabled() bool {
+	return *d != NoneDryRun
+}
@@ -0,0 +1,41 @@
+package types
+
+import "strconv"
+
+type NullableFloat64 struct {
+	Value *float64
+}
+
+func (n *NullableFloat64) String() string {
+	if n.Value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*n.Value, 'g', -1, 64)
+}
+
+// SetValue sets the value of the NullableFloat64 from a string
+func (n *NullableFloat64) SetValue(value *string) error {
+	if value == nil {
+		return nil
+	}
+
+	nf, err := strconv.ParseFloat(*value, 64)
+	if err != nil {
+		return err
+	}
+	n.Value = &nf
+	return nil
+}
+
+// Set implements the flag.Value interface
+func (n *NullableFloat64) Set(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	return n.SetValue(&value)
+}
+
+func (n *NullableFloat64) Type() string {
+	return "number"
+}
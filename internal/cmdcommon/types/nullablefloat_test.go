@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullableFloat64_Set(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		want       *float64
+		wantString string
+		wantErr    bool
+	}{
+		{
+			name:       "empty",
+			value:      "",
+			want:       nil,
+			wantString: "",
+			wantErr:    false,
+		},
+		{
+			name:       "set fractional value",
+			value:      "0.75",
+			want:       toptr(0.75),
+			wantString: "0.75",
+			wantErr:    false,
+		},
+		{
+			name:       "set integer value",
+			value:      "3",
+			want:       toptr(float64(3)),
+			wantString: "3",
+			wantErr:    false,
+		},
+		{
+			name:       "incorrect",
+			value:      "incorrect",
+			want:       nil,
+			wantString: "",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nf := NullableFloat64{}
+			err := nf.Set(tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, nf.Value)
+			require.Equal(t, tt.wantString, nf.String())
+			require.Equal(t, "number", nf.Type())
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/snapshot"
 )
 
 type DiagnosticData struct {
@@ -14,6 +15,9 @@ type DiagnosticData struct {
 }
 
 func GetData(ctx context.Context, client kube.Client) DiagnosticData {
+	// collectors read the same cluster state so reads of module templates and module CRs are shared
+	client = snapshot.NewClient(client)
+
 	metadataCollector := NewMetadataCollector(client)
 	kymaSystemWarningsCollector := NewClusterWarningsCollector(client)
 	nodeResourceInfoCollector := NewNodeResourceInfoCollector(client)
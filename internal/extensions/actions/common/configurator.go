@@ -36,10 +36,13 @@ func (c *TemplateConfigurator[T]) configure(cfgTmpl types.ActionConfig, overwrit
 
 	out.Debugfln("Templated action config:\n%s\n", string(configBytes))
 
-	err = yaml.Unmarshal(configBytes, &c.Cfg)
+	// unmarshal to the empty config so values from the previous configuration (for example, of the earlier step) are not merged
+	var cfg T
+	err = yaml.Unmarshal(configBytes, &cfg)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to configure action"))
 	}
 
+	c.Cfg = cfg
 	return nil
 }
@@ -3,6 +3,7 @@ package common
 import (
 	"bytes"
 	"fmt"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/kyma-project/cli.v3/internal/clierror"
 	"github.com/kyma-project/cli.v3/internal/extensions/errors"
 	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"gopkg.in/yaml.v3"
 )
 
 // newFuncMap creates a template.FuncMap used in config templating
@@ -40,11 +42,7 @@ func newFuncMap(overwrites types.ActionConfigOverwrites) template.FuncMap {
 
 // templateConfig parses the given template and executes it with the provided overwrites
 func templateConfig(tmpl []byte, overwrites types.ActionConfigOverwrites) ([]byte, clierror.Error) {
-	configTmpl, err := template.
-		New("config").
-		Option("missingkey=zero").
-		Delims("${{", "}}").Funcs(newFuncMap(overwrites)).
-		Parse(string(tmpl))
+	configTmpl, err := parseConfigTemplate(tmpl, overwrites)
 	if err != nil {
 		return nil, clierror.Wrap(err, clierror.New("failed to parse config template"))
 	}
@@ -59,6 +57,95 @@ func templateConfig(tmpl []byte, overwrites types.ActionConfigOverwrites) ([]byt
 	return templatedConfig.Bytes(), nil
 }
 
+// ValidateConfigTemplate templates the config with the provided overwrites and checks if the result is a valid YAML
+// it's used to dry-run the config templating without configuring the action
+func ValidateConfigTemplate(cfgTmpl types.ActionConfig, overwrites types.ActionConfigOverwrites) error {
+	tmplBytes, err := yaml.Marshal(cfgTmpl)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal config template")
+	}
+
+	configTmpl, err := parseConfigTemplate(tmplBytes, overwrites)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse config template")
+	}
+
+	templatedConfig := bytes.NewBuffer([]byte{})
+	err = configTmpl.Execute(templatedConfig, overwrites)
+	if err != nil {
+		return errors.Wrap(err, "failed to execute config template")
+	}
+
+	var config map[string]interface{}
+	err = yaml.Unmarshal(templatedConfig.Bytes(), &config)
+	if err != nil {
+		return errors.Wrap(err, "templated config is not a valid YAML")
+	}
+
+	return nil
+}
+
+// ValidateConfigTemplateSyntax parses the config template without executing it
+// it's used when values referenced in the template, like outputs of earlier steps, are not known before the run
+func ValidateConfigTemplateSyntax(cfgTmpl types.ActionConfig) error {
+	tmplBytes, err := yaml.Marshal(cfgTmpl)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal config template")
+	}
+
+	_, err = parseConfigTemplate(tmplBytes, types.ActionConfigOverwrites{})
+	if err != nil {
+		return errors.Wrap(err, "failed to parse config template")
+	}
+
+	return nil
+}
+
+// ValidateConditionSyntax parses the condition template without executing it
+func ValidateConditionSyntax(condition string) error {
+	_, err := parseConfigTemplate([]byte(condition), types.ActionConfigOverwrites{})
+	if err != nil {
+		return errors.Wrap(err, "failed to parse condition")
+	}
+
+	return nil
+}
+
+// EvaluateCondition templates the condition with the provided overwrites and parses the result as a bool
+// the condition is false if it's rendered to an empty or missing value
+func EvaluateCondition(condition string, overwrites types.ActionConfigOverwrites) (bool, error) {
+	conditionTmpl, err := parseConfigTemplate([]byte(condition), overwrites)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to parse condition")
+	}
+
+	result := bytes.NewBuffer([]byte{})
+	err = conditionTmpl.Execute(result, overwrites)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to execute condition")
+	}
+
+	value := strings.TrimSpace(result.String())
+	if value == "" || value == "<no value>" {
+		return false, nil
+	}
+
+	isTrue, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.Newf("condition must be rendered to true or false, got '%s'", value)
+	}
+
+	return isTrue, nil
+}
+
+func parseConfigTemplate(tmpl []byte, overwrites types.ActionConfigOverwrites) (*template.Template, error) {
+	return template.
+		New("config").
+		Option("missingkey=zero").
+		Delims("${{", "}}").Funcs(newFuncMap(overwrites)).
+		Parse(string(tmpl))
+}
+
 // adds indentation to the beginning of each new line
 func newLineIndent(n int, s string) string {
 	return strings.ReplaceAll(s, "\n", fmt.Sprintf("\n%s", strings.Repeat(" ", n)))
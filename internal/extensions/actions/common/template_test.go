@@ -322,3 +322,57 @@ func TestToYaml(t *testing.T) {
 		assert.Contains(t, result, `"rate":"%!s(float64=3.14)"`)
 	})
 }
+
+func TestEvaluateCondition(t *testing.T) {
+	overwrites := types.ActionConfigOverwrites{
+		"flags": map[string]interface{}{
+			"wait": map[string]interface{}{
+				"value": true,
+			},
+		},
+		"steps": map[string]interface{}{
+			"get": map[string]interface{}{
+				"resource": map[string]interface{}{
+					"status": map[string]interface{}{"phase": "Ready"},
+				},
+			},
+		},
+	}
+
+	t.Run("flag value", func(t *testing.T) {
+		result, err := EvaluateCondition("${{ .flags.wait.value }}", overwrites)
+		assert.NoError(t, err)
+		assert.True(t, result)
+	})
+
+	t.Run("step output comparison", func(t *testing.T) {
+		result, err := EvaluateCondition(`${{ eq .steps.get.resource.status.phase "Failed" }}`, overwrites)
+		assert.NoError(t, err)
+		assert.False(t, result)
+	})
+
+	t.Run("missing value", func(t *testing.T) {
+		result, err := EvaluateCondition("${{ .flags.missing }}", overwrites)
+		assert.NoError(t, err)
+		assert.False(t, result)
+	})
+
+	t.Run("not a bool", func(t *testing.T) {
+		result, err := EvaluateCondition("maybe", overwrites)
+		assert.EqualError(t, err, "condition must be rendered to true or false, got 'maybe'")
+		assert.False(t, result)
+	})
+}
+
+func TestTemplateConfigurator_Configure(t *testing.T) {
+	t.Run("don't merge values from previous configuration", func(t *testing.T) {
+		configurator := TemplateConfigurator[map[string]interface{}]{}
+
+		err := configurator.Configure(types.ActionConfig{"first": "value"}, types.ActionConfigOverwrites{})
+		assert.Nil(t, err)
+		err = configurator.Configure(types.ActionConfig{"second": "value"}, types.ActionConfigOverwrites{})
+		assert.Nil(t, err)
+
+		assert.Equal(t, map[string]interface{}{"second": "value"}, configurator.Cfg)
+	})
+}
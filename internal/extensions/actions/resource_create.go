@@ -27,6 +27,7 @@ type resourceCreateAction struct {
 	common.TemplateConfigurator[resourceCreateActionConfig]
 
 	kymaConfig *cmdcommon.KymaConfig
+	outputs    map[string]interface{}
 }
 
 func NewResourceCreate(kymaConfig *cmdcommon.KymaConfig) types.Action {
@@ -54,6 +55,10 @@ func (a *resourceCreateAction) Run(cmd *cobra.Command, _ []string) clierror.Erro
 		return clierror.Wrap(err, clierror.New("failed to create resource"))
 	}
 
+	a.outputs = map[string]interface{}{
+		"resource": u.Object,
+	}
+
 	output, err := a.formatOutput(u)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to format output"))
@@ -68,6 +73,11 @@ func (a *resourceCreateAction) Run(cmd *cobra.Command, _ []string) clierror.Erro
 	return nil
 }
 
+// Outputs returns the applied resource under the resource key
+func (a *resourceCreateAction) Outputs() map[string]interface{} {
+	return a.outputs
+}
+
 func (a *resourceCreateAction) formatOutput(u *unstructured.Unstructured) (string, error) {
 	if a.Cfg.Output == cmd_types.JSONFormat {
 		obj, err := json.MarshalIndent(u.Object, "", "  ")
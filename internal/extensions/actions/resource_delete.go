@@ -19,6 +19,7 @@ type resourceDeleteAction struct {
 	common.TemplateConfigurator[resourceDeleteActionConfig]
 
 	kymaConfig *cmdcommon.KymaConfig
+	outputs    map[string]interface{}
 }
 
 func NewResourceDelete(kymaConfig *cmdcommon.KymaConfig) types.Action {
@@ -42,6 +43,10 @@ func (a *resourceDeleteAction) Run(cmd *cobra.Command, _ []string) clierror.Erro
 		return clierror.Wrap(err, clierror.New("failed to delete resource"))
 	}
 
+	a.outputs = map[string]interface{}{
+		"resource": u.Object,
+	}
+
 	messageSuffix := ""
 	if a.Cfg.DryRun {
 		messageSuffix = " (dry run)"
@@ -51,3 +56,8 @@ func (a *resourceDeleteAction) Run(cmd *cobra.Command, _ []string) clierror.Erro
 
 	return nil
 }
+
+// Outputs returns the deleted resource under the resource key
+func (a *resourceDeleteAction) Outputs() map[string]interface{} {
+	return a.outputs
+}
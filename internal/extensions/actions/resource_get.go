@@ -38,6 +38,7 @@ type resourceGetAction struct {
 	common.TemplateConfigurator[resourceGetActionConfig]
 
 	kymaConfig *cmdcommon.KymaConfig
+	outputs    map[string]interface{}
 }
 
 func NewResourceGet(kymaConfig *cmdcommon.KymaConfig) types.Action {
@@ -70,6 +71,8 @@ func (a *resourceGetAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
 		return clierror.Wrap(err, clierror.New("failed to get resource"))
 	}
 
+	a.outputs = buildGetOutputs(resources)
+
 	output, err := a.formatOutput(resources)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to format output"))
@@ -79,6 +82,27 @@ func (a *resourceGetAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
 	return nil
 }
 
+// Outputs returns all found resources under the resources key and the first one under the resource key
+func (a *resourceGetAction) Outputs() map[string]interface{} {
+	return a.outputs
+}
+
+func buildGetOutputs(resources *unstructured.UnstructuredList) map[string]interface{} {
+	objects := []interface{}{}
+	for _, resource := range resources.Items {
+		objects = append(objects, resource.Object)
+	}
+
+	outputs := map[string]interface{}{
+		"resources": objects,
+	}
+	if len(objects) > 0 {
+		outputs["resource"] = objects[0]
+	}
+
+	return outputs
+}
+
 func (a *resourceGetAction) formatOutput(resources *unstructured.UnstructuredList) (string, error) {
 	tableInfo := buildTableInfo(&a.Cfg)
 	outputParameters := convertResourcesToParameters(resources.Items, tableInfo)
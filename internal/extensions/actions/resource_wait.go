@@ -0,0 +1,189 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/itchyny/gojq"
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const defaultResourceWaitTimeout = 5 * time.Minute
+
+type resourceWaitActionConfig struct {
+	Resource map[string]interface{} `yaml:"resource"`
+	// Condition is a jq expression evaluated on the resource, the action waits until it returns a value other than false or null
+	Condition     string        `yaml:"condition"`
+	Timeout       time.Duration `yaml:"timeout"`
+	OutputMessage string        `yaml:"outputMessage"`
+}
+
+type resourceWaitAction struct {
+	common.TemplateConfigurator[resourceWaitActionConfig]
+
+	kymaConfig *cmdcommon.KymaConfig
+	outputs    map[string]interface{}
+}
+
+func NewResourceWait(kymaConfig *cmdcommon.KymaConfig) types.Action {
+	return &resourceWaitAction{
+		kymaConfig: kymaConfig,
+	}
+}
+
+func (a *resourceWaitAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
+	u := &unstructured.Unstructured{
+		Object: a.Cfg.Resource,
+	}
+
+	condition := a.Cfg.Condition
+	if condition == "" {
+		// wait until the resource exists
+		condition = "true"
+	}
+
+	query, err := gojq.Parse(condition)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to parse condition",
+			"make sure the condition is a valid jq expression"))
+	}
+
+	client, clierr := a.kymaConfig.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	timeout := a.Cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultResourceWaitTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(a.kymaConfig.Ctx, timeout)
+	defer cancel()
+
+	var result *unstructured.Unstructured
+	err = watchEvents(ctx, func() (watch.Interface, error) {
+		return client.RootlessDynamic().WatchSingleResource(ctx, u)
+	}, func(event watch.Event) (bool, error) {
+		obj, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			return false, nil
+		}
+
+		if event.Type == watch.Deleted {
+			return true, errors.Newf("resource %s was deleted", obj.GetName())
+		}
+
+		met, err := isConditionMet(query, obj)
+		if met {
+			result = obj
+		}
+		return met, err
+	})
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(
+			fmt.Sprintf("failed to wait for resource %s", u.GetName()),
+			"make sure the resource exists and the condition can be met",
+		))
+	}
+
+	a.outputs = map[string]interface{}{
+		"resource": result.Object,
+	}
+
+	if a.Cfg.OutputMessage != "" {
+		out.Msgln(a.Cfg.OutputMessage)
+	} else {
+		out.Msgfln("resource %s meets the condition", u.GetName())
+	}
+
+	return nil
+}
+
+// Outputs returns the resource that meets the condition under the resource key
+func (a *resourceWaitAction) Outputs() map[string]interface{} {
+	return a.outputs
+}
+
+// isConditionMet returns true if the query returns any value other than false or null
+func isConditionMet(query *gojq.Query, obj *unstructured.Unstructured) (bool, error) {
+	// gojq doesn't support int64 values used in unstructured objects, normalize numbers to float64
+	data, err := json.Marshal(obj.Object)
+	if err != nil {
+		return false, err
+	}
+
+	var normalized interface{}
+	err = json.Unmarshal(data, &normalized)
+	if err != nil {
+		return false, err
+	}
+
+	iter := query.Run(normalized)
+	for {
+		value, ok := iter.Next()
+		if !ok {
+			return false, nil
+		}
+
+		if _, isError := value.(error); isError {
+			// ignore errors because fields may not exist until the resource is processed
+			continue
+		}
+
+		if value != nil && value != false {
+			return true, nil
+		}
+	}
+}
+
+// watchEvents passes events of the started watch to the handler until the handler is done or the context is done
+// the watch is started again if the server closes it
+func watchEvents(ctx context.Context, startWatch func() (watch.Interface, error), handle func(watch.Event) (bool, error)) error {
+	for {
+		watcher, err := startWatch()
+		if err != nil {
+			return err
+		}
+
+		done, err := handleWatchEvents(ctx, watcher, handle)
+		watcher.Stop()
+		if done || err != nil {
+			return err
+		}
+	}
+}
+
+func handleWatchEvents(ctx context.Context, watcher watch.Interface, handle func(watch.Event) (bool, error)) (bool, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return true, ctx.Err()
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				// watch closed by the server
+				return false, nil
+			}
+
+			if event.Type == watch.Error {
+				return true, apierrors.FromObject(event.Object)
+			}
+
+			done, err := handle(event)
+			if done || err != nil {
+				return true, err
+			}
+		}
+	}
+}
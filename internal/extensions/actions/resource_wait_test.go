@@ -0,0 +1,205 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/itchyny/gojq"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func Test_isConditionMet(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"replicas": int64(1),
+		},
+		"status": map[string]interface{}{
+			"state": "Ready",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Installed", "status": "True"},
+				map[string]interface{}{"type": "Ready", "status": "False"},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name      string
+		condition string
+		want      bool
+	}{
+		{
+			name:      "condition met",
+			condition: `.status.state == "Ready"`,
+			want:      true,
+		},
+		{
+			name:      "condition not met",
+			condition: `.status.state == "Error"`,
+			want:      false,
+		},
+		{
+			name:      "compare int64 values",
+			condition: `.spec.replicas == 1`,
+			want:      true,
+		},
+		{
+			name:      "non-boolean value",
+			condition: `.status.state`,
+			want:      true,
+		},
+		{
+			name:      "missing field",
+			condition: `.status.message`,
+			want:      false,
+		},
+		{
+			name:      "any of many values",
+			condition: `.status.conditions[] | .status == "True"`,
+			want:      true,
+		},
+		{
+			name:      "ignore evaluation error",
+			condition: `.status.missing[] | .status == "True"`,
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := gojq.Parse(tt.condition)
+			require.NoError(t, err)
+
+			got, err := isConditionMet(query, obj)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_watchEvents(t *testing.T) {
+	pending := fixWatchEvent(watch.Added, "Pending")
+	ready := fixWatchEvent(watch.Modified, "Ready")
+	deleted := fixWatchEvent(watch.Deleted, "Ready")
+	forbidden := watch.Event{Type: watch.Error, Object: &metav1.Status{
+		Status:  metav1.StatusFailure,
+		Reason:  metav1.StatusReasonForbidden,
+		Message: "access denied",
+	}}
+
+	tests := []struct {
+		name string
+		// events sent by following watches, all watches except the last one are closed by the server
+		watches       [][]watch.Event
+		startErr      error
+		wantErr       string
+		wantStarts    int
+		wantHandled   int
+		wantCtxExpiry bool
+	}{
+		{
+			name:        "stop when the handler is done",
+			watches:     [][]watch.Event{{pending, ready, pending}},
+			wantStarts:  1,
+			wantHandled: 2,
+		},
+		{
+			name:        "restart watch closed by the server",
+			watches:     [][]watch.Event{{pending}, {}, {ready}},
+			wantStarts:  3,
+			wantHandled: 2,
+		},
+		{
+			name:        "return error event",
+			watches:     [][]watch.Event{{pending, forbidden, ready}},
+			wantErr:     "access denied",
+			wantStarts:  1,
+			wantHandled: 1,
+		},
+		{
+			name:        "return handler error",
+			watches:     [][]watch.Event{{deleted, ready}},
+			wantErr:     "resource deleted",
+			wantStarts:  1,
+			wantHandled: 1,
+		},
+		{
+			name:       "return start error",
+			watches:    [][]watch.Event{{ready}},
+			startErr:   errors.New("connection refused"),
+			wantErr:    "connection refused",
+			wantStarts: 1,
+		},
+		{
+			name:          "stop when context is done",
+			watches:       [][]watch.Event{{pending}},
+			wantStarts:    1,
+			wantHandled:   1,
+			wantCtxExpiry: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			starts := 0
+			startWatch := func() (watch.Interface, error) {
+				events := tt.watches[starts]
+				starts++
+				if tt.startErr != nil {
+					return nil, tt.startErr
+				}
+
+				watcher := watch.NewFakeWithChanSize(len(events), false)
+				for _, event := range events {
+					watcher.Action(event.Type, event.Object)
+				}
+				if starts < len(tt.watches) {
+					// buffered events are still delivered before the channel is closed
+					watcher.Stop()
+				}
+
+				return watcher, nil
+			}
+
+			handled := 0
+			handle := func(event watch.Event) (bool, error) {
+				handled++
+				if event.Type == watch.Deleted {
+					return true, errors.New("resource deleted")
+				}
+
+				state, _, _ := unstructured.NestedString(event.Object.(*unstructured.Unstructured).Object, "status", "state")
+				return state == "Ready", nil
+			}
+
+			err := watchEvents(ctx, startWatch, handle)
+
+			switch {
+			case tt.wantCtxExpiry:
+				require.ErrorIs(t, err, context.DeadlineExceeded)
+			case tt.wantErr != "":
+				require.ErrorContains(t, err, tt.wantErr)
+			default:
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.wantStarts, starts)
+			require.Equal(t, tt.wantHandled, handled)
+		})
+	}
+}
+
+func fixWatchEvent(eventType watch.EventType, state string) watch.Event {
+	return watch.Event{
+		Type: eventType,
+		Object: &unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "test"},
+			"status":   map[string]interface{}{"state": state},
+		}},
+	}
+}
@@ -0,0 +1,126 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	cmd_types "github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/kyma-project/cli.v3/internal/render"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+type resourceWatchActionConfig struct {
+	resourceGetActionConfig `yaml:",inline"`
+
+	// Timeout stops watching after the given time, resources are watched until the command is interrupted if it's empty
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+type resourceWatchAction struct {
+	common.TemplateConfigurator[resourceWatchActionConfig]
+
+	kymaConfig *cmdcommon.KymaConfig
+}
+
+func NewResourceWatch(kymaConfig *cmdcommon.KymaConfig) types.Action {
+	return &resourceWatchAction{
+		kymaConfig: kymaConfig,
+	}
+}
+
+func (a *resourceWatchAction) Run(cmd *cobra.Command, _ []string) clierror.Error {
+	u := &unstructured.Unstructured{
+		Object: a.Cfg.Resource,
+	}
+
+	client, clierr := a.kymaConfig.GetKubeClientWithClierr()
+	if clierr != nil {
+		return clierr
+	}
+
+	ctx, cancel := newWatchContext(a.kymaConfig.Ctx, a.Cfg.Timeout)
+	defer cancel()
+
+	printEvent := a.newEventPrinter()
+	err := watchEvents(ctx, func() (watch.Interface, error) {
+		if u.GetName() != "" {
+			return client.RootlessDynamic().WatchSingleResource(ctx, u)
+		}
+
+		return client.RootlessDynamic().Watch(ctx, u, &rootlessdynamic.ListOptions{
+			AllNamespaces: a.Cfg.FromAllNamespaces,
+		})
+	}, func(event watch.Event) (bool, error) {
+		obj, ok := event.Object.(*unstructured.Unstructured)
+		if !ok {
+			return false, nil
+		}
+
+		return false, printEvent(event.Type, obj)
+	})
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		return clierror.Wrap(err, clierror.New("failed to watch resources"))
+	}
+
+	return nil
+}
+
+// newWatchContext returns context done after the timeout or only when the parent is done if the timeout is empty
+func newWatchContext(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return context.WithCancel(parent)
+	}
+
+	return context.WithTimeout(parent, timeout)
+}
+
+// newEventPrinter returns func printing every event as a table row or as a separate JSON or YAML document
+func (a *resourceWatchAction) newEventPrinter() func(watch.EventType, *unstructured.Unstructured) error {
+	tableInfo := buildTableInfo(&a.Cfg.resourceGetActionConfig)
+
+	switch a.Cfg.OutputFormat {
+	case cmd_types.JSONFormat, cmd_types.YAMLFormat:
+		return func(eventType watch.EventType, obj *unstructured.Unstructured) error {
+			parameters := convertResourcesToParameters([]unstructured.Unstructured{*obj}, tableInfo)[0]
+			parameters["event"] = string(eventType)
+
+			if a.Cfg.OutputFormat == cmd_types.JSONFormat {
+				data, err := json.Marshal(parameters)
+				if err != nil {
+					return err
+				}
+				out.Msgln(string(data))
+				return nil
+			}
+
+			data, err := yaml.Marshal(parameters)
+			if err != nil {
+				return err
+			}
+			out.Msg("---\n" + string(data))
+			return nil
+		}
+	default:
+		var stream *render.TableStream
+		return func(eventType watch.EventType, obj *unstructured.Unstructured) error {
+			if stream == nil {
+				// render headers with the first event
+				stream = render.NewTableStream(out.Default.MsgWriter(), append([]interface{}{"event"}, tableInfo.Headers...))
+			}
+
+			stream.Row(append([]interface{}{string(eventType)}, tableInfo.RowConverter(*obj)...))
+			return nil
+		}
+	}
+}
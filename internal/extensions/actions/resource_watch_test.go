@@ -0,0 +1,29 @@
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newWatchContext(t *testing.T) {
+	t.Run("context with timeout", func(t *testing.T) {
+		ctx, cancel := newWatchContext(context.Background(), time.Minute)
+		defer cancel()
+
+		_, ok := ctx.Deadline()
+		require.True(t, ok)
+	})
+
+	t.Run("context without timeout", func(t *testing.T) {
+		ctx, cancel := newWatchContext(context.Background(), 0)
+
+		_, ok := ctx.Deadline()
+		require.False(t, ok)
+
+		cancel()
+		require.ErrorIs(t, ctx.Err(), context.Canceled)
+	})
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// argsValuePath is the path of the args value in the config overwrites
+const argsValuePath = ".args.value"
+
 type args struct {
 	run   func(*cobra.Command, []string) error
 	value parameters.Value
@@ -17,7 +20,7 @@ func buildArgs(extensionArgs *types.Args, overwrites map[string]interface{}) arg
 		return args{}
 	}
 
-	value := parameters.NewTyped(extensionArgs.Type, ".args.value")
+	value := parameters.NewTyped(extensionArgs.Type, argsValuePath)
 
 	// append args to overwrites
 	overwrites["args"] = map[string]interface{}{
@@ -0,0 +1,193 @@
+package extensions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	refreshExtensionsFlag = "--refresh-extensions"
+	// cacheRevalidationTimeout bounds the background revalidation so the CLI doesn't wait for an unreachable cluster on exit
+	cacheRevalidationTimeout = 3 * time.Second
+)
+
+// extensionsCache stores extension ConfigMaps fetched from the cluster identified by the server URL and the kubeconfig context
+type extensionsCache struct {
+	path    string
+	server  string
+	context string
+}
+
+type extensionsCacheFile struct {
+	Server     string         `json:"server"`
+	Context    string         `json:"context"`
+	ConfigMaps []v1.ConfigMap `json:"configMaps"`
+	// RefreshError contains the error of the last failed revalidation of cached ConfigMaps
+	RefreshError string `json:"refreshError,omitempty"`
+}
+
+// cacheRevalidation tracks revalidation of cached ConfigMaps running in the background
+type cacheRevalidation struct {
+	done chan struct{}
+	err  error
+	// previousErr is the error of the revalidation from the previous CLI invocation
+	previousErr error
+}
+
+// newExtensionsCache returns nil if the cluster can't be identified
+func newExtensionsCache(client kube.Client) *extensionsCache {
+	restConfig := client.RestConfig()
+	if restConfig == nil || restConfig.Host == "" {
+		return nil
+	}
+
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return nil
+	}
+
+	currentContext := ""
+	if apiConfig := client.APIConfig(); apiConfig != nil {
+		currentContext = apiConfig.CurrentContext
+	}
+
+	sum := sha256.Sum256([]byte(restConfig.Host + "\n" + currentContext))
+	return &extensionsCache{
+		path:    filepath.Join(cacheDir, "kyma", "extensions", hex.EncodeToString(sum[:])+".json"),
+		server:  restConfig.Host,
+		context: currentContext,
+	}
+}
+
+func (c *extensionsCache) read() (*extensionsCacheFile, error) {
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheFile := &extensionsCacheFile{}
+	err = json.Unmarshal(data, cacheFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse extensions cache file '%s'", c.path)
+	}
+
+	return cacheFile, nil
+}
+
+// write saves ConfigMaps to the cache file
+// the file is replaced atomically so concurrent CLI invocations never read a partially written cache
+func (c *extensionsCache) write(cms []v1.ConfigMap, refreshErr error) error {
+	cacheFile := extensionsCacheFile{
+		Server:     c.server,
+		Context:    c.context,
+		ConfigMaps: []v1.ConfigMap{},
+	}
+	for _, cm := range cms {
+		// keep only fields required to build extensions and to revalidate the cache
+		cacheFile.ConfigMaps = append(cacheFile.ConfigMaps, v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            cm.GetName(),
+				Namespace:       cm.GetNamespace(),
+				ResourceVersion: cm.GetResourceVersion(),
+			},
+			Data: cm.Data,
+		})
+	}
+	if refreshErr != nil {
+		cacheFile.RefreshError = refreshErr.Error()
+	}
+
+	data, err := json.Marshal(cacheFile)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(c.path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.Write(data)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), c.path)
+}
+
+// revalidate lists extension ConfigMaps in the background and updates the cache if their resource versions changed
+// commands are built from the cached ConfigMaps, so the refreshed set is used starting from the next CLI invocation
+func (c *extensionsCache) revalidate(ctx context.Context, client kube.Client, cacheFile *extensionsCacheFile) *cacheRevalidation {
+	revalidation := &cacheRevalidation{
+		done: make(chan struct{}),
+	}
+	if cacheFile.RefreshError != "" {
+		revalidation.previousErr = errors.New(cacheFile.RefreshError)
+	}
+
+	go func() {
+		defer close(revalidation.done)
+
+		ctx, cancel := context.WithTimeout(ctx, cacheRevalidationTimeout)
+		defer cancel()
+
+		cms, err := listCommandExtenionConfigMaps(ctx, client)
+		if err != nil {
+			revalidation.err = err
+			if cacheFile.RefreshError != err.Error() {
+				_ = c.write(cacheFile.ConfigMaps, err)
+			}
+			return
+		}
+
+		if cacheFile.RefreshError != "" || !maps.Equal(resourceVersions(cacheFile.ConfigMaps), resourceVersions(cms.Items)) {
+			_ = c.write(cms.Items, nil)
+		}
+	}()
+
+	return revalidation
+}
+
+// wait waits for the revalidation to finish and returns its error
+// it returns the error of the previous revalidation if the revalidation doesn't finish in the given time
+func (r *cacheRevalidation) wait(timeout time.Duration) error {
+	if r == nil {
+		return nil
+	}
+
+	select {
+	case <-r.done:
+		return r.err
+	case <-time.After(timeout):
+		return r.previousErr
+	}
+}
+
+func resourceVersions(cms []v1.ConfigMap) map[string]string {
+	versions := map[string]string{}
+	for _, cm := range cms {
+		versions[cm.GetNamespace()+"/"+cm.GetName()] = cm.GetResourceVersion()
+	}
+
+	return versions
+}
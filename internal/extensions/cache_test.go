@@ -0,0 +1,195 @@
+package extensions
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/cmdcommon"
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func Test_NewBuilder_cache(t *testing.T) {
+	expectedExtensions := []types.ConfigmapCommandExtension{
+		{
+			ConfigMapName:      "cm1",
+			ConfigMapNamespace: "kyma-system",
+			Extension:          testExtension,
+		},
+	}
+
+	t.Run("fetch extensions and write cache", func(t *testing.T) {
+		t.Setenv("XDG_CACHE_HOME", t.TempDir())
+		client := fixCacheKubeClient(k8sfake.NewClientset(fixTestExtensionConfigMap("cm1", testExtensionString)))
+
+		b := NewBuilder(fixCacheKymaConfig(client))
+
+		require.Empty(t, b.extensionsErrors)
+		require.Equal(t, expectedExtensions, b.extensions)
+		require.Nil(t, b.revalidation)
+
+		cacheFile, err := newExtensionsCache(client).read()
+		require.NoError(t, err)
+		require.Equal(t, "https://cluster.local", cacheFile.Server)
+		require.Equal(t, "test-context", cacheFile.Context)
+		require.Len(t, cacheFile.ConfigMaps, 1)
+	})
+
+	t.Run("build extensions from cache when cluster is unreachable", func(t *testing.T) {
+		t.Setenv("XDG_CACHE_HOME", t.TempDir())
+		fixExtensionsCache(t, fixCacheKubeClient(nil), fixTestExtensionConfigMap("cm1", testExtensionString))
+		client := fixCacheKubeClient(fixUnreachableClientset())
+
+		b := NewBuilder(fixCacheKymaConfig(client))
+		staleErr := b.revalidation.wait(time.Minute)
+
+		require.Empty(t, b.extensionsErrors)
+		require.Equal(t, expectedExtensions, b.extensions)
+		require.ErrorContains(t, staleErr, "connection refused")
+
+		cacheFile, err := newExtensionsCache(client).read()
+		require.NoError(t, err)
+		require.Contains(t, cacheFile.RefreshError, "connection refused")
+		require.Len(t, cacheFile.ConfigMaps, 1)
+	})
+
+	t.Run("revalidate cache with changed resource versions", func(t *testing.T) {
+		t.Setenv("XDG_CACHE_HOME", t.TempDir())
+		cachedCM := fixTestExtensionConfigMap("cm1", testExtensionString)
+		cachedCM.ResourceVersion = "1"
+		fixExtensionsCache(t, fixCacheKubeClient(nil), cachedCM)
+
+		client := fixCacheKubeClient(k8sfake.NewClientset(
+			fixTestExtensionConfigMap("cm2", testExtensionString),
+		))
+
+		b := NewBuilder(fixCacheKymaConfig(client))
+		staleErr := b.revalidation.wait(time.Minute)
+
+		// commands are built from the cache until the next invocation
+		require.Equal(t, expectedExtensions, b.extensions)
+		require.NoError(t, staleErr)
+
+		cacheFile, err := newExtensionsCache(client).read()
+		require.NoError(t, err)
+		require.Len(t, cacheFile.ConfigMaps, 1)
+		require.Equal(t, "cm2", cacheFile.ConfigMaps[0].Name)
+	})
+
+	t.Run("refresh extensions when cluster is unreachable", func(t *testing.T) {
+		t.Setenv("XDG_CACHE_HOME", t.TempDir())
+		oldArgs := os.Args
+		os.Args = append(os.Args, "--refresh-extensions")
+		defer func() { os.Args = oldArgs }()
+
+		fixExtensionsCache(t, fixCacheKubeClient(nil), fixTestExtensionConfigMap("cm1", testExtensionString))
+		client := fixCacheKubeClient(fixUnreachableClientset())
+
+		b := NewBuilder(fixCacheKymaConfig(client))
+
+		require.Empty(t, b.extensionsErrors)
+		require.Equal(t, expectedExtensions, b.extensions)
+		require.Nil(t, b.revalidation)
+		require.ErrorContains(t, b.staleCacheErr, "connection refused")
+	})
+}
+
+func Test_newExtensionsCache(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	t.Run("different contexts use different cache files", func(t *testing.T) {
+		client := fixCacheKubeClient(nil)
+		otherClient := fixCacheKubeClient(nil)
+		otherClient.TestAPIConfig = &api.Config{CurrentContext: "other-context"}
+
+		require.NotEqual(t, newExtensionsCache(client).path, newExtensionsCache(otherClient).path)
+	})
+
+	t.Run("skip cache for unknown cluster", func(t *testing.T) {
+		require.Nil(t, newExtensionsCache(&kubefake.KubeClient{}))
+	})
+}
+
+func fixCacheKubeClient(clientset *k8sfake.Clientset) *kubefake.KubeClient {
+	return &kubefake.KubeClient{
+		TestKubernetesInterface: clientset,
+		TestRestConfig:          &rest.Config{Host: "https://cluster.local"},
+		TestAPIConfig:           &api.Config{CurrentContext: "test-context"},
+	}
+}
+
+func fixCacheKymaConfig(client *kubefake.KubeClient) *cmdcommon.KymaConfig {
+	return &cmdcommon.KymaConfig{
+		Ctx: context.Background(),
+		KubeClientConfig: &fakeKubeClientConfig{
+			kubeClient: client,
+		},
+	}
+}
+
+func fixExtensionsCache(t *testing.T, client *kubefake.KubeClient, cms ...*corev1.ConfigMap) {
+	items := []corev1.ConfigMap{}
+	for _, cm := range cms {
+		items = append(items, *cm)
+	}
+
+	require.NoError(t, newExtensionsCache(client).write(items, nil))
+}
+
+func fixUnreachableClientset() *k8sfake.Clientset {
+	clientset := k8sfake.NewClientset()
+	clientset.PrependReactor("list", "configmaps", func(_ k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("connection refused")
+	})
+
+	return clientset
+}
+
+func Test_WaitForCacheRevalidation(t *testing.T) {
+	t.Run("display stale warning after failed revalidation", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		revalidation := &cacheRevalidation{
+			done: make(chan struct{}),
+			err:  errors.New("connection refused"),
+		}
+		close(revalidation.done)
+		b := Builder{
+			printer:      out.NewToWriter(buffer),
+			revalidation: revalidation,
+		}
+
+		b.WaitForCacheRevalidation()
+
+		require.Equal(t, "Extensions Warning:\n"+
+			"failed to refresh extensions from the target Kyma environment. Extensions are loaded from the cache and may be stale. Use the '--show-extensions-error' flag to see more details.\n\n", buffer.String())
+	})
+
+	t.Run("skip warning after successful revalidation", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		revalidation := &cacheRevalidation{
+			done:        make(chan struct{}),
+			previousErr: errors.New("connection refused"),
+		}
+		close(revalidation.done)
+		b := Builder{
+			printer:      out.NewToWriter(buffer),
+			revalidation: revalidation,
+		}
+
+		b.WaitForCacheRevalidation()
+
+		require.Equal(t, "", buffer.String())
+	})
+}
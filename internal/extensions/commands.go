@@ -48,7 +48,7 @@ func buildSingleCommand(extension types.Extension, availableActions types.Action
 		Long:  extension.Metadata.DescriptionLong,
 	}
 
-	if extension.Action == "" {
+	if extension.Action == "" && len(extension.Steps) == 0 {
 		// no action provided
 		// set help command as default run
 		cmd.RunE = emptyActionRun
@@ -80,6 +80,12 @@ func buildSingleCommand(extension types.Extension, availableActions types.Action
 	cmd.Args = cmdArgs.run
 	values = append(values, cmdArgs.value)
 
+	if len(extension.Steps) > 0 {
+		// set steps runs
+		setStepsRuns(cmd, extension.Steps, availableActions, overwrites, values, requiredFlags)
+		return cmd, errors.NewList(errs...)
+	}
+
 	// set action runs
 	action, ok := availableActions[extension.Action]
 	if !ok {
@@ -7,10 +7,12 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/kyma-project/cli.v3/internal/cmdcommon"
 	"github.com/kyma-project/cli.v3/internal/extensions/errors"
 	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/kube"
 	"github.com/kyma-project/cli.v3/internal/out"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -20,7 +22,14 @@ import (
 
 type Builder struct {
 	extensions       []types.ConfigmapCommandExtension
+	localExtensions  []types.LocalCommandExtension
 	extensionsErrors []error
+	// staleCacheErr is the error of fetching extensions from the cluster when cached extensions are used instead
+	staleCacheErr error
+	revalidation  *cacheRevalidation
+	// summaries and availableActions are set by the Build func
+	summaries        []ExtensionSummary
+	availableActions types.ActionsMap
 	printer          *out.Printer
 }
 
@@ -35,7 +44,12 @@ func NewBuilder(kymaConfig *cmdcommon.KymaConfig) *Builder {
 	}
 
 	var err error
-	config.extensions, err = loadCommandExtensionsFromCluster(kymaConfig.Ctx, kymaConfig.KubeClientConfig)
+	config.localExtensions, err = loadCommandExtensionsFromLocal()
+	if err != nil {
+		config.extensionsErrors = append(config.extensionsErrors, err)
+	}
+
+	config.extensions, err = config.loadCommandExtensionsFromCluster(kymaConfig.Ctx, kymaConfig.KubeClientConfig)
 	if err != nil {
 		config.extensionsErrors = append(config.extensionsErrors, err)
 	}
@@ -45,8 +59,10 @@ func NewBuilder(kymaConfig *cmdcommon.KymaConfig) *Builder {
 
 func AddCmdPersistentFlags(cmd *cobra.Command) {
 	// these flags are not operational. it's only to print the help description, and the help cobra with validation
-	_ = cmd.PersistentFlags().Bool("skip-extensions", false, "Skips fetching extensions from the target Kyma environment")
+	_ = cmd.PersistentFlags().Bool("skip-extensions", false, "Skips fetching extensions from the target Kyma environment and local directories")
 	_ = cmd.PersistentFlags().Bool("show-extensions-error", false, "Prints a possible error when fetching extensions fails")
+	_ = cmd.PersistentFlags().Bool("refresh-extensions", false, "Fetches extensions from the target Kyma environment instead of using the cached ones")
+	_ = cmd.PersistentFlags().String("extensions-dir", "", fmt.Sprintf("Path to the extension file or directory with extension files (takes precedence over the %s env and the ~/.kyma/extensions directory)", extensionsPathEnv))
 }
 
 func (b *Builder) DisplayWarnings() {
@@ -61,39 +77,109 @@ func (b *Builder) DisplayWarnings() {
 	} else if len(b.extensionsErrors) > 0 {
 		b.printer.Errln("Extensions Warning:\nfailed to fetch all extensions from the target Kyma environment. Use the '--show-extensions-error' flag to see more details.\n")
 	}
+
+	b.displayStaleCacheWarning(b.staleCacheErr)
+}
+
+// WaitForCacheRevalidation waits for the background revalidation of cached extensions
+// and displays a warning if extensions can't be refreshed from the target Kyma environment
+// it must be called before the CLI exits, otherwise the cache is not updated
+func (b *Builder) WaitForCacheRevalidation() {
+	if b.revalidation == nil {
+		return
+	}
+
+	// give the revalidation a moment to write the cache after its deadline
+	staleErr := b.revalidation.wait(cacheRevalidationTimeout + time.Second)
+	if isSubRootCommandUsed("help", "completion", "version") {
+		// skip if one of restricted flags is used
+		return
+	}
+
+	b.displayStaleCacheWarning(staleErr)
+}
+
+func (b *Builder) displayStaleCacheWarning(staleErr error) {
+	if staleErr != nil && getBoolFlagValue("--show-extensions-error") {
+		b.printer.Errfln("Extensions Warning:\nextensions are loaded from the cache and may be stale: %s\n", staleErr.Error())
+	} else if staleErr != nil {
+		b.printer.Errln("Extensions Warning:\nfailed to refresh extensions from the target Kyma environment. Extensions are loaded from the cache and may be stale. Use the '--show-extensions-error' flag to see more details.\n")
+	}
 }
 
-// Build - compose extensions based on extensions configmaps from a cluster
+// Build - compose extensions based on local extension files and extensions configmaps from a cluster
+// local extensions take precedence over cluster extensions with the same name
 // any errors can be displayed by using the DisplayExtensionsErrors func
 func (b *Builder) Build(parentCmd *cobra.Command, availableActions types.ActionsMap) {
-	for _, cmExt := range b.extensions {
-		// validate
-		err := cmExt.Extension.Validate()
+	b.availableActions = availableActions
+
+	localCommands := map[string]*cobra.Command{}
+	for _, localExt := range b.localExtensions {
+		summary := newExtensionSummary(localExt.Extension, fmt.Sprintf("file %s", localExt.Path), availableActions)
+		command, err := b.buildExtension(parentCmd, localExt.Extension, availableActions, fmt.Sprintf("file '%s'", localExt.Path))
 		if err != nil {
-			b.extensionsErrors = append(b.extensionsErrors,
-				errors.Wrapf(err, "failed to validate extension from configmap '%s/%s'", cmExt.ConfigMapNamespace, cmExt.ConfigMapName))
+			b.extensionsErrors = append(b.extensionsErrors, err)
+			b.summaries = append(b.summaries, summary.withError(err))
 			continue
 		}
 
-		// build final commands tree
-		command, err := buildCommand(cmExt.Extension, availableActions)
-		if err != nil {
-			b.extensionsErrors = append(b.extensionsErrors,
-				errors.Wrapf(err, "failed to build extension from configmap '%s/%s'", cmExt.ConfigMapNamespace, cmExt.ConfigMapName))
+		markLocalCommand(command, localExt.Path)
+		localCommands[command.Name()] = command
+		b.summaries = append(b.summaries, summary)
+	}
+
+	for _, cmExt := range b.extensions {
+		summary := newExtensionSummary(cmExt.Extension, fmt.Sprintf("configmap %s/%s", cmExt.ConfigMapNamespace, cmExt.ConfigMapName), availableActions)
+		if localCommand, ok := localCommands[cmExt.Extension.Metadata.Name]; ok {
+			localCommand.Long = fmt.Sprintf("%s\nIt overrides the extension from the '%s/%s' configmap.", localCommand.Long, cmExt.ConfigMapNamespace, cmExt.ConfigMapName)
+			summary.Status = ExtensionOverridden
+			b.summaries = append(b.summaries, summary)
 			continue
 		}
 
-		// check command duplicates
-		if hasCommand(parentCmd, command) {
-			b.extensionsErrors = append(b.extensionsErrors,
-				errors.Newf("failed to add extension from configmap '%s/%s': base command with name '%s' already exists",
-					cmExt.ConfigMapNamespace, cmExt.ConfigMapName, command.Name()))
-			continue
+		_, err := b.buildExtension(parentCmd, cmExt.Extension, availableActions, fmt.Sprintf("configmap '%s/%s'", cmExt.ConfigMapNamespace, cmExt.ConfigMapName))
+		if err != nil {
+			b.extensionsErrors = append(b.extensionsErrors, err)
+			summary = summary.withError(err)
 		}
+		b.summaries = append(b.summaries, summary)
+	}
+}
 
-		// append extension command
-		parentCmd.AddCommand(command)
+// buildExtension validates and builds the extension command and adds it to the parent command
+// returns an error if the command can't be added
+func (b *Builder) buildExtension(parentCmd *cobra.Command, extension types.Extension, availableActions types.ActionsMap, source string) (*cobra.Command, error) {
+	// validate
+	err := extension.Validate()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to validate extension from %s", source)
+	}
+
+	// build final commands tree
+	command, err := buildCommand(extension, availableActions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to build extension from %s", source)
 	}
+
+	// check command duplicates
+	if hasCommand(parentCmd, command) {
+		return nil, errors.Newf("failed to add extension from %s: base command with name '%s' already exists",
+			source, command.Name())
+	}
+
+	// append extension command
+	parentCmd.AddCommand(command)
+	return command, nil
+}
+
+func markLocalCommand(cmd *cobra.Command, path string) {
+	long := cmd.Long
+	if long == "" {
+		long = cmd.Short
+	}
+
+	cmd.Short = strings.TrimSpace(cmd.Short + " (local extension)")
+	cmd.Long = fmt.Sprintf("%s\n\nThis command is a local extension loaded from the '%s' file.", long, path)
 }
 
 func hasCommand(base *cobra.Command, cmd *cobra.Command) bool {
@@ -107,15 +193,53 @@ func hasCommand(base *cobra.Command, cmd *cobra.Command) bool {
 	return false
 }
 
-func loadCommandExtensionsFromCluster(ctx context.Context, clientConfig cmdcommon.KubeClientConfig) ([]types.ConfigmapCommandExtension, error) {
-	var cms, cmsError = listCommandExtenionConfigMaps(ctx, clientConfig)
-	if cmsError != nil {
-		return nil, cmsError
+// loadCommandExtensionsFromCluster reads extensions from the cache of the target cluster and revalidates the cache in the background
+// extensions are fetched from the cluster directly if the cache doesn't exist or the --refresh-extensions flag is used
+// cached extensions are used if the cluster is unreachable
+func (b *Builder) loadCommandExtensionsFromCluster(ctx context.Context, clientConfig cmdcommon.KubeClientConfig) ([]types.ConfigmapCommandExtension, error) {
+	client, clientErr := clientConfig.GetKubeClient()
+	if clientErr != nil {
+		return nil, clientErr
+	}
+
+	cache := newExtensionsCache(client)
+	if cache == nil {
+		// the cluster can't be identified, skip caching
+		cms, err := listCommandExtenionConfigMaps(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+
+		return parseCommandExtensionConfigMaps(cms.Items)
 	}
 
+	cacheFile, cacheErr := cache.read()
+	if cacheErr == nil && !getBoolFlagValue(refreshExtensionsFlag) {
+		b.revalidation = cache.revalidate(ctx, client, cacheFile)
+		return parseCommandExtensionConfigMaps(cacheFile.ConfigMaps)
+	}
+
+	cms, err := listCommandExtenionConfigMaps(ctx, client)
+	if err != nil && cacheErr == nil {
+		b.staleCacheErr = err
+		return parseCommandExtensionConfigMaps(cacheFile.ConfigMaps)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = cache.write(cms.Items, nil)
+	if err != nil {
+		b.printer.Debugfln("failed to write extensions cache: %v", err)
+	}
+
+	return parseCommandExtensionConfigMaps(cms.Items)
+}
+
+func parseCommandExtensionConfigMaps(cms []v1.ConfigMap) ([]types.ConfigmapCommandExtension, error) {
 	extensions := []types.ConfigmapCommandExtension{}
 	var parseErrors []error
-	for _, cm := range cms.Items {
+	for _, cm := range cms {
 		commandExtension, err := parseRequiredField[types.Extension](cm.Data, types.ExtensionCMDataKey)
 		if err != nil {
 			parseErrors = append(parseErrors,
@@ -142,12 +266,7 @@ func loadCommandExtensionsFromCluster(ctx context.Context, clientConfig cmdcommo
 	return extensions, errors.NewList(parseErrors...)
 }
 
-func listCommandExtenionConfigMaps(ctx context.Context, clientConfig cmdcommon.KubeClientConfig) (*v1.ConfigMapList, error) {
-	client, clientErr := clientConfig.GetKubeClient()
-	if clientErr != nil {
-		return nil, clientErr
-	}
-
+func listCommandExtenionConfigMaps(ctx context.Context, client kube.Client) (*v1.ConfigMapList, error) {
 	labelSelector := fmt.Sprintf("%s==%s", types.ExtensionCMLabelKey, types.ExtensionCMLabelValue)
 	cms, err := client.Static().CoreV1().ConfigMaps("").List(ctx, metav1.ListOptions{
 		LabelSelector: labelSelector,
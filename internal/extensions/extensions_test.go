@@ -343,6 +343,76 @@ func Test_Build(t *testing.T) {
 	})
 }
 
+func Test_Build_localExtensions(t *testing.T) {
+	t.Run("mark local extension", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		b := Builder{
+			localExtensions: []types.LocalCommandExtension{
+				{
+					Path:      "/tmp/resource.yaml",
+					Extension: testExtension,
+				},
+			},
+		}
+
+		b.Build(cmd, testActionsMap)
+
+		require.Empty(t, b.extensionsErrors)
+		require.Len(t, cmd.Commands(), 1)
+		require.Equal(t, "manage resources (local extension)", cmd.Commands()[0].Short)
+		require.Equal(t, "use to manage resources\n\nThis command is a local extension loaded from the '/tmp/resource.yaml' file.", cmd.Commands()[0].Long)
+	})
+
+	t.Run("local extension overrides cluster extension", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		b := Builder{
+			localExtensions: []types.LocalCommandExtension{
+				{
+					Path: "/tmp/resource.yaml",
+					Extension: types.Extension{
+						Metadata: types.Metadata{
+							Name:        "resource",
+							Description: "local resources",
+						},
+					},
+				},
+			},
+			extensions: []types.ConfigmapCommandExtension{
+				{
+					ConfigMapName:      "cm1",
+					ConfigMapNamespace: "ns",
+					Extension:          testExtension,
+				},
+			},
+		}
+
+		b.Build(cmd, testActionsMap)
+
+		require.Empty(t, b.extensionsErrors)
+		require.Len(t, cmd.Commands(), 1)
+		require.Equal(t, "local resources (local extension)", cmd.Commands()[0].Short)
+		require.Equal(t, "local resources\n\nThis command is a local extension loaded from the '/tmp/resource.yaml' file.\n"+
+			"It overrides the extension from the 'ns/cm1' configmap.", cmd.Commands()[0].Long)
+		require.Empty(t, cmd.Commands()[0].Commands())
+	})
+
+	t.Run("handle local validation error", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		b := Builder{
+			localExtensions: []types.LocalCommandExtension{
+				{
+					Path: "/tmp/empty.yaml",
+				},
+			},
+		}
+
+		b.Build(cmd, testActionsMap)
+
+		require.Equal(t, []error{errors.New("failed to validate extension from file '/tmp/empty.yaml':\n  wrong .metadata: empty name")}, b.extensionsErrors)
+		require.Empty(t, cmd.Commands())
+	})
+}
+
 func fixTestExtensionConfigMap(name, data string) *corev1.ConfigMap {
 	return fixTestConfigMap(name, map[string]string{
 		types.ExtensionCMDataKey: data,
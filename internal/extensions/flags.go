@@ -17,8 +17,7 @@ type flag struct {
 
 func buildFlag(commandFlag types.Flag, overwrites map[string]interface{}) flag {
 	flagOverwriteName := strings.ReplaceAll(commandFlag.Name, "-", "")
-	valuePath := fmt.Sprintf(".flags.%s.value", flagOverwriteName)
-	value := parameters.NewTyped(commandFlag.Type, valuePath)
+	value := parameters.NewTyped(commandFlag.Type, flagValuePath(commandFlag.Name))
 	warning := value.SetValue(commandFlag.DefaultValue)
 
 	pflag := &pflag.Flag{
@@ -52,3 +51,8 @@ func buildFlag(commandFlag types.Flag, overwrites map[string]interface{}) flag {
 		warning: warning,
 	}
 }
+
+// flagValuePath returns the path of the flag value in the config overwrites
+func flagValuePath(name string) string {
+	return fmt.Sprintf(".flags.%s.value", strings.ReplaceAll(name, "-", ""))
+}
@@ -0,0 +1,388 @@
+package extensions
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	cmdcommontypes "github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/parameters"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/kyma-project/cli.v3/internal/render"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ExtensionValid       = "Valid"
+	ExtensionInvalid     = "Invalid"
+	ExtensionUnsupported = "Unsupported"
+	ExtensionOverridden  = "Overridden"
+)
+
+// sampleValues are used to dry-run the config templating for every flag and args type
+var sampleValues = map[parameters.ConfigFieldType]string{
+	parameters.StringCustomType: "sample",
+	parameters.PathCustomType:   "sample file content",
+	parameters.IntCustomType:    "1",
+	parameters.NumberCustomType: "1.5",
+	parameters.BoolCustomType:   "true",
+	parameters.MapCustomType:    "key=value",
+}
+
+// ExtensionSummary describes an extension loaded from a local file or a cluster ConfigMap and the result of building its commands
+type ExtensionSummary struct {
+	Name        string   `json:"name" yaml:"name"`
+	Source      string   `json:"source" yaml:"source"`
+	Actions     []string `json:"actions" yaml:"actions"`
+	SubCommands []string `json:"subCommands" yaml:"subCommands"`
+	Status      string   `json:"status" yaml:"status"`
+	Error       string   `json:"error,omitempty" yaml:"error,omitempty"`
+
+	Extension types.Extension `json:"-" yaml:"-"`
+}
+
+func newExtensionSummary(extension types.Extension, source string, availableActions types.ActionsMap) ExtensionSummary {
+	summary := ExtensionSummary{
+		Name:        extension.Metadata.Name,
+		Source:      source,
+		Actions:     getExtensionActions(extension),
+		SubCommands: []string{},
+		Status:      ExtensionValid,
+		Extension:   extension,
+	}
+	for _, subCommand := range extension.SubCommands {
+		summary.SubCommands = append(summary.SubCommands, subCommand.Metadata.Name)
+	}
+
+	unsupported := []string{}
+	for _, action := range summary.Actions {
+		if _, ok := availableActions[action]; !ok {
+			unsupported = append(unsupported, action)
+		}
+	}
+	if len(unsupported) > 0 {
+		summary.Status = ExtensionUnsupported
+		summary.Error = fmt.Sprintf("unsupported actions: %s", strings.Join(unsupported, ", "))
+	}
+
+	return summary
+}
+
+func (s ExtensionSummary) withError(err error) ExtensionSummary {
+	s.Status = ExtensionInvalid
+	s.Error = err.Error()
+	return s
+}
+
+// Summaries returns extensions processed by the Build func in the order they were built
+func (b *Builder) Summaries() []ExtensionSummary {
+	return b.summaries
+}
+
+// GetSummary returns summaries of all extensions with the given name
+// there are many summaries if the local extension overrides the one from the cluster
+func (b *Builder) GetSummary(name string) []ExtensionSummary {
+	summaries := []ExtensionSummary{}
+	for _, summary := range b.summaries {
+		if summary.Name == name {
+			summaries = append(summaries, summary)
+		}
+	}
+
+	return summaries
+}
+
+// ValidateExtensionFile validates the extension from the file against actions available in the CLI
+// the file can contain the extension definition or the extension ConfigMap
+func (b *Builder) ValidateExtensionFile(path string) error {
+	extension, err := readExtensionFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read file '%s'", path)
+	}
+
+	err = ValidateExtension(*extension, b.availableActions)
+	if err != nil {
+		return errors.Wrapf(err, "failed to validate extension from file '%s'", path)
+	}
+
+	return nil
+}
+
+// ValidateExtension validates the extension definition, checks if all actions are available,
+// and dry-runs the config templating with sample flag and args values for every command
+func ValidateExtension(extension types.Extension, availableActions types.ActionsMap) error {
+	err := extension.Validate()
+	if err != nil {
+		// commands can't be built from the invalid definition
+		return err
+	}
+
+	return errors.NewList(validateExtensionActions(extension, availableActions, extension.Metadata.Name)...)
+}
+
+func validateExtensionActions(extension types.Extension, availableActions types.ActionsMap, commandPath string) []error {
+	var errs []error
+	if extension.Action != "" {
+		if _, ok := availableActions[extension.Action]; !ok {
+			errs = append(errs, errors.Newf("wrong command '%s': unsupported action '%s'", commandPath, extension.Action))
+		} else if err := dryRunConfigTemplate(extension); err != nil {
+			errs = append(errs, errors.Wrapf(err, "wrong command '%s'", commandPath))
+		}
+	}
+
+	for _, step := range extension.Steps {
+		if _, ok := availableActions[step.Action]; !ok {
+			errs = append(errs, errors.Newf("wrong command '%s': unsupported action '%s' in the '%s' step", commandPath, step.Action, step.Name))
+		} else if err := validateStepTemplates(step); err != nil {
+			errs = append(errs, errors.Wrapf(err, "wrong command '%s': '%s' step", commandPath, step.Name))
+		}
+	}
+	if len(extension.Steps) > 0 {
+		if _, err := sampleOverwrites(extension); err != nil {
+			errs = append(errs, errors.Wrapf(err, "wrong command '%s'", commandPath))
+		}
+	}
+
+	for _, subCommand := range extension.SubCommands {
+		errs = append(errs, validateExtensionActions(subCommand, availableActions, commandPath+" "+subCommand.Metadata.Name)...)
+	}
+
+	return errs
+}
+
+// dryRunConfigTemplate sets overwrites the same way as the built command does and templates the config
+func dryRunConfigTemplate(extension types.Extension) error {
+	overwrites, err := sampleOverwrites(extension)
+	if err != nil {
+		return err
+	}
+
+	return common.ValidateConfigTemplate(extension.Config, overwrites)
+}
+
+// validateStepTemplates only parses step templates because they can reference outputs of earlier steps known during the run
+func validateStepTemplates(step types.Step) error {
+	if step.If != "" {
+		err := common.ValidateConditionSyntax(step.If)
+		if err != nil {
+			return err
+		}
+	}
+
+	return common.ValidateConfigTemplateSyntax(step.Config)
+}
+
+// sampleOverwrites builds overwrites from flags and args of the extension set to sample values
+func sampleOverwrites(extension types.Extension) (types.ActionConfigOverwrites, error) {
+	overwrites := types.ActionConfigOverwrites{
+		"flags": map[string]interface{}{},
+	}
+	values := []parameters.Value{}
+	for _, extensionFlag := range extension.Flags {
+		cmdFlag := buildFlag(extensionFlag, overwrites)
+		if cmdFlag.warning != nil {
+			return nil, errors.Newf("flag '%s' error: %s", extensionFlag.Name, cmdFlag.warning.Error())
+		}
+
+		sample := sampleValues[extensionFlag.Type]
+		err := cmdFlag.value.SetValue(&sample)
+		if err != nil {
+			return nil, errors.Newf("flag '%s' error: %s", extensionFlag.Name, err.Error())
+		}
+		values = append(values, cmdFlag.value)
+	}
+
+	cmdArgs := buildArgs(extension.Args, overwrites)
+	if cmdArgs.value != nil {
+		sample := sampleValues[extension.Args.Type]
+		err := cmdArgs.value.SetValue(&sample)
+		if err != nil {
+			return nil, errors.Newf("args error: %s", err.Error())
+		}
+		values = append(values, cmdArgs.value)
+	}
+
+	clierr := parameters.Set(overwrites, values)
+	if clierr != nil {
+		return nil, errors.New(strings.TrimSpace(clierr.String()))
+	}
+
+	return overwrites, nil
+}
+
+func readExtensionFile(path string) (*types.Extension, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cm := struct {
+		Kind string            `yaml:"kind"`
+		Data map[string]string `yaml:"data"`
+	}{}
+	err = yaml.Unmarshal(data, &cm)
+	if err != nil {
+		return nil, err
+	}
+
+	if cm.Kind == "ConfigMap" {
+		return parseRequiredField[types.Extension](cm.Data, types.ExtensionCMDataKey)
+	}
+
+	var extension types.Extension
+	err = yaml.Unmarshal(data, &extension)
+	return &extension, err
+}
+
+// getExtensionActions returns unique actions used by the extension and its sub-commands
+func getExtensionActions(extension types.Extension) []string {
+	actions := []string{}
+	if extension.Action != "" {
+		actions = append(actions, extension.Action)
+	}
+
+	for _, step := range extension.Steps {
+		if !slices.Contains(actions, step.Action) {
+			actions = append(actions, step.Action)
+		}
+	}
+
+	for _, subCommand := range extension.SubCommands {
+		for _, action := range getExtensionActions(subCommand) {
+			if !slices.Contains(actions, action) {
+				actions = append(actions, action)
+			}
+		}
+	}
+
+	return actions
+}
+
+// RenderSummaries prints extensions summaries in the given format
+func RenderSummaries(summaries []ExtensionSummary, format cmdcommontypes.Format) error {
+	return renderSummaries(out.Default, summaries, format)
+}
+
+func renderSummaries(printer *out.Printer, summaries []ExtensionSummary, format cmdcommontypes.Format) error {
+	switch format {
+	case cmdcommontypes.JSONFormat:
+		obj, err := json.MarshalIndent(summaries, "", "  ")
+		if err != nil {
+			return err
+		}
+		printer.Msgln(string(obj))
+	case cmdcommontypes.YAMLFormat:
+		obj, err := yaml.Marshal(summaries)
+		if err != nil {
+			return err
+		}
+		printer.Msgln(string(obj))
+	default:
+		rows := [][]interface{}{}
+		for _, summary := range summaries {
+			rows = append(rows, []interface{}{
+				summary.Name,
+				summary.Source,
+				valueOrNone(strings.Join(summary.Actions, ", ")),
+				valueOrNone(strings.Join(summary.SubCommands, ", ")),
+				summary.Status,
+			})
+		}
+		render.Table(printer, []interface{}{"NAME", "SOURCE", "ACTIONS", "SUBCOMMANDS", "STATUS"}, rows)
+
+		for _, summary := range summaries {
+			if summary.Error != "" {
+				printer.Msgfln("\n%s extension from %s:\n%s", summary.Name, summary.Source, summary.Error)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RenderSummaryTree prints the extension source, status, and the command tree with flags and their config paths
+func RenderSummaryTree(summary ExtensionSummary) {
+	renderSummaryTree(out.Default, summary)
+}
+
+func renderSummaryTree(printer *out.Printer, summary ExtensionSummary) {
+	printer.Msgfln("Source: %s", summary.Source)
+	printer.Msgfln("Status: %s", summary.Status)
+	if summary.Error != "" {
+		printer.Msgfln("Error: %s", summary.Error)
+	}
+	printer.Msgln("")
+
+	render.Tree(printer.MsgWriter(), buildCommandTree(summary.Extension, "kyma "+summary.Extension.Metadata.Name))
+}
+
+func buildCommandTree(extension types.Extension, commandPath string) render.TreeNode {
+	text := commandPath
+	if extension.Action != "" {
+		text = fmt.Sprintf("%s (uses: %s)", commandPath, extension.Action)
+	}
+
+	node := render.TreeNode{Text: text}
+	if extension.Args != nil {
+		optional := "required"
+		if extension.Args.Optional {
+			optional = "optional"
+		}
+		node.Children = append(node.Children, render.TreeNode{
+			Text: fmt.Sprintf("args (%s, %s): %s", extension.Args.Type, optional, argsValuePath),
+		})
+	}
+
+	for _, extensionFlag := range extension.Flags {
+		node.Children = append(node.Children, render.TreeNode{
+			Text: fmt.Sprintf("%s (%s): %s", formatFlagName(extensionFlag), formatFlagDetails(extensionFlag), flagValuePath(extensionFlag.Name)),
+		})
+	}
+
+	for _, step := range extension.Steps {
+		text := fmt.Sprintf("step %s (uses: %s)", step.Name, step.Action)
+		if step.If != "" {
+			text = fmt.Sprintf("step %s (uses: %s, if: %s)", step.Name, step.Action, step.If)
+		}
+		node.Children = append(node.Children, render.TreeNode{Text: text})
+	}
+
+	for _, subCommand := range extension.SubCommands {
+		node.Children = append(node.Children, buildCommandTree(subCommand, commandPath+" "+subCommand.Metadata.Name))
+	}
+
+	return node
+}
+
+func formatFlagName(extensionFlag types.Flag) string {
+	if extensionFlag.Shorthand != "" {
+		return fmt.Sprintf("--%s, -%s", extensionFlag.Name, extensionFlag.Shorthand)
+	}
+
+	return "--" + extensionFlag.Name
+}
+
+func formatFlagDetails(extensionFlag types.Flag) string {
+	details := []string{string(extensionFlag.Type)}
+	if extensionFlag.Required {
+		details = append(details, "required")
+	}
+	if extensionFlag.DefaultValue != nil {
+		details = append(details, fmt.Sprintf("default %q", *extensionFlag.DefaultValue))
+	}
+
+	return strings.Join(details, ", ")
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+
+	return value
+}
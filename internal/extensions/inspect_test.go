@@ -0,0 +1,219 @@
+package extensions
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cmdcommontypes "github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/parameters"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Build_summaries(t *testing.T) {
+	cmd := &cobra.Command{}
+	b := Builder{
+		localExtensions: []types.LocalCommandExtension{
+			{Path: "/tmp/resource.yaml", Extension: testExtension},
+			{Path: "/tmp/empty.yaml"},
+		},
+		extensions: []types.ConfigmapCommandExtension{
+			{ConfigMapName: "cm1", ConfigMapNamespace: "ns", Extension: testExtension},
+			{ConfigMapName: "cm2", ConfigMapNamespace: "ns", Extension: types.Extension{
+				Metadata: types.Metadata{Name: "other"},
+				Action:   "unknown-action",
+			}},
+		},
+	}
+
+	b.Build(cmd, testActionsMap)
+
+	require.Equal(t, []ExtensionSummary{
+		{
+			Name:        "resource",
+			Source:      "file /tmp/resource.yaml",
+			Actions:     []string{"action-1", "action-2"},
+			SubCommands: []string{"create", "delete"},
+			Status:      ExtensionValid,
+			Extension:   testExtension,
+		},
+		{
+			Source:      "file /tmp/empty.yaml",
+			Actions:     []string{},
+			SubCommands: []string{},
+			Status:      ExtensionInvalid,
+			Error:       "failed to validate extension from file '/tmp/empty.yaml':\n  wrong .metadata: empty name",
+		},
+		{
+			Name:        "resource",
+			Source:      "configmap ns/cm1",
+			Actions:     []string{"action-1", "action-2"},
+			SubCommands: []string{"create", "delete"},
+			Status:      ExtensionOverridden,
+			Extension:   testExtension,
+		},
+		{
+			Name:        "other",
+			Source:      "configmap ns/cm2",
+			Actions:     []string{"unknown-action"},
+			SubCommands: []string{},
+			Status:      ExtensionUnsupported,
+			Error:       "unsupported actions: unknown-action",
+			Extension:   b.extensions[1].Extension,
+		},
+	}, b.Summaries())
+	require.Len(t, b.GetSummary("resource"), 2)
+}
+
+func TestValidateExtension(t *testing.T) {
+	t.Run("valid extension", func(t *testing.T) {
+		extension := types.Extension{
+			Metadata: types.Metadata{Name: "resource"},
+			Action:   "action-1",
+			Args:     &types.Args{Type: parameters.StringCustomType},
+			Flags: []types.Flag{
+				{Name: "replicas", Type: parameters.IntCustomType},
+				{Name: "labels", Type: parameters.MapCustomType},
+			},
+			Config: types.ActionConfig{
+				"name":     "${{ .args.value }}",
+				"replicas": "${{ .flags.replicas.value }}",
+				"labels":   "${{ .flags.labels.value | toYaml }}",
+			},
+		}
+
+		require.NoError(t, ValidateExtension(extension, testActionsMap))
+	})
+
+	t.Run("invalid definition", func(t *testing.T) {
+		err := ValidateExtension(types.Extension{}, testActionsMap)
+
+		require.Equal(t, errors.NewList(errors.New("wrong .metadata: empty name")), err)
+	})
+
+	t.Run("unsupported action and broken template", func(t *testing.T) {
+		extension := types.Extension{
+			Metadata: types.Metadata{Name: "resource"},
+			SubCommands: []types.Extension{
+				{
+					Metadata: types.Metadata{Name: "create"},
+					Action:   "unknown-action",
+				},
+				{
+					Metadata: types.Metadata{Name: "delete"},
+					Action:   "action-2",
+					Config: types.ActionConfig{
+						"name": "${{ .flags.name.value | unknownFunc }}",
+					},
+				},
+			},
+		}
+
+		err := ValidateExtension(extension, testActionsMap)
+
+		require.ErrorContains(t, err, "wrong command 'resource create': unsupported action 'unknown-action'")
+		require.ErrorContains(t, err, "wrong command 'resource delete': failed to parse config template")
+	})
+}
+
+func TestValidateExtension_steps(t *testing.T) {
+	extension := types.Extension{
+		Metadata: types.Metadata{Name: "resource"},
+		Steps: []types.Step{
+			{Name: "create", Action: "action-1", Config: types.ActionConfig{"name": "${{ .flags.name.value }}"}},
+			{Name: "get", Action: "action-2", If: "${{ .steps.create.resource", Config: types.ActionConfig{"name": "${{ .steps.create.resource.metadata.name }}"}},
+			{Name: "delete", Action: "unknown-action"},
+		},
+	}
+
+	err := ValidateExtension(extension, testActionsMap)
+
+	require.ErrorContains(t, err, "wrong command 'resource': 'get' step: failed to parse condition")
+	require.ErrorContains(t, err, "wrong command 'resource': unsupported action 'unknown-action' in the 'delete' step")
+	require.NotContains(t, err.Error(), "'create' step")
+}
+
+func Test_readExtensionFile(t *testing.T) {
+	t.Run("read extension from ConfigMap", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cm.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+data:
+  kyma-commands.yaml: |
+    metadata:
+      name: resource
+`), 0600))
+
+		extension, err := readExtensionFile(path)
+
+		require.NoError(t, err)
+		require.Equal(t, "resource", extension.Metadata.Name)
+	})
+
+	t.Run("read extension definition", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "extension.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(testExtensionString), 0600))
+
+		extension, err := readExtensionFile(path)
+
+		require.NoError(t, err)
+		require.Equal(t, &testExtension, extension)
+	})
+}
+
+func Test_renderSummaries(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{})
+	summaries := []ExtensionSummary{
+		{Name: "resource", Source: "configmap ns/cm1", Actions: []string{"action-1"}, SubCommands: []string{"create"}, Status: ExtensionValid},
+		{Name: "other", Source: "file /tmp/other.yaml", Actions: []string{}, SubCommands: []string{}, Status: ExtensionInvalid, Error: "wrong .metadata: empty name"},
+	}
+
+	err := renderSummaries(out.NewToWriter(buffer), summaries, cmdcommontypes.DefaultFormat)
+
+	require.NoError(t, err)
+	require.Regexp(t, `resource\s+configmap ns/cm1\s+action-1\s+create\s+Valid`, buffer.String())
+	require.Regexp(t, `other\s+file /tmp/other.yaml\s+<none>\s+<none>\s+Invalid`, buffer.String())
+	require.Contains(t, buffer.String(), "\nother extension from file /tmp/other.yaml:\nwrong .metadata: empty name\n")
+}
+
+func Test_renderSummaryTree(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{})
+	defaultNamespace := "default"
+	summary := ExtensionSummary{
+		Source: "configmap ns/cm1",
+		Status: ExtensionValid,
+		Extension: types.Extension{
+			Metadata: types.Metadata{Name: "resource"},
+			SubCommands: []types.Extension{
+				{
+					Metadata: types.Metadata{Name: "get"},
+					Action:   "action-1",
+					Args:     &types.Args{Type: parameters.StringCustomType, Optional: true},
+					Flags: []types.Flag{
+						{Name: "all-namespaces", Shorthand: "A", Type: parameters.BoolCustomType},
+						{Name: "namespace", Type: parameters.StringCustomType, Required: true, DefaultValue: &defaultNamespace},
+					},
+				},
+			},
+		},
+	}
+
+	renderSummaryTree(out.NewToWriter(buffer), summary)
+
+	require.Equal(t, "Source: configmap ns/cm1\n"+
+		"Status: Valid\n"+
+		"\n"+
+		"kyma resource\n"+
+		"└── kyma resource get (uses: action-1)\n"+
+		"    ├── args (string, optional): .args.value\n"+
+		"    ├── --all-namespaces, -A (bool): .flags.allnamespaces.value\n"+
+		"    └── --namespace (string, required, default \"default\"): .flags.namespace.value\n", buffer.String())
+}
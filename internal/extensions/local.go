@@ -0,0 +1,150 @@
+package extensions
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// extensionsPathEnv contains paths to extension files or directories separated by the OS path list separator
+	extensionsPathEnv = "KYMA_EXTENSIONS_PATH"
+	extensionsDirFlag = "--extensions-dir"
+)
+
+type localExtensionsPath struct {
+	path string
+	// optional paths are skipped if they don't exist
+	optional bool
+}
+
+// loadCommandExtensionsFromLocal reads extensions from the --extensions-dir flag, the KYMA_EXTENSIONS_PATH env, and the ~/.kyma/extensions directory
+// when names collide, extensions from earlier paths take precedence over extensions from later ones
+func loadCommandExtensionsFromLocal() ([]types.LocalCommandExtension, error) {
+	extensions := []types.LocalCommandExtension{}
+	var loadErrors []error
+	for _, path := range getLocalExtensionsPaths() {
+		pathExtensions, err := readLocalExtensions(path)
+		if err != nil {
+			loadErrors = append(loadErrors, err)
+		}
+
+		names := []string{}
+		for _, extension := range pathExtensions {
+			name := extension.Extension.Metadata.Name
+			if slices.Contains(names, name) {
+				loadErrors = append(loadErrors,
+					errors.Newf("failed to validate file '%s': extension with name '%s' already exists", extension.Path, name))
+				continue
+			}
+			names = append(names, name)
+
+			if slices.ContainsFunc(extensions, func(e types.LocalCommandExtension) bool {
+				return e.Extension.Metadata.Name == name
+			}) {
+				// overridden by the extension from the path with higher precedence
+				continue
+			}
+
+			extensions = append(extensions, extension)
+		}
+	}
+
+	return extensions, errors.NewList(loadErrors...)
+}
+
+func getLocalExtensionsPaths() []localExtensionsPath {
+	paths := []localExtensionsPath{}
+	if dir := getStringFlagValue(extensionsDirFlag); dir != "" {
+		paths = append(paths, localExtensionsPath{path: dir})
+	}
+
+	for _, path := range filepath.SplitList(os.Getenv(extensionsPathEnv)) {
+		if path != "" {
+			paths = append(paths, localExtensionsPath{path: path})
+		}
+	}
+
+	if home, err := os.UserHomeDir(); err == nil {
+		paths = append(paths, localExtensionsPath{path: filepath.Join(home, ".kyma", "extensions"), optional: true})
+	}
+
+	return paths
+}
+
+// readLocalExtensions reads the extension file or all YAML files from the directory
+func readLocalExtensions(path localExtensionsPath) ([]types.LocalCommandExtension, error) {
+	info, err := os.Stat(path.path)
+	if os.IsNotExist(err) && path.optional {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read extensions from '%s'", path.path)
+	}
+
+	files := []string{path.path}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path.path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read extensions from '%s'", path.path)
+		}
+
+		files = []string{}
+		for _, entry := range entries {
+			ext := filepath.Ext(entry.Name())
+			if !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+				files = append(files, filepath.Join(path.path, entry.Name()))
+			}
+		}
+	}
+
+	extensions := []types.LocalCommandExtension{}
+	var parseErrors []error
+	for _, file := range files {
+		extension, err := readLocalExtension(file)
+		if err != nil {
+			parseErrors = append(parseErrors, errors.Wrapf(err, "failed to parse file '%s'", file))
+			continue
+		}
+
+		extensions = append(extensions, types.LocalCommandExtension{
+			Path:      file,
+			Extension: *extension,
+		})
+	}
+
+	return extensions, errors.NewList(parseErrors...)
+}
+
+func readLocalExtension(file string) (*types.Extension, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var extension types.Extension
+	err = yaml.Unmarshal(data, &extension)
+	return &extension, err
+}
+
+// search os.Args manually to find if user pass given flag and return its value
+func getStringFlagValue(flag string) string {
+	for i, arg := range os.Args {
+		// example: --extensions-dir ./extensions
+		if arg == flag && len(os.Args) > i+1 {
+			return os.Args[i+1]
+		}
+
+		// example: --extensions-dir=./extensions
+		if value, ok := strings.CutPrefix(arg, flag+"="); ok {
+			return value
+		}
+	}
+
+	return ""
+}
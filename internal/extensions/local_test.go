@@ -0,0 +1,92 @@
+package extensions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/extensions/errors"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_loadCommandExtensionsFromLocal(t *testing.T) {
+	t.Run("load extensions from flag, env, and home directory", func(t *testing.T) {
+		home := t.TempDir()
+		t.Setenv("HOME", home)
+		homeDir := filepath.Join(home, ".kyma", "extensions")
+		fixLocalExtensionFile(t, homeDir, "resource.yaml", testExtensionString)
+		fixLocalExtensionFile(t, homeDir, "home.yml", "metadata:\n  name: home\n")
+		fixLocalExtensionFile(t, homeDir, "README.md", "not an extension")
+
+		envFile := fixLocalExtensionFile(t, t.TempDir(), "env.yaml", "metadata:\n  name: resource\n  description: from env\n")
+		t.Setenv(extensionsPathEnv, envFile)
+
+		flagDir := t.TempDir()
+		flagFile := fixLocalExtensionFile(t, flagDir, "flag.yaml", "metadata:\n  name: flag\n")
+		oldArgs := os.Args
+		os.Args = append(os.Args, "--extensions-dir", flagDir)
+		defer func() { os.Args = oldArgs }()
+
+		extensions, err := loadCommandExtensionsFromLocal()
+
+		require.NoError(t, err)
+		require.Equal(t, []types.LocalCommandExtension{
+			{Path: flagFile, Extension: types.Extension{Metadata: types.Metadata{Name: "flag"}}},
+			{Path: envFile, Extension: types.Extension{Metadata: types.Metadata{Name: "resource", Description: "from env"}}},
+			{Path: filepath.Join(homeDir, "home.yml"), Extension: types.Extension{Metadata: types.Metadata{Name: "home"}}},
+		}, extensions)
+	})
+
+	t.Run("skip missing home directory", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv(extensionsPathEnv, "")
+
+		extensions, err := loadCommandExtensionsFromLocal()
+
+		require.NoError(t, err)
+		require.Empty(t, extensions)
+	})
+
+	t.Run("handle missing path, parse errors, and duplicates", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		dir := t.TempDir()
+		fixLocalExtensionFile(t, dir, "a.yaml", "metadata:\n  name: resource\n")
+		fixLocalExtensionFile(t, dir, "b.yaml", "metadata:\n  name: resource\n")
+		fixLocalExtensionFile(t, dir, "c.yaml", "metadata: [")
+		missingPath := filepath.Join(dir, "missing")
+		t.Setenv(extensionsPathEnv, dir+string(os.PathListSeparator)+missingPath)
+
+		extensions, err := loadCommandExtensionsFromLocal()
+
+		require.Equal(t, []types.LocalCommandExtension{
+			{Path: filepath.Join(dir, "a.yaml"), Extension: types.Extension{Metadata: types.Metadata{Name: "resource"}}},
+		}, extensions)
+		require.Equal(t, errors.NewList(
+			errors.NewList(errors.Newf("failed to parse file '%s': yaml: line 1: did not find expected node content", filepath.Join(dir, "c.yaml"))),
+			errors.Newf("failed to validate file '%s': extension with name 'resource' already exists", filepath.Join(dir, "b.yaml")),
+			errors.Newf("failed to read extensions from '%s': stat %s: no such file or directory", missingPath, missingPath),
+		), err)
+	})
+}
+
+func Test_getStringFlagValue(t *testing.T) {
+	oldArgs := os.Args
+	defer func() { os.Args = oldArgs }()
+
+	os.Args = []string{"kyma", "--extensions-dir", "./extensions"}
+	require.Equal(t, "./extensions", getStringFlagValue("--extensions-dir"))
+
+	os.Args = []string{"kyma", "--extensions-dir=./other"}
+	require.Equal(t, "./other", getStringFlagValue("--extensions-dir"))
+
+	os.Args = []string{"kyma"}
+	require.Equal(t, "", getStringFlagValue("--extensions-dir"))
+}
+
+func fixLocalExtensionFile(t *testing.T, dir, name, data string) string {
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(data), 0600))
+	return path
+}
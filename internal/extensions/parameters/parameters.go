@@ -26,6 +26,8 @@ func NewTyped(paramType ConfigFieldType, resourcepath string) Value {
 		return &pathValue{stringValue: stringValue{path: resourcepath}}
 	case IntCustomType:
 		return &int64Value{path: resourcepath}
+	case NumberCustomType:
+		return &float64Value{path: resourcepath}
 	case BoolCustomType:
 		return &boolValue{path: resourcepath}
 	case MapCustomType:
@@ -12,6 +12,7 @@ const (
 	StringCustomType ConfigFieldType = "string"
 	PathCustomType   ConfigFieldType = "path"
 	IntCustomType    ConfigFieldType = "int"
+	NumberCustomType ConfigFieldType = "number"
 	BoolCustomType   ConfigFieldType = "bool"
 	MapCustomType    ConfigFieldType = "map"
 )
@@ -21,6 +22,7 @@ var (
 		StringCustomType,
 		PathCustomType,
 		IntCustomType,
+		NumberCustomType,
 		BoolCustomType,
 		MapCustomType,
 	}
@@ -65,6 +67,19 @@ func (v *int64Value) GetPath() string {
 	return v.path
 }
 
+type float64Value struct {
+	cmdcommontypes.NullableFloat64
+	path string
+}
+
+func (v *float64Value) GetValue() interface{} {
+	return getValueOrNil(v.Value)
+}
+
+func (v *float64Value) GetPath() string {
+	return v.path
+}
+
 type stringValue struct {
 	cmdcommontypes.NullableString
 	path string
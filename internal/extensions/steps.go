@@ -0,0 +1,80 @@
+package extensions
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	"github.com/kyma-project/cli.v3/internal/extensions/parameters"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/kyma-project/cli.v3/internal/flags"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/spf13/cobra"
+)
+
+// setStepsRuns sets runs of the command that runs extension steps one by one
+// outputs of every step are available in templates of later steps under the .steps.<step name> path
+func setStepsRuns(cmd *cobra.Command, steps []types.Step, availableActions types.ActionsMap, overwrites types.ActionConfigOverwrites, values []parameters.Value, requiredFlags []string) {
+	for _, step := range steps {
+		if _, ok := availableActions[step.Action]; !ok {
+			// action not found
+			// set unsupported action run to inform user
+			cmd.Run = unsupportedActionRun
+			return
+		}
+	}
+
+	cmd.PreRun = func(_ *cobra.Command, _ []string) {
+		// check required flags
+		clierror.Check(flags.Validate(cmd.Flags(),
+			flags.MarkRequired(requiredFlags...),
+		))
+		// set parameters from flag and args as overwrites
+		clierror.Check(parameters.Set(overwrites, values))
+	}
+
+	cmd.Run = func(cmd *cobra.Command, args []string) {
+		// run steps
+		clierror.Check(runSteps(cmd, args, steps, availableActions, overwrites))
+	}
+}
+
+// runSteps configures and runs actions of steps in order and stops on the first failure
+func runSteps(cmd *cobra.Command, args []string, steps []types.Step, availableActions types.ActionsMap, overwrites types.ActionConfigOverwrites) clierror.Error {
+	stepsOutputs := map[string]interface{}{}
+	overwrites["steps"] = stepsOutputs
+
+	for _, step := range steps {
+		if step.If != "" {
+			run, err := common.EvaluateCondition(step.If, overwrites)
+			if err != nil {
+				return clierror.Wrap(err, clierror.New(
+					fmt.Sprintf("failed to evaluate the condition of the '%s' step", step.Name),
+					"ensure the CLI version is compatible with the extension",
+				))
+			}
+
+			if !run {
+				out.Debugfln("Skipping the '%s' step because its condition is not met", step.Name)
+				continue
+			}
+		}
+
+		action := availableActions[step.Action]
+		clierr := action.Configure(step.Config, overwrites)
+		if clierr != nil {
+			return clierror.WrapE(clierr, clierror.New(fmt.Sprintf("failed to configure the '%s' step", step.Name)))
+		}
+
+		clierr = action.Run(cmd, args)
+		if clierr != nil {
+			return clierror.WrapE(clierr, clierror.New(fmt.Sprintf("failed to run the '%s' step", step.Name)))
+		}
+
+		if actionOutputs, ok := action.(types.ActionOutputs); ok {
+			stepsOutputs[step.Name] = actionOutputs.Outputs()
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package extensions
+
+import (
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/extensions/actions/common"
+	"github.com/kyma-project/cli.v3/internal/extensions/parameters"
+	"github.com/kyma-project/cli.v3/internal/extensions/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_runSteps(t *testing.T) {
+	t.Run("pass outputs to later steps and skip steps with unmet conditions", func(t *testing.T) {
+		createAction := &stepAction{}
+		getAction := &stepAction{}
+		skippedAction := &stepAction{}
+		steps := []types.Step{
+			{Name: "create", Action: "create", Config: types.ActionConfig{
+				"metadata": map[string]interface{}{"name": "${{ .flags.name.value }}"},
+			}},
+			{Name: "skipped", Action: "skipped", If: "${{ .flags.wait.value }}"},
+			{Name: "get", Action: "get", If: `${{ eq .steps.create.resource.metadata.name "test" }}`, Config: types.ActionConfig{
+				"name": "${{ .steps.create.resource.metadata.name }}",
+			}},
+		}
+		overwrites := types.ActionConfigOverwrites{
+			"flags": map[string]interface{}{
+				"name": map[string]interface{}{"value": "test"},
+				"wait": map[string]interface{}{"value": false},
+			},
+		}
+
+		clierr := runSteps(&cobra.Command{}, []string{}, steps, types.ActionsMap{
+			"create":  createAction,
+			"get":     getAction,
+			"skipped": skippedAction,
+		}, overwrites)
+
+		require.Nil(t, clierr)
+		require.Equal(t, []map[string]interface{}{{"metadata": map[string]interface{}{"name": "test"}}}, createAction.runConfigs)
+		require.Empty(t, skippedAction.runConfigs)
+		require.Equal(t, []map[string]interface{}{{"name": "test"}}, getAction.runConfigs)
+	})
+
+	t.Run("stop on the first failure", func(t *testing.T) {
+		createAction := &stepAction{runError: clierror.New("resource already exists")}
+		getAction := &stepAction{}
+		steps := []types.Step{
+			{Name: "create", Action: "create"},
+			{Name: "get", Action: "get"},
+		}
+
+		clierr := runSteps(&cobra.Command{}, []string{}, steps, types.ActionsMap{
+			"create": createAction,
+			"get":    getAction,
+		}, types.ActionConfigOverwrites{})
+
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to run the 'create' step")
+		require.Contains(t, clierr.String(), "resource already exists")
+		require.Empty(t, getAction.runConfigs)
+	})
+
+	t.Run("condition error", func(t *testing.T) {
+		steps := []types.Step{
+			{Name: "create", Action: "create", If: "sometimes"},
+		}
+
+		clierr := runSteps(&cobra.Command{}, []string{}, steps, types.ActionsMap{
+			"create": &stepAction{},
+		}, types.ActionConfigOverwrites{})
+
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to evaluate the condition of the 'create' step")
+	})
+}
+
+func Test_buildCommand_steps(t *testing.T) {
+	t.Run("run steps with flag values", func(t *testing.T) {
+		createAction := &stepAction{}
+		extension := types.Extension{
+			Metadata: types.Metadata{Name: "create"},
+			Flags: []types.Flag{
+				{Name: "name", Type: parameters.StringCustomType},
+			},
+			Steps: []types.Step{
+				{Name: "create", Action: "create", Config: types.ActionConfig{"name": "${{ .flags.name.value }}"}},
+			},
+		}
+
+		cmd, err := buildCommand(extension, types.ActionsMap{"create": createAction})
+		require.NoError(t, err)
+
+		cmd.SetArgs([]string{"--name", "test"})
+		require.NoError(t, cmd.Execute())
+		require.Equal(t, []map[string]interface{}{{"name": "test"}}, createAction.runConfigs)
+	})
+
+	t.Run("unsupported step action", func(t *testing.T) {
+		extension := types.Extension{
+			Metadata: types.Metadata{Name: "create"},
+			Steps: []types.Step{
+				{Name: "create", Action: "unknown"},
+			},
+		}
+
+		cmd, err := buildCommand(extension, types.ActionsMap{})
+
+		require.NoError(t, err)
+		require.Nil(t, cmd.PreRun)
+		require.NotNil(t, cmd.Run)
+	})
+}
+
+// stepAction records configs of runs and returns the last config as the resource output
+type stepAction struct {
+	common.TemplateConfigurator[map[string]interface{}]
+
+	runError   clierror.Error
+	runConfigs []map[string]interface{}
+}
+
+func (a *stepAction) Run(_ *cobra.Command, _ []string) clierror.Error {
+	a.runConfigs = append(a.runConfigs, a.Cfg)
+	return a.runError
+}
+
+func (a *stepAction) Outputs() map[string]interface{} {
+	return map[string]interface{}{
+		"resource": a.Cfg,
+	}
+}
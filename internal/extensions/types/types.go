@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"regexp"
 	"slices"
 
 	"github.com/kyma-project/cli.v3/internal/clierror"
@@ -21,6 +22,12 @@ type Action interface {
 	Run(*cobra.Command, []string) clierror.Error
 }
 
+// ActionOutputs is implemented by actions that expose their results to later steps of the extension
+type ActionOutputs interface {
+	// Outputs returns results of the last run available in templates under the .steps.<step name> path
+	Outputs() map[string]interface{}
+}
+
 // map of allowed action commands in format ID: ACTION
 type ActionsMap map[string]Action
 
@@ -34,6 +41,12 @@ type ConfigmapCommandExtension struct {
 	Extension          Extension
 }
 
+// LocalCommandExtension is an extension read from a local file instead of a cluster ConfigMap
+type LocalCommandExtension struct {
+	Path      string
+	Extension Extension
+}
+
 type Metadata struct {
 	// name of the command group
 	Name string `yaml:"name"`
@@ -95,11 +108,41 @@ func (f *Flag) Validate() error {
 	return errors.JoinWithSeparator(", ", errs...)
 }
 
+var stepNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+type Step struct {
+	// name of the step used to access its outputs in templates of later steps
+	Name string `yaml:"name"`
+	// id of the functionality that cli will run in this step
+	Action string `yaml:"uses"`
+	// config passed to the action
+	Config ActionConfig `yaml:"with"`
+	// optional condition templated the same way as the config, the step is skipped if it's not rendered to true
+	If string `yaml:"if"`
+}
+
+func (s *Step) Validate() error {
+	var errs []error
+	if s.Name == "" {
+		errs = append(errs, errors.New("empty name"))
+	} else if !stepNameRegexp.MatchString(s.Name) {
+		errs = append(errs, errors.Newf("name '%s' must contain only letters, digits, and underscores, and can't start with a digit", s.Name))
+	}
+
+	if s.Action == "" {
+		errs = append(errs, errors.New("empty uses"))
+	}
+
+	return errors.JoinWithSeparator(", ", errs...)
+}
+
 type Extension struct {
 	// metadata (name, descriptions) for the command
 	Metadata Metadata `yaml:"metadata"`
 	// id of the functionality that cli will run when user use this command
 	Action string `yaml:"uses"`
+	// list of actions run one by one instead of the single action
+	Steps []Step `yaml:"steps"`
 	// flags used to set specific fields in config
 	Flags []Flag `yaml:"flags"`
 	// args used to set specific fields in config
@@ -132,6 +175,23 @@ func (e *Extension) validateWithPath(path string) error {
 		}
 	}
 
+	if e.Action != "" && len(e.Steps) > 0 {
+		errs = append(errs, errors.Newf("wrong %suses: can't be used together with steps", path))
+	}
+
+	stepNames := []string{}
+	for i := range e.Steps {
+		if stepErr := e.Steps[i].Validate(); stepErr != nil {
+			errs = append(errs, errors.Newf("wrong %ssteps[%d]: %s", path, i, stepErr))
+			continue
+		}
+
+		if slices.Contains(stepNames, e.Steps[i].Name) {
+			errs = append(errs, errors.Newf("wrong %ssteps[%d]: step with name '%s' already exists", path, i, e.Steps[i].Name))
+		}
+		stepNames = append(stepNames, e.Steps[i].Name)
+	}
+
 	for i := range e.SubCommands {
 		subCmdErr := e.SubCommands[i].validateWithPath(fmt.Sprintf("%ssubCommands[%d].", path, i))
 		if subCmdErr != nil {
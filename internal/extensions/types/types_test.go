@@ -85,6 +85,36 @@ func TestExtension_Validate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "validation error - broken steps",
+			wantErr: "wrong .uses: can't be used together with steps\n" +
+				"wrong .steps[0]: empty name, empty uses\n" +
+				"wrong .steps[1]: name 'get-secret' must contain only letters, digits, and underscores, and can't start with a digit\n" +
+				"wrong .steps[3]: step with name 'create' already exists",
+			extension: Extension{
+				Metadata: Metadata{
+					Name: "function",
+				},
+				Action: "create",
+				Steps: []Step{
+					{
+						// empty step
+					},
+					{
+						Name:   "get-secret",
+						Action: "resource_get",
+					},
+					{
+						Name:   "create",
+						Action: "resource_create",
+					},
+					{
+						Name:   "create",
+						Action: "resource_create",
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
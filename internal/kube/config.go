@@ -1,6 +1,8 @@
 package kube
 
 import (
+	"sort"
+
 	"github.com/kyma-project/cli.v3/internal/out"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -38,6 +40,22 @@ func apiConfig(kubeconfig, context string) (*api.Config, error) {
 	return api, err
 }
 
+// ListContexts returns sorted names of all contexts from the kubeconfig
+func ListContexts(kubeconfig string) ([]string, error) {
+	apiConfig, err := apiConfig(kubeconfig, "")
+	if err != nil {
+		return nil, err
+	}
+
+	contexts := make([]string, 0, len(apiConfig.Contexts))
+	for name := range apiConfig.Contexts {
+		contexts = append(contexts, name)
+	}
+	sort.Strings(contexts)
+
+	return contexts, nil
+}
+
 // setKubernetesDefaults sets default values on the provided client config for accessing the
 // Kubernetes API or returns an error if any of the defaults are impossible or invalid.
 func setKubernetesDefaults(config *rest.Config) error {
@@ -12,6 +12,11 @@ type FakeEnabledModule struct {
 	CustomResourcePolicy string
 }
 
+type FakeModuleChannel struct {
+	Name    string
+	Channel string
+}
+
 type KymaClient struct {
 	// outputs
 	ReturnErr                   error
@@ -30,6 +35,7 @@ type KymaClient struct {
 	UpdateDefaultKymas []kyma.Kyma
 	DisabledModules    []string
 	EnabledModules     []FakeEnabledModule
+	ModuleChannels     []FakeModuleChannel
 }
 
 func (c *KymaClient) ListModuleReleaseMeta(_ context.Context) (*kyma.ModuleReleaseMetaList, error) {
@@ -90,3 +96,11 @@ func (c *KymaClient) ManageModule(_ context.Context, _, _ string) error {
 func (c *KymaClient) UnmanageModule(_ context.Context, _ string) error {
 	return c.ReturnWaitForModuleErr
 }
+
+func (c *KymaClient) SetModuleChannel(_ context.Context, module, channel string) error {
+	c.ModuleChannels = append(c.ModuleChannels, FakeModuleChannel{
+		Name:    module,
+		Channel: channel,
+	})
+	return c.ReturnErr
+}
@@ -23,9 +23,19 @@ type RootlessDynamicClient struct {
 	ListObjs    []unstructured.Unstructured
 	RemovedObjs []unstructured.Unstructured
 	ApplyObjs   []unstructured.Unstructured
+	WatchObjs   []unstructured.Unstructured
+
+	// objects passed with the dryRun option
+	DryRunRemovedObjs []unstructured.Unstructured
+	DryRunApplyObjs   []unstructured.Unstructured
 }
 
-func (m *RootlessDynamicClient) Apply(_ context.Context, obj *unstructured.Unstructured, _ bool) error {
+func (m *RootlessDynamicClient) Apply(_ context.Context, obj *unstructured.Unstructured, dryRun bool) error {
+	if dryRun {
+		m.DryRunApplyObjs = append(m.DryRunApplyObjs, *obj)
+		return m.ReturnErr
+	}
+
 	m.ApplyObjs = append(m.ApplyObjs, *obj)
 	return m.ReturnErr
 }
@@ -45,7 +55,12 @@ func (m *RootlessDynamicClient) List(_ context.Context, obj *unstructured.Unstru
 	return m.ReturnListObjs, m.ReturnErr
 }
 
-func (m *RootlessDynamicClient) Remove(_ context.Context, obj *unstructured.Unstructured, _ bool) error {
+func (m *RootlessDynamicClient) Remove(_ context.Context, obj *unstructured.Unstructured, dryRun bool) error {
+	if dryRun {
+		m.DryRunRemovedObjs = append(m.DryRunRemovedObjs, *obj)
+		return m.ReturnRemoveErr
+	}
+
 	m.RemovedObjs = append(m.RemovedObjs, *obj)
 	return m.ReturnRemoveErr
 }
@@ -58,3 +73,8 @@ func (m *RootlessDynamicClient) RemoveMany(_ context.Context, objs []unstructure
 func (m *RootlessDynamicClient) WatchSingleResource(_ context.Context, obj *unstructured.Unstructured) (watch.Interface, error) {
 	return m.ReturnWatcher, m.ReturnWatchErr
 }
+
+func (m *RootlessDynamicClient) Watch(_ context.Context, obj *unstructured.Unstructured, _ *rootlessdynamic.ListOptions) (watch.Interface, error) {
+	m.WatchObjs = append(m.WatchObjs, *obj)
+	return m.ReturnWatcher, m.ReturnWatchErr
+}
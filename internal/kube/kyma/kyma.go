@@ -38,6 +38,7 @@ type Interface interface {
 	DisableModule(context.Context, string) error
 	ManageModule(context.Context, string, string) error
 	UnmanageModule(context.Context, string) error
+	SetModuleChannel(context.Context, string, string) error
 }
 
 type client struct {
@@ -266,6 +267,41 @@ func (c *client) UnmanageModule(ctx context.Context, moduleName string) error {
 	return c.UpdateDefaultKyma(ctx, kymaCR)
 }
 
+// SetModuleChannel changes channel of the given module and updates Kyma CR in the kyma-system namespace with it
+func (c *client) SetModuleChannel(ctx context.Context, moduleName, channel string) error {
+	kymaCR, err := c.GetDefaultKyma(ctx)
+	if err != nil {
+		return err
+	}
+
+	kymaCR, err = setModuleChannel(kymaCR, moduleName, channel)
+	if err != nil {
+		return err
+	}
+
+	return c.UpdateDefaultKyma(ctx, kymaCR)
+}
+
+// EnableModuleInKymaCR adds module to the given Kyma CR without updating it on the cluster
+func EnableModuleInKymaCR(kymaCR *Kyma, moduleName, moduleChannel, customResourcePolicy string) *Kyma {
+	return enableModule(kymaCR, moduleName, moduleChannel, customResourcePolicy)
+}
+
+// DisableModuleInKymaCR removes module from the given Kyma CR without updating it on the cluster
+func DisableModuleInKymaCR(kymaCR *Kyma, moduleName string) *Kyma {
+	return disableModule(kymaCR, moduleName)
+}
+
+// ManageModuleInKymaCR configures module as managed in the given Kyma CR without updating it on the cluster
+func ManageModuleInKymaCR(kymaCR *Kyma, moduleName, policy string) (*Kyma, error) {
+	return manageModule(kymaCR, moduleName, policy)
+}
+
+// UnmanageModuleInKymaCR configures module as unmanaged in the given Kyma CR without updating it on the cluster
+func UnmanageModuleInKymaCR(kymaCR *Kyma, moduleName string) (*Kyma, error) {
+	return unmanageModule(kymaCR, moduleName)
+}
+
 func checkModuleState(kymaObj runtime.Object, moduleName string, expectedStates ...string) error {
 	kyma := &Kyma{}
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(kymaObj.(*unstructured.Unstructured).Object, kyma)
@@ -356,6 +392,19 @@ func unmanageModule(kymaCR *Kyma, moduleName string) (*Kyma, error) {
 	return kymaCR, ErrModuleNotFound
 }
 
+func setModuleChannel(kymaCR *Kyma, moduleName, channel string) (*Kyma, error) {
+	for i, m := range kymaCR.Spec.Modules {
+		if m.Name == moduleName {
+			// module exists, update channel only
+			kymaCR.Spec.Modules[i].Channel = channel
+
+			return kymaCR, nil
+		}
+	}
+
+	return kymaCR, ErrModuleNotFound
+}
+
 func list[T any](ctx context.Context, client dynamic.Interface, gvr schema.GroupVersionResource) (*T, error) {
 	list, err := client.Resource(gvr).
 		List(ctx, metav1.ListOptions{})
@@ -869,3 +869,105 @@ func Test_unmanageModule(t *testing.T) {
 		})
 	}
 }
+
+func Test_setModuleChannel(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		kymaCR     *Kyma
+		moduleName string
+		channel    string
+		want       *Kyma
+		wantErr    error
+	}{
+		{
+			name:       "channel updated",
+			moduleName: "module",
+			channel:    "fast",
+			kymaCR: &Kyma{
+				Spec: KymaSpec{
+					Modules: []Module{
+						{
+							Name:                 "module",
+							Channel:              "regular",
+							Managed:              ptr.To(true),
+							CustomResourcePolicy: "CreateAndDelete",
+						},
+					},
+				},
+			},
+			want: &Kyma{
+				Spec: KymaSpec{
+					Modules: []Module{
+						{
+							Name:                 "module",
+							Channel:              "fast",
+							Managed:              ptr.To(true),
+							CustomResourcePolicy: "CreateAndDelete",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:       "channel reset to the default one",
+			moduleName: "module",
+			channel:    "",
+			kymaCR: &Kyma{
+				Spec: KymaSpec{
+					Modules: []Module{
+						{
+							Name:    "module",
+							Channel: "fast",
+						},
+					},
+				},
+			},
+			want: &Kyma{
+				Spec: KymaSpec{
+					Modules: []Module{
+						{
+							Name: "module",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:       "module not found",
+			moduleName: "module",
+			channel:    "fast",
+			kymaCR: &Kyma{
+				Spec: KymaSpec{
+					Modules: []Module{
+						{
+							Name: "other-module",
+						},
+					},
+				},
+			},
+			want: &Kyma{
+				Spec: KymaSpec{
+					Modules: []Module{
+						{
+							Name: "other-module",
+						},
+					},
+				},
+			},
+			wantErr: ErrModuleNotFound,
+		},
+	}
+	for _, tt := range tests {
+		kymaCR := tt.kymaCR
+		moduleName := tt.moduleName
+		channel := tt.channel
+		want := tt.want
+		wantErr := tt.wantErr
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := setModuleChannel(kymaCR, moduleName, channel)
+			require.Equal(t, wantErr, err)
+			require.Equal(t, want, got)
+		})
+	}
+}
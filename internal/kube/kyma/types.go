@@ -91,6 +91,10 @@ type CustomStateCheck struct {
 type Resource struct {
 	Name string `json:"name"`
 	Link string `json:"link"`
+	// Digest of the linked file in format sha256:<hex>
+	Digest string `json:"digest,omitempty"`
+	// Signature is a link to the detached signature of the linked file
+	Signature string `json:"signature,omitempty"`
 }
 
 // Kyma is the Schema for the kymas API.
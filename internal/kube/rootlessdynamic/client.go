@@ -23,6 +23,7 @@ type Interface interface {
 	Remove(context.Context, *unstructured.Unstructured, bool) error
 	RemoveMany(context.Context, []unstructured.Unstructured) error
 	WatchSingleResource(context.Context, *unstructured.Unstructured) (watch.Interface, error)
+	Watch(context.Context, *unstructured.Unstructured, *ListOptions) (watch.Interface, error)
 }
 
 type client struct {
@@ -203,6 +204,31 @@ func (c *client) WatchSingleResource(ctx context.Context, resource *unstructured
 	})
 }
 
+// Watch watches all resources of the given kind in the resource namespace or in all namespaces
+func (c *client) Watch(ctx context.Context, resource *unstructured.Unstructured, opts *ListOptions) (watch.Interface, error) {
+	group, version := groupVersion(resource.GetAPIVersion())
+	apiResource, err := c.discoverAPIResource(group, version, resource.GetKind())
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover API resource using discovery client: %w", err)
+	}
+
+	gvr := &schema.GroupVersionResource{
+		Group:    group,
+		Version:  version,
+		Resource: apiResource.Name,
+	}
+
+	if apiResource.Namespaced && !opts.AllNamespaces && resource.GetNamespace() != "" {
+		return c.dynamic.Resource(*gvr).Namespace(getResourceNamespace(resource)).Watch(ctx, metav1.ListOptions{
+			FieldSelector: opts.FieldSelector,
+		})
+	}
+
+	return c.dynamic.Resource(*gvr).Watch(ctx, metav1.ListOptions{
+		FieldSelector: opts.FieldSelector,
+	})
+}
+
 // applyResource creates or updates given object
 func applyResource(ctx context.Context, resourceInterface dynamic.ResourceInterface, resource *unstructured.Unstructured, dryRun bool) error {
 	dryRunOpts := []string{}
@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	clientgo_fake "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/dynamic"
 	dynamic_fake "k8s.io/client-go/dynamic/fake"
@@ -312,6 +313,44 @@ func Test_List(t *testing.T) {
 	})
 }
 
+func Test_Watch(t *testing.T) {
+	t.Run("watch namespaced resources", func(t *testing.T) {
+		obj, apiResource := fixSecretObjectAndApiResource()
+		ctx := context.Background()
+		dynamic := dynamic_fake.NewSimpleDynamicClient(scheme.Scheme)
+		client := fixRootlessDynamic(dynamic, []*metav1.APIResourceList{apiResource})
+
+		watcher, err := client.Watch(ctx, obj, &ListOptions{
+			AllNamespaces: true,
+		})
+		require.NoError(t, err)
+		defer watcher.Stop()
+
+		err = client.Apply(ctx, obj, false)
+		require.NoError(t, err)
+
+		event := <-watcher.ResultChan()
+		require.Equal(t, watch.Added, event.Type)
+		require.Equal(t, "test", event.Object.(*unstructured.Unstructured).GetName())
+	})
+
+	t.Run("watch resource error because can't be discovered", func(t *testing.T) {
+		obj, _ := fixSecretObjectAndApiResource()
+		ctx := context.Background()
+		dynamic := dynamic_fake.NewSimpleDynamicClient(scheme.Scheme)
+		client := fixRootlessDynamic(dynamic, []*metav1.APIResourceList{
+			{
+				GroupVersion: "v1",
+			},
+		})
+
+		_, err := client.Watch(ctx, obj, &ListOptions{
+			AllNamespaces: false,
+		})
+		require.ErrorContains(t, err, "failed to discover API resource using discovery client: resource 'Secret' in group '', and version 'v1' not registered on cluster")
+	})
+}
+
 func Test_Remove(t *testing.T) {
 	t.Run("remove namespaced resource", func(t *testing.T) {
 		obj, apiResource := fixSecretObjectAndApiResource()
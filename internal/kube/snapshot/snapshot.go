@@ -0,0 +1,215 @@
+package snapshot
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// NewClient returns the client that reads ModuleTemplates, ModuleReleaseMetas, and resources
+// from the cluster at most once and serves next reads from the cache
+// it's meant for read-only flows, like listing modules, where all reads of one command can see the same cluster state
+// writes and other reads are passed to the given client
+func NewClient(client kube.Client) kube.Client {
+	if _, ok := client.(*snapshotClient); ok {
+		return client
+	}
+
+	return &snapshotClient{
+		Client: client,
+		kymaClient: &kymaClient{
+			Interface: client.Kyma(),
+		},
+		rootlessDynamicClient: &rootlessDynamicClient{
+			Interface: client.RootlessDynamic(),
+		},
+	}
+}
+
+type snapshotClient struct {
+	kube.Client
+	kymaClient            *kymaClient
+	rootlessDynamicClient *rootlessDynamicClient
+}
+
+func (c *snapshotClient) Kyma() kyma.Interface {
+	return c.kymaClient
+}
+
+func (c *snapshotClient) RootlessDynamic() rootlessdynamic.Interface {
+	return c.rootlessDynamicClient
+}
+
+type kymaClient struct {
+	kyma.Interface
+	moduleTemplates    cache[*kyma.ModuleTemplateList]
+	moduleTemplate     cache[*kyma.ModuleTemplate]
+	moduleReleaseMetas cache[*kyma.ModuleReleaseMetaList]
+}
+
+func (c *kymaClient) ListModuleTemplate(ctx context.Context) (*kyma.ModuleTemplateList, error) {
+	list, err := c.listModuleTemplate(ctx)
+	if list == nil {
+		return nil, err
+	}
+
+	// copy items so callers can't modify cached items
+	listCopy := *list
+	listCopy.Items = make([]kyma.ModuleTemplate, len(list.Items))
+	for i := range list.Items {
+		listCopy.Items[i] = copyModuleTemplate(list.Items[i])
+	}
+	return &listCopy, err
+}
+
+// GetModuleTemplate looks for the ModuleTemplate in the cached list
+// and gets it from the cluster only if it's not there
+func (c *kymaClient) GetModuleTemplate(ctx context.Context, namespace, name string) (*kyma.ModuleTemplate, error) {
+	list, err := c.listModuleTemplate(ctx)
+	if err == nil {
+		for i := range list.Items {
+			if list.Items[i].GetNamespace() == namespace && list.Items[i].GetName() == name {
+				moduleTemplate := copyModuleTemplate(list.Items[i])
+				return &moduleTemplate, nil
+			}
+		}
+	}
+
+	moduleTemplate, err := c.moduleTemplate.get(namespace+"/"+name, func() (*kyma.ModuleTemplate, error) {
+		return c.Interface.GetModuleTemplate(ctx, namespace, name)
+	})
+	if moduleTemplate == nil {
+		return nil, err
+	}
+
+	moduleTemplateCopy := copyModuleTemplate(*moduleTemplate)
+	return &moduleTemplateCopy, err
+}
+
+func (c *kymaClient) listModuleTemplate(ctx context.Context) (*kyma.ModuleTemplateList, error) {
+	return c.moduleTemplates.get("", func() (*kyma.ModuleTemplateList, error) {
+		return c.Interface.ListModuleTemplate(ctx)
+	})
+}
+
+func (c *kymaClient) ListModuleReleaseMeta(ctx context.Context) (*kyma.ModuleReleaseMetaList, error) {
+	list, err := c.moduleReleaseMetas.get("", func() (*kyma.ModuleReleaseMetaList, error) {
+		return c.Interface.ListModuleReleaseMeta(ctx)
+	})
+	if list == nil {
+		return nil, err
+	}
+
+	listCopy := *list
+	listCopy.Items = make([]kyma.ModuleReleaseMeta, len(list.Items))
+	for i := range list.Items {
+		listCopy.Items[i] = copyModuleReleaseMeta(list.Items[i])
+	}
+	return &listCopy, err
+}
+
+// copyModuleTemplate returns the copy of the module template that doesn't share maps, slices and pointers with the given one
+func copyModuleTemplate(moduleTemplate kyma.ModuleTemplate) kyma.ModuleTemplate {
+	moduleTemplate.ObjectMeta = *moduleTemplate.ObjectMeta.DeepCopy()
+	moduleTemplate.Spec.Data.Object = copyJSONObject(moduleTemplate.Spec.Data.Object)
+	moduleTemplate.Spec.Descriptor = *moduleTemplate.Spec.Descriptor.DeepCopy()
+	moduleTemplate.Spec.CustomStateCheck = slices.Clone(moduleTemplate.Spec.CustomStateCheck)
+	moduleTemplate.Spec.Resources = slices.Clone(moduleTemplate.Spec.Resources)
+	moduleTemplate.Spec.Info.Icons = slices.Clone(moduleTemplate.Spec.Info.Icons)
+	moduleTemplate.Spec.AssociatedResources = slices.Clone(moduleTemplate.Spec.AssociatedResources)
+	if moduleTemplate.Spec.Manager != nil {
+		manager := *moduleTemplate.Spec.Manager
+		moduleTemplate.Spec.Manager = &manager
+	}
+
+	return moduleTemplate
+}
+
+func copyModuleReleaseMeta(moduleReleaseMeta kyma.ModuleReleaseMeta) kyma.ModuleReleaseMeta {
+	moduleReleaseMeta.ObjectMeta = *moduleReleaseMeta.ObjectMeta.DeepCopy()
+	moduleReleaseMeta.Spec.Channels = slices.Clone(moduleReleaseMeta.Spec.Channels)
+
+	return moduleReleaseMeta
+}
+
+func copyJSONObject(obj map[string]any) map[string]any {
+	if obj == nil {
+		return nil
+	}
+
+	return runtime.DeepCopyJSONValue(obj).(map[string]any)
+}
+
+type rootlessDynamicClient struct {
+	rootlessdynamic.Interface
+	objects cache[*unstructured.Unstructured]
+	lists   cache[*unstructured.UnstructuredList]
+}
+
+func (c *rootlessDynamicClient) Get(ctx context.Context, resource *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	key := resource.GetAPIVersion() + "/" + resource.GetKind() + "/" + resource.GetNamespace() + "/" + resource.GetName()
+	obj, err := c.objects.get(key, func() (*unstructured.Unstructured, error) {
+		return c.Interface.Get(ctx, resource)
+	})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.DeepCopy(), err
+}
+
+// List caches only resources listed from all namespaces without the field selector
+// because that's how module custom resources are listed to get module states
+func (c *rootlessDynamicClient) List(ctx context.Context, resource *unstructured.Unstructured, opts *rootlessdynamic.ListOptions) (*unstructured.UnstructuredList, error) {
+	if opts == nil || !opts.AllNamespaces || opts.FieldSelector != "" {
+		return c.Interface.List(ctx, resource, opts)
+	}
+
+	key := resource.GetAPIVersion() + "/" + resource.GetKind()
+	list, err := c.lists.get(key, func() (*unstructured.UnstructuredList, error) {
+		return c.Interface.List(ctx, resource, opts)
+	})
+	if list == nil {
+		return nil, err
+	}
+
+	return list.DeepCopy(), err
+}
+
+// cache stores results of reads by key
+// concurrent reads with the same key wait for the first one so the cluster is called once
+type cache[T any] struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry[T]
+}
+
+type cacheEntry[T any] struct {
+	once  sync.Once
+	value T
+	err   error
+}
+
+func (c *cache[T]) get(key string, read func() (T, error)) (T, error) {
+	c.mu.Lock()
+	if c.entries == nil {
+		c.entries = map[string]*cacheEntry[T]{}
+	}
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &cacheEntry[T]{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		entry.value, entry.err = read()
+	})
+
+	return entry.value, entry.err
+}
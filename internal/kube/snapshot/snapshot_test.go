@@ -0,0 +1,202 @@
+package snapshot
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgo_fake "k8s.io/client-go/discovery/fake"
+	dynamic_fake "k8s.io/client-go/dynamic/fake"
+	clientgo_testing "k8s.io/client-go/testing"
+)
+
+func TestNewClient(t *testing.T) {
+	t.Run("lists module templates once", func(t *testing.T) {
+		dynamicClient := fixDynamicClient(fixModuleTemplate("keda-1.0.0"), fixModuleTemplate("istio-1.0.0"))
+		client := NewClient(&fake.KubeClient{TestKymaInterface: kyma.NewClient(dynamicClient)})
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				list, err := client.Kyma().ListModuleTemplate(context.Background())
+				require.NoError(t, err)
+				require.Len(t, list.Items, 2)
+			}()
+		}
+		wg.Wait()
+
+		moduleTemplate, err := client.Kyma().GetModuleTemplate(context.Background(), "kyma-system", "istio-1.0.0")
+		require.NoError(t, err)
+		require.Equal(t, "istio-1.0.0", moduleTemplate.GetName())
+
+		require.Len(t, dynamicClient.Actions(), 1)
+	})
+
+	t.Run("returns copies of cached module templates", func(t *testing.T) {
+		moduleTemplate := fixModuleTemplate("keda-1.0.0")
+		moduleTemplate.Object["spec"] = map[string]interface{}{
+			"moduleName": "keda",
+			"data": map[string]interface{}{
+				"apiVersion": "operator.kyma-project.io/v1alpha1",
+				"kind":       "Keda",
+				"metadata":   map[string]interface{}{"name": "default"},
+			},
+			"manager": map[string]interface{}{"name": "keda-manager"},
+		}
+		dynamicClient := fixDynamicClient(moduleTemplate)
+		client := NewClient(&fake.KubeClient{TestKymaInterface: kyma.NewClient(dynamicClient)})
+
+		list, err := client.Kyma().ListModuleTemplate(context.Background())
+		require.NoError(t, err)
+		list.Items[0].Spec.Data.SetName("modified")
+		list.Items[0].Spec.Manager.Name = "modified"
+		list.Items[0].SetLabels(map[string]string{"modified": "true"})
+
+		got, err := client.Kyma().GetModuleTemplate(context.Background(), "kyma-system", "keda-1.0.0")
+		require.NoError(t, err)
+		require.Equal(t, "default", got.Spec.Data.GetName())
+		require.Equal(t, "keda-manager", got.Spec.Manager.Name)
+		require.Empty(t, got.GetLabels())
+		got.Spec.Data.SetName("modified")
+
+		list, err = client.Kyma().ListModuleTemplate(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "default", list.Items[0].Spec.Data.GetName())
+	})
+
+	t.Run("gets module template missing in the list from the cluster", func(t *testing.T) {
+		dynamicClient := fixDynamicClient()
+		client := NewClient(&fake.KubeClient{TestKymaInterface: kyma.NewClient(dynamicClient)})
+
+		_, err := client.Kyma().GetModuleTemplate(context.Background(), "kyma-system", "keda-1.0.0")
+		require.Error(t, err)
+		_, err = client.Kyma().GetModuleTemplate(context.Background(), "kyma-system", "keda-1.0.0")
+		require.Error(t, err)
+
+		require.Len(t, dynamicClient.Actions(), 2)
+		require.Equal(t, "get", dynamicClient.Actions()[1].GetVerb())
+	})
+
+	t.Run("lists module release metas once", func(t *testing.T) {
+		dynamicClient := fixDynamicClient()
+		client := NewClient(&fake.KubeClient{TestKymaInterface: kyma.NewClient(dynamicClient)})
+
+		for range 3 {
+			_, err := client.Kyma().ListModuleReleaseMeta(context.Background())
+			require.NoError(t, err)
+		}
+
+		require.Len(t, dynamicClient.Actions(), 1)
+	})
+
+	t.Run("lists resources from all namespaces once", func(t *testing.T) {
+		dynamicClient := fixDynamicClient(fixSample("sample-1"), fixSample("sample-2"))
+		client := NewClient(&fake.KubeClient{TestRootlessDynamicInterface: fixRootlessDynamic(dynamicClient)})
+
+		for range 3 {
+			list, err := client.RootlessDynamic().List(context.Background(), fixSample(""), &rootlessdynamic.ListOptions{AllNamespaces: true})
+			require.NoError(t, err)
+			require.Len(t, list.Items, 2)
+
+			// modification of the result doesn't change the cache
+			list.Items[0].SetName("changed")
+		}
+
+		require.Len(t, dynamicClient.Actions(), 1)
+	})
+
+	t.Run("lists resources from one namespace every time", func(t *testing.T) {
+		dynamicClient := fixDynamicClient(fixSample("sample-1"))
+		client := NewClient(&fake.KubeClient{TestRootlessDynamicInterface: fixRootlessDynamic(dynamicClient)})
+
+		for range 2 {
+			_, err := client.RootlessDynamic().List(context.Background(), fixSample(""), &rootlessdynamic.ListOptions{})
+			require.NoError(t, err)
+		}
+
+		require.Len(t, dynamicClient.Actions(), 2)
+	})
+
+	t.Run("gets resource once", func(t *testing.T) {
+		dynamicClient := fixDynamicClient(fixSample("sample-1"))
+		client := NewClient(&fake.KubeClient{TestRootlessDynamicInterface: fixRootlessDynamic(dynamicClient)})
+
+		for range 3 {
+			obj, err := client.RootlessDynamic().Get(context.Background(), fixSample("sample-1"))
+			require.NoError(t, err)
+			require.Equal(t, "sample-1", obj.GetName())
+		}
+
+		require.Len(t, dynamicClient.Actions(), 1)
+	})
+
+	t.Run("doesn't wrap the snapshot client again", func(t *testing.T) {
+		client := NewClient(&fake.KubeClient{})
+
+		require.Same(t, client, NewClient(client))
+	})
+}
+
+func fixDynamicClient(objs ...runtime.Object) *dynamic_fake.FakeDynamicClient {
+	scheme := runtime.NewScheme()
+	scheme.AddKnownTypes(kyma.GVRModuleTemplate.GroupVersion())
+	scheme.AddKnownTypes(kyma.GVRModuleReleaseMeta.GroupVersion())
+	return dynamic_fake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		kyma.GVRModuleTemplate:    "ModuleTemplateList",
+		kyma.GVRModuleReleaseMeta: "ModuleReleaseMetaList",
+		sampleGVR:                 "SampleList",
+	}, objs...)
+}
+
+var sampleGVR = schema.GroupVersionResource{Group: "operator.kyma-project.io", Version: "v1alpha1", Resource: "samples"}
+
+func fixRootlessDynamic(dynamicClient *dynamic_fake.FakeDynamicClient) rootlessdynamic.Interface {
+	return rootlessdynamic.NewClient(dynamicClient, &clientgo_fake.FakeDiscovery{
+		Fake: &clientgo_testing.Fake{
+			Resources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "operator.kyma-project.io/v1alpha1",
+					APIResources: []metav1.APIResource{
+						{Kind: "Sample", Name: "samples", Namespaced: true},
+					},
+				},
+			},
+		},
+	})
+}
+
+func fixModuleTemplate(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "operator.kyma-project.io/v1beta2",
+			"kind":       "ModuleTemplate",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "kyma-system",
+			},
+		},
+	}
+}
+
+func fixSample(name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "operator.kyma-project.io/v1alpha1",
+			"kind":       "Sample",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "default",
+			},
+		},
+	}
+}
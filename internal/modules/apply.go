@@ -0,0 +1,621 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"gopkg.in/yaml.v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ModuleSet describes the desired state of modules in the cluster
+type ModuleSet struct {
+	Modules          []ModuleSetCoreModule      `yaml:"modules"`
+	CommunityModules []ModuleSetCommunityModule `yaml:"communityModules"`
+}
+
+type ModuleSetCoreModule struct {
+	Name                 string `yaml:"name"`
+	Channel              string `yaml:"channel,omitempty"`
+	Managed              *bool  `yaml:"managed,omitempty"`
+	CustomResourcePolicy string `yaml:"customResourcePolicy,omitempty"`
+}
+
+type ModuleSetCommunityModule struct {
+	// Template is the module location in format <namespace>/<module-template-name>
+	Template        string         `yaml:"template"`
+	Version         string         `yaml:"version,omitempty"`
+	DefaultConfigCR bool           `yaml:"defaultConfigCR,omitempty"`
+	ConfigCR        map[string]any `yaml:"configCR,omitempty"`
+}
+
+// CommunityModuleChange describes community module that must be installed, upgraded or reconfigured
+type CommunityModuleChange struct {
+	Desired          ModuleSetCommunityModule
+	ModuleTemplate   kyma.ModuleTemplate
+	InstalledVersion string
+	ConfigOnly       bool
+}
+
+// ApplyPlan contains all operations needed to reach the state described by the ModuleSet
+type ApplyPlan struct {
+	CoreModulesToAdd         []ModuleSetCoreModule
+	CoreModulesToUpdate      []CoreModuleUpdate
+	CoreModulesToRemove      []string
+	CommunityModulesToApply  []CommunityModuleChange
+	CommunityModulesToRemove []kyma.ModuleTemplate
+}
+
+type CoreModuleUpdate struct {
+	Desired ModuleSetCoreModule
+	Current kyma.Module
+}
+
+// IsEmpty returns true if the cluster already matches the ModuleSet
+func (p *ApplyPlan) IsEmpty() bool {
+	return len(p.CoreModulesToAdd) == 0 &&
+		len(p.CoreModulesToUpdate) == 0 &&
+		len(p.CoreModulesToRemove) == 0 &&
+		len(p.CommunityModulesToApply) == 0 &&
+		len(p.CommunityModulesToRemove) == 0
+}
+
+// InstallsCommunityModules returns true if plan installs or upgrades at least one community module
+func (p *ApplyPlan) InstallsCommunityModules() bool {
+	for _, change := range p.CommunityModulesToApply {
+		if !change.ConfigOnly {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadModuleSet reads and validates ModuleSet from the given file
+func ReadModuleSet(path string) (*ModuleSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	moduleSet := &ModuleSet{}
+	err = yaml.Unmarshal(data, moduleSet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse module set: %w", err)
+	}
+
+	return moduleSet, moduleSet.validate()
+}
+
+func (s *ModuleSet) validate() error {
+	coreNames := map[string]bool{}
+	for _, module := range s.Modules {
+		if module.Name == "" {
+			return fmt.Errorf("module name can't be empty")
+		}
+		if coreNames[module.Name] {
+			return fmt.Errorf("module %s is defined more than once", module.Name)
+		}
+		coreNames[module.Name] = true
+
+		if module.CustomResourcePolicy != "" &&
+			module.CustomResourcePolicy != kyma.CustomResourcePolicyCreateAndDelete &&
+			module.CustomResourcePolicy != kyma.CustomResourcePolicyIgnore {
+			return fmt.Errorf("module %s has unsupported customResourcePolicy %s, expected %s or %s",
+				module.Name, module.CustomResourcePolicy, kyma.CustomResourcePolicyCreateAndDelete, kyma.CustomResourcePolicyIgnore)
+		}
+	}
+
+	templates := map[string]bool{}
+	for _, module := range s.CommunityModules {
+		if _, _, err := splitModuleTemplateLocation(module.Template); err != nil {
+			return err
+		}
+		if templates[module.Template] {
+			return fmt.Errorf("community module %s is defined more than once", module.Template)
+		}
+		templates[module.Template] = true
+
+		if module.DefaultConfigCR && len(module.ConfigCR) > 0 {
+			return fmt.Errorf("community module %s can't define both defaultConfigCR and configCR", module.Template)
+		}
+		if len(module.ConfigCR) > 0 {
+			configCR := unstructured.Unstructured{Object: module.ConfigCR}
+			if configCR.GetAPIVersion() == "" || configCR.GetKind() == "" {
+				return fmt.Errorf("configCR of the community module %s must contain apiVersion and kind", module.Template)
+			}
+		}
+	}
+
+	return nil
+}
+
+// PlanApply compares ModuleSet with modules installed in the cluster and returns list of needed operations
+// modules missing in the ModuleSet are removed only if prune is true
+func PlanApply(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleSet *ModuleSet, prune bool) (*ApplyPlan, error) {
+	plan := &ApplyPlan{}
+
+	defaultKyma, err := client.Kyma().GetDefaultKyma(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Kyma CR: %w", err)
+	}
+
+	planCoreModules(plan, defaultKyma, moduleSet, prune)
+
+	err = planCommunityModules(ctx, client, repo, plan, defaultKyma, moduleSet, prune)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func planCoreModules(plan *ApplyPlan, defaultKyma *kyma.Kyma, moduleSet *ModuleSet, prune bool) {
+	for _, desired := range moduleSet.Modules {
+		current := getKymaModuleSpec(defaultKyma, desired.Name)
+		if current == nil {
+			plan.CoreModulesToAdd = append(plan.CoreModulesToAdd, desired)
+			continue
+		}
+
+		if !coreModuleMatches(desired, *current) {
+			plan.CoreModulesToUpdate = append(plan.CoreModulesToUpdate, CoreModuleUpdate{
+				Desired: desired,
+				Current: *current,
+			})
+		}
+	}
+
+	if !prune {
+		return
+	}
+
+	for _, current := range defaultKyma.Spec.Modules {
+		if !coreModuleDesired(moduleSet, current.Name) {
+			plan.CoreModulesToRemove = append(plan.CoreModulesToRemove, current.Name)
+		}
+	}
+}
+
+func coreModuleMatches(desired ModuleSetCoreModule, current kyma.Module) bool {
+	return desired.Channel == current.Channel &&
+		desiredPolicy(desired) == currentPolicy(current) &&
+		desiredManaged(desired) == currentManaged(current)
+}
+
+func coreModuleDesired(moduleSet *ModuleSet, name string) bool {
+	for _, module := range moduleSet.Modules {
+		if module.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// desiredPolicy returns policy set in the ModuleSet or the Kyma CR default
+func desiredPolicy(module ModuleSetCoreModule) string {
+	if module.CustomResourcePolicy == "" {
+		return kyma.CustomResourcePolicyCreateAndDelete
+	}
+	return module.CustomResourcePolicy
+}
+
+func currentPolicy(module kyma.Module) string {
+	if module.CustomResourcePolicy == "" {
+		return kyma.CustomResourcePolicyCreateAndDelete
+	}
+	return module.CustomResourcePolicy
+}
+
+func desiredManaged(module ModuleSetCoreModule) bool {
+	return module.Managed == nil || *module.Managed
+}
+
+func currentManaged(module kyma.Module) bool {
+	return module.Managed == nil || *module.Managed
+}
+
+func planCommunityModules(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, plan *ApplyPlan, defaultKyma *kyma.Kyma, moduleSet *ModuleSet, prune bool) error {
+	if len(moduleSet.CommunityModules) == 0 && !prune {
+		// skip if there is nothing to do
+		return nil
+	}
+
+	communityModuleTemplates, err := repo.Community(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list community module templates: %w", err)
+	}
+
+	installedVersions := getInstalledCommunityVersions(ctx, repo, defaultKyma, communityModuleTemplates)
+
+	desiredModuleNames := map[string]bool{}
+	for _, desired := range moduleSet.CommunityModules {
+		namespace, name, _ := splitModuleTemplateLocation(desired.Template)
+		moduleTemplate := findModuleTemplate(communityModuleTemplates, namespace, name)
+		if moduleTemplate == nil {
+			return fmt.Errorf("community module template %s does not exist in the cluster, pull it first using the 'kyma module pull' command", desired.Template)
+		}
+
+		if desired.Version != "" && desired.Version != moduleTemplate.Spec.Version {
+			return fmt.Errorf("community module template %s contains version %s, expected %s", desired.Template, moduleTemplate.Spec.Version, desired.Version)
+		}
+
+		desiredModuleNames[moduleTemplate.Spec.ModuleName] = true
+
+		installedVersion, installed := installedVersions[moduleTemplate.Spec.ModuleName]
+		if !installed || installedVersion != moduleTemplate.Spec.Version {
+			plan.CommunityModulesToApply = append(plan.CommunityModulesToApply, CommunityModuleChange{
+				Desired:          desired,
+				ModuleTemplate:   *moduleTemplate,
+				InstalledVersion: installedVersion,
+			})
+			continue
+		}
+
+		configChanged, err := communityConfigChanged(ctx, client, desired, moduleTemplate)
+		if err != nil {
+			return err
+		}
+
+		if configChanged {
+			plan.CommunityModulesToApply = append(plan.CommunityModulesToApply, CommunityModuleChange{
+				Desired:          desired,
+				ModuleTemplate:   *moduleTemplate,
+				InstalledVersion: installedVersion,
+				ConfigOnly:       true,
+			})
+		}
+	}
+
+	if !prune {
+		return nil
+	}
+
+	for moduleName, installedVersion := range installedVersions {
+		if desiredModuleNames[moduleName] {
+			continue
+		}
+
+		moduleTemplate := findInstalledModuleTemplateByVersion(communityModuleTemplates, moduleName, installedVersion)
+		if moduleTemplate != nil {
+			plan.CommunityModulesToRemove = append(plan.CommunityModulesToRemove, *moduleTemplate)
+		}
+	}
+
+	return nil
+}
+
+// getInstalledCommunityVersions returns versions of installed community modules mapped by module name
+func getInstalledCommunityVersions(ctx context.Context, repo repo.ModuleTemplatesRepository, defaultKyma *kyma.Kyma, moduleTemplates []kyma.ModuleTemplate) map[string]string {
+	installedVersions := map[string]string{}
+	for _, moduleTemplate := range moduleTemplates {
+		if _, alreadyChecked := installedVersions[moduleTemplate.Spec.ModuleName]; alreadyChecked {
+			continue
+		}
+
+		if getKymaModuleSpec(defaultKyma, moduleTemplate.Spec.ModuleName) != nil {
+			// module is installed as core module
+			continue
+		}
+
+		installedManager, err := repo.InstalledManager(ctx, moduleTemplate)
+		if err != nil {
+			out.Debugfln("failed to get installed manager of the %s module: %v", moduleTemplate.Spec.ModuleName, err)
+			continue
+		}
+		if installedManager == nil {
+			continue
+		}
+
+		version, err := getManagerVersion(installedManager)
+		if err != nil {
+			out.Debugfln("failed to get manager version of the %s module: %v", moduleTemplate.Spec.ModuleName, err)
+		}
+
+		installedVersions[moduleTemplate.Spec.ModuleName] = version
+	}
+
+	return installedVersions
+}
+
+func findModuleTemplate(moduleTemplates []kyma.ModuleTemplate, namespace, name string) *kyma.ModuleTemplate {
+	for _, moduleTemplate := range moduleTemplates {
+		if moduleTemplate.GetNamespace() == namespace && moduleTemplate.GetName() == name {
+			return &moduleTemplate
+		}
+	}
+	return nil
+}
+
+func findInstalledModuleTemplateByVersion(moduleTemplates []kyma.ModuleTemplate, moduleName, version string) *kyma.ModuleTemplate {
+	var found *kyma.ModuleTemplate
+	for _, moduleTemplate := range moduleTemplates {
+		if moduleTemplate.Spec.ModuleName != moduleName {
+			continue
+		}
+		if moduleTemplate.Spec.Version == version {
+			return &moduleTemplate
+		}
+		if found == nil {
+			found = &moduleTemplate
+		}
+	}
+	return found
+}
+
+// communityConfigChanged returns true if the configCR from the ModuleSet contains values that are not present in the cluster
+func communityConfigChanged(ctx context.Context, client kube.Client, desired ModuleSetCommunityModule, moduleTemplate *kyma.ModuleTemplate) (bool, error) {
+	configCR := desiredCommunityConfigCR(desired, moduleTemplate)
+	if configCR == nil {
+		return false, nil
+	}
+
+	liveCR, err := client.RootlessDynamic().Get(ctx, configCR)
+	if apierrors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get %s/%s CR: %w", configCR.GetNamespace(), configCR.GetName(), err)
+	}
+
+	return !isSubset(configCR.Object, liveCR.Object), nil
+}
+
+func desiredCommunityConfigCR(desired ModuleSetCommunityModule, moduleTemplate *kyma.ModuleTemplate) *unstructured.Unstructured {
+	if len(desired.ConfigCR) > 0 {
+		return &unstructured.Unstructured{Object: desired.ConfigCR}
+	}
+	if desired.DefaultConfigCR && len(moduleTemplate.Spec.Data.Object) > 0 {
+		return moduleTemplate.Spec.Data.DeepCopy()
+	}
+	return nil
+}
+
+// isSubset returns true if all fields from the expected object exist with the same values in the actual object
+func isSubset(expected, actual map[string]any) bool {
+	for key, expectedValue := range expected {
+		actualValue, ok := actual[key]
+		if !ok {
+			return false
+		}
+
+		expectedMap, expectedIsMap := expectedValue.(map[string]any)
+		actualMap, actualIsMap := actualValue.(map[string]any)
+		if expectedIsMap && actualIsMap {
+			if !isSubset(expectedMap, actualMap) {
+				return false
+			}
+			continue
+		}
+
+		// values are compared as strings because numbers decoded from yaml and from the cluster differ in type
+		if fmt.Sprint(expectedValue) != fmt.Sprint(actualValue) {
+			return false
+		}
+	}
+	return true
+}
+
+func splitModuleTemplateLocation(location string) (string, string, error) {
+	elems := strings.Split(location, "/")
+	if len(elems) != 2 || elems[0] == "" || elems[1] == "" {
+		return "", "", fmt.Errorf("invalid community module template %q - expected <namespace>/<module-template-name>", location)
+	}
+	return elems[0], elems[1], nil
+}
+
+// PrintApplyPlan prints operations from the plan in human readable form
+func PrintApplyPlan(plan *ApplyPlan) {
+	printApplyPlan(out.Default, plan)
+}
+
+func printApplyPlan(printer *out.Printer, plan *ApplyPlan) {
+	if plan.IsEmpty() {
+		printer.Msgln("the cluster already matches the module set")
+		return
+	}
+
+	printer.Msgln("planned changes:")
+	for _, module := range plan.CoreModulesToAdd {
+		printer.Msgfln("  + %s (channel: %s, customResourcePolicy: %s, managed: %t)",
+			module.Name, channelOrDefault(module.Channel), desiredPolicy(module), desiredManaged(module))
+	}
+	for _, update := range plan.CoreModulesToUpdate {
+		printer.Msgfln("  ~ %s (%s)", update.Desired.Name, strings.Join(describeCoreModuleUpdate(update), ", "))
+	}
+	for _, module := range plan.CoreModulesToRemove {
+		printer.Msgfln("  - %s", module)
+	}
+	for _, change := range plan.CommunityModulesToApply {
+		switch {
+		case change.ConfigOnly:
+			printer.Msgfln("  ~ %s (config CR)", change.Desired.Template)
+		case change.InstalledVersion == "":
+			printer.Msgfln("  + %s (version: %s)", change.Desired.Template, change.ModuleTemplate.Spec.Version)
+		default:
+			printer.Msgfln("  ~ %s (version: %s -> %s)", change.Desired.Template, change.InstalledVersion, change.ModuleTemplate.Spec.Version)
+		}
+	}
+	for _, moduleTemplate := range plan.CommunityModulesToRemove {
+		printer.Msgfln("  - %s/%s", moduleTemplate.GetNamespace(), moduleTemplate.GetName())
+	}
+}
+
+func describeCoreModuleUpdate(update CoreModuleUpdate) []string {
+	changes := []string{}
+	if update.Desired.Channel != update.Current.Channel {
+		changes = append(changes, fmt.Sprintf("channel: %s -> %s", channelOrDefault(update.Current.Channel), channelOrDefault(update.Desired.Channel)))
+	}
+	if desiredPolicy(update.Desired) != currentPolicy(update.Current) {
+		changes = append(changes, fmt.Sprintf("customResourcePolicy: %s -> %s", currentPolicy(update.Current), desiredPolicy(update.Desired)))
+	}
+	if desiredManaged(update.Desired) != currentManaged(update.Current) {
+		changes = append(changes, fmt.Sprintf("managed: %t -> %t", currentManaged(update.Current), desiredManaged(update.Desired)))
+	}
+	return changes
+}
+
+func channelOrDefault(channel string) string {
+	if channel == "" {
+		return "default"
+	}
+	return channel
+}
+
+// ExecuteApplyPlan takes care about applying all operations from the plan in order:
+// 1. add missing core modules to the Kyma CR
+// 2. update existing core modules entries in the Kyma CR
+// 3. install, upgrade or reconfigure community modules
+// 4. remove community and core modules that are not part of the ModuleSet
+func ExecuteApplyPlan(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, plan *ApplyPlan) clierror.Error {
+	return executeApplyPlan(out.Default, ctx, client, repo, plan)
+}
+
+func executeApplyPlan(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, plan *ApplyPlan) clierror.Error {
+	for _, module := range plan.CoreModulesToAdd {
+		if !desiredManaged(module) {
+			// unmanaged modules are added by the updateCoreModules func, so they are never managed by KLM
+			continue
+		}
+
+		defaultCR := desiredPolicy(module) == kyma.CustomResourcePolicyCreateAndDelete
+		clierr := enable(printer, ctx, client, repo, module.Name, module.Channel, defaultCR, DefaultModuleCRTimeout)
+		if clierr != nil {
+			return clierr
+		}
+	}
+
+	clierr := updateCoreModules(printer, ctx, client, repo, plan)
+	if clierr != nil {
+		return clierr
+	}
+
+	for _, change := range plan.CommunityModulesToApply {
+		clierr = applyCommunityModule(printer, ctx, client, repo, change)
+		if clierr != nil {
+			return clierr
+		}
+	}
+
+	for _, moduleTemplate := range plan.CommunityModulesToRemove {
+		clierr = uninstall(printer, ctx, repo, &moduleTemplate)
+		if clierr != nil {
+			return clierr
+		}
+	}
+
+	for _, module := range plan.CoreModulesToRemove {
+		clierr = disable(printer, ctx, client, module, DefaultModuleCRTimeout)
+		if clierr != nil {
+			return clierr
+		}
+	}
+
+	return nil
+}
+
+// updateCoreModules updates all changed modules and added unmanaged modules in the Kyma CR at once
+func updateCoreModules(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, plan *ApplyPlan) clierror.Error {
+	modulesToUpdate := []ModuleSetCoreModule{}
+	for _, update := range plan.CoreModulesToUpdate {
+		modulesToUpdate = append(modulesToUpdate, update.Desired)
+	}
+	modulesToAdd := []ModuleSetCoreModule{}
+	for _, module := range plan.CoreModulesToAdd {
+		if !desiredManaged(module) {
+			modulesToAdd = append(modulesToAdd, module)
+		}
+	}
+
+	if len(modulesToUpdate) == 0 && len(modulesToAdd) == 0 {
+		// skip if there is nothing to do
+		return nil
+	}
+
+	for _, module := range modulesToAdd {
+		if err := validateModuleAvailability(ctx, client, repo, module.Name, module.Channel); err != nil {
+			hints := []string{
+				"ensure you provide a valid module name and channel (or version)",
+				"to list available modules, call the `kyma module catalog` command",
+				"to pull available modules, call the `kyma module pull` command",
+			}
+			return clierror.Wrap(err, clierror.New("unknown module name or channel", hints...))
+		}
+	}
+
+	defaultKyma, err := client.Kyma().GetDefaultKyma(ctx)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to get Kyma CR"))
+	}
+
+	for _, desired := range modulesToUpdate {
+		index := slices.IndexFunc(defaultKyma.Spec.Modules, func(m kyma.Module) bool {
+			return m.Name == desired.Name
+		})
+		if index < 0 {
+			return clierror.New(fmt.Sprintf("module %s does not exist in the Kyma CR", desired.Name))
+		}
+
+		managed := desiredManaged(desired)
+		defaultKyma.Spec.Modules[index].Channel = desired.Channel
+		defaultKyma.Spec.Modules[index].CustomResourcePolicy = desiredPolicy(desired)
+		defaultKyma.Spec.Modules[index].Managed = &managed
+	}
+
+	for _, desired := range modulesToAdd {
+		managed := false
+		defaultKyma.Spec.Modules = append(defaultKyma.Spec.Modules, kyma.Module{
+			Name:                 desired.Name,
+			Channel:              desired.Channel,
+			CustomResourcePolicy: desiredPolicy(desired),
+			Managed:              &managed,
+		})
+	}
+
+	printer.Debugln("updating modules in the Kyma CR")
+	err = client.Kyma().UpdateDefaultKyma(ctx, defaultKyma)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to update modules in the Kyma CR"))
+	}
+
+	for _, desired := range modulesToUpdate {
+		printer.Msgfln("%s module updated", desired.Name)
+	}
+	for _, desired := range modulesToAdd {
+		printer.Msgfln("%s module added as unmanaged%s", desired.Name, channelMsgSuffix(desired.Channel))
+	}
+
+	return nil
+}
+
+func applyCommunityModule(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, change CommunityModuleChange) clierror.Error {
+	if change.ConfigOnly {
+		configCR := desiredCommunityConfigCR(change.Desired, &change.ModuleTemplate)
+		printer.Debugfln("applying %s/%s CR", configCR.GetNamespace(), configCR.GetName())
+		err := client.RootlessDynamic().Apply(ctx, configCR, false)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to apply config CR of the %s community module", change.Desired.Template)))
+		}
+
+		printer.Msgfln("%s community module configured", change.ModuleTemplate.Spec.ModuleName)
+		return nil
+	}
+
+	installData := InstallCommunityModuleData{
+		CommunityModuleTemplate: &change.ModuleTemplate,
+		IsDefaultCRApplicable:   change.Desired.DefaultConfigCR,
+	}
+	if len(change.Desired.ConfigCR) > 0 {
+		installData.CustomResources = []unstructured.Unstructured{{Object: change.Desired.ConfigCR}}
+	}
+
+	return Install(ctx, client, repo, installData)
+}
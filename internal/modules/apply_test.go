@@ -0,0 +1,469 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	modulesfake "github.com/kyma-project/cli.v3/internal/modules/fake"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var testCommunityModuleTemplate = kyma.ModuleTemplate{
+	ObjectMeta: metav1.ObjectMeta{
+		Name:      "my-module-1.0.0",
+		Namespace: "default",
+	},
+	Spec: kyma.ModuleTemplateSpec{
+		ModuleName: "my-module",
+		Version:    "1.0.0",
+	},
+}
+
+func TestReadModuleSet(t *testing.T) {
+	t.Run("read module set", func(t *testing.T) {
+		path := writeModuleSetFile(t, `
+modules:
+  - name: keda
+    channel: fast
+    customResourcePolicy: Ignore
+  - name: serverless
+    managed: false
+communityModules:
+  - template: default/my-module-1.0.0
+    version: 1.0.0
+    configCR:
+      apiVersion: test/v1
+      kind: MyModule
+      metadata:
+        name: default
+`)
+
+		moduleSet, err := ReadModuleSet(path)
+		require.NoError(t, err)
+		require.Len(t, moduleSet.Modules, 2)
+		require.Equal(t, "keda", moduleSet.Modules[0].Name)
+		require.Equal(t, "fast", moduleSet.Modules[0].Channel)
+		require.Equal(t, kyma.CustomResourcePolicyIgnore, moduleSet.Modules[0].CustomResourcePolicy)
+		require.False(t, *moduleSet.Modules[1].Managed)
+		require.Len(t, moduleSet.CommunityModules, 1)
+		require.Equal(t, "default/my-module-1.0.0", moduleSet.CommunityModules[0].Template)
+		require.Equal(t, "MyModule", moduleSet.CommunityModules[0].ConfigCR["kind"])
+	})
+
+	t.Run("duplicated core module", func(t *testing.T) {
+		path := writeModuleSetFile(t, `
+modules:
+  - name: keda
+  - name: keda
+`)
+
+		_, err := ReadModuleSet(path)
+		require.ErrorContains(t, err, "module keda is defined more than once")
+	})
+
+	t.Run("unsupported customResourcePolicy", func(t *testing.T) {
+		path := writeModuleSetFile(t, `
+modules:
+  - name: keda
+    customResourcePolicy: Unknown
+`)
+
+		_, err := ReadModuleSet(path)
+		require.ErrorContains(t, err, "unsupported customResourcePolicy Unknown")
+	})
+
+	t.Run("invalid community module template", func(t *testing.T) {
+		path := writeModuleSetFile(t, `
+communityModules:
+  - template: my-module
+`)
+
+		_, err := ReadModuleSet(path)
+		require.ErrorContains(t, err, "expected <namespace>/<module-template-name>")
+	})
+
+	t.Run("config CR without kind", func(t *testing.T) {
+		path := writeModuleSetFile(t, `
+communityModules:
+  - template: default/my-module
+    configCR:
+      apiVersion: test/v1
+`)
+
+		_, err := ReadModuleSet(path)
+		require.ErrorContains(t, err, "must contain apiVersion and kind")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := ReadModuleSet(filepath.Join(t.TempDir(), "missing.yaml"))
+		require.ErrorContains(t, err, "failed to read file")
+	})
+}
+
+func TestPlanApply(t *testing.T) {
+	managed := false
+	defaultKyma := kyma.Kyma{
+		Spec: kyma.KymaSpec{
+			Channel: "regular",
+			Modules: []kyma.Module{
+				{Name: "keda", Channel: "regular", CustomResourcePolicy: kyma.CustomResourcePolicyCreateAndDelete},
+				{Name: "serverless", CustomResourcePolicy: kyma.CustomResourcePolicyCreateAndDelete},
+				{Name: "istio"},
+			},
+		},
+	}
+
+	t.Run("plan core modules changes", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: defaultKyma,
+			},
+		}
+		moduleSet := &ModuleSet{
+			Modules: []ModuleSetCoreModule{
+				{Name: "keda", Channel: "fast"},
+				{Name: "serverless", Managed: &managed},
+				{Name: "istio"},
+				{Name: "api-gateway", Channel: "fast"},
+			},
+		}
+
+		plan, err := PlanApply(context.Background(), &client, &modulesfake.ModuleTemplatesRepo{}, moduleSet, false)
+		require.NoError(t, err)
+		require.Equal(t, []ModuleSetCoreModule{{Name: "api-gateway", Channel: "fast"}}, plan.CoreModulesToAdd)
+		require.Len(t, plan.CoreModulesToUpdate, 2)
+		require.Equal(t, "keda", plan.CoreModulesToUpdate[0].Desired.Name)
+		require.Equal(t, "serverless", plan.CoreModulesToUpdate[1].Desired.Name)
+		require.Empty(t, plan.CoreModulesToRemove)
+	})
+
+	t.Run("plan core modules removal with prune", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: defaultKyma,
+			},
+		}
+		moduleSet := &ModuleSet{
+			Modules: []ModuleSetCoreModule{
+				{Name: "keda", Channel: "regular"},
+			},
+		}
+
+		plan, err := PlanApply(context.Background(), &client, &modulesfake.ModuleTemplatesRepo{}, moduleSet, true)
+		require.NoError(t, err)
+		require.Empty(t, plan.CoreModulesToAdd)
+		require.Empty(t, plan.CoreModulesToUpdate)
+		require.Equal(t, []string{"serverless", "istio"}, plan.CoreModulesToRemove)
+	})
+
+	t.Run("plan community module installation", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: defaultKyma,
+			},
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnCommunity: []kyma.ModuleTemplate{testCommunityModuleTemplate},
+		}
+		moduleSet := &ModuleSet{
+			CommunityModules: []ModuleSetCommunityModule{
+				{Template: "default/my-module-1.0.0", Version: "1.0.0"},
+			},
+		}
+
+		plan, err := PlanApply(context.Background(), &client, repo, moduleSet, false)
+		require.NoError(t, err)
+		require.Len(t, plan.CommunityModulesToApply, 1)
+		require.Equal(t, "my-module-1.0.0", plan.CommunityModulesToApply[0].ModuleTemplate.GetName())
+		require.Empty(t, plan.CommunityModulesToApply[0].InstalledVersion)
+		require.True(t, plan.InstallsCommunityModules())
+	})
+
+	t.Run("skip installed community module", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: defaultKyma,
+			},
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnCommunity:        []kyma.ModuleTemplate{testCommunityModuleTemplate},
+			ReturnInstalledManager: testManagerWithVersion("1.0.0"),
+		}
+		moduleSet := &ModuleSet{
+			Modules: []ModuleSetCoreModule{
+				{Name: "keda", Channel: "regular"},
+				{Name: "serverless"},
+				{Name: "istio"},
+			},
+			CommunityModules: []ModuleSetCommunityModule{
+				{Template: "default/my-module-1.0.0"},
+			},
+		}
+
+		plan, err := PlanApply(context.Background(), &client, repo, moduleSet, true)
+		require.NoError(t, err)
+		require.True(t, plan.IsEmpty())
+	})
+
+	t.Run("plan community module upgrade", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: defaultKyma,
+			},
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnCommunity:        []kyma.ModuleTemplate{testCommunityModuleTemplate},
+			ReturnInstalledManager: testManagerWithVersion("0.9.0"),
+		}
+		moduleSet := &ModuleSet{
+			CommunityModules: []ModuleSetCommunityModule{
+				{Template: "default/my-module-1.0.0"},
+			},
+		}
+
+		plan, err := PlanApply(context.Background(), &client, repo, moduleSet, false)
+		require.NoError(t, err)
+		require.Len(t, plan.CommunityModulesToApply, 1)
+		require.Equal(t, "0.9.0", plan.CommunityModulesToApply[0].InstalledVersion)
+	})
+
+	t.Run("plan community module config change", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: defaultKyma,
+			},
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnGetObj: unstructured.Unstructured{Object: map[string]any{
+					"apiVersion": "test/v1",
+					"kind":       "MyModule",
+					"metadata":   map[string]any{"name": "default"},
+					"spec":       map[string]any{"replicas": int64(1)},
+				}},
+			},
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnCommunity:        []kyma.ModuleTemplate{testCommunityModuleTemplate},
+			ReturnInstalledManager: testManagerWithVersion("1.0.0"),
+		}
+		moduleSet := &ModuleSet{
+			CommunityModules: []ModuleSetCommunityModule{
+				{
+					Template: "default/my-module-1.0.0",
+					ConfigCR: map[string]any{
+						"apiVersion": "test/v1",
+						"kind":       "MyModule",
+						"metadata":   map[string]any{"name": "default"},
+						"spec":       map[string]any{"replicas": 2},
+					},
+				},
+			},
+		}
+
+		plan, err := PlanApply(context.Background(), &client, repo, moduleSet, false)
+		require.NoError(t, err)
+		require.Len(t, plan.CommunityModulesToApply, 1)
+		require.True(t, plan.CommunityModulesToApply[0].ConfigOnly)
+		require.False(t, plan.InstallsCommunityModules())
+	})
+
+	t.Run("plan community module removal with prune", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: defaultKyma,
+			},
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnCommunity:        []kyma.ModuleTemplate{testCommunityModuleTemplate},
+			ReturnInstalledManager: testManagerWithVersion("1.0.0"),
+		}
+		moduleSet := &ModuleSet{}
+
+		plan, err := PlanApply(context.Background(), &client, repo, moduleSet, true)
+		require.NoError(t, err)
+		require.Len(t, plan.CommunityModulesToRemove, 1)
+		require.Equal(t, "my-module-1.0.0", plan.CommunityModulesToRemove[0].GetName())
+	})
+
+	t.Run("community module template not found", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: defaultKyma,
+			},
+		}
+		moduleSet := &ModuleSet{
+			CommunityModules: []ModuleSetCommunityModule{
+				{Template: "default/other-module-1.0.0"},
+			},
+		}
+
+		_, err := PlanApply(context.Background(), &client, &modulesfake.ModuleTemplatesRepo{}, moduleSet, false)
+		require.ErrorContains(t, err, "community module template default/other-module-1.0.0 does not exist in the cluster")
+	})
+
+	t.Run("community module version mismatch", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: defaultKyma,
+			},
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnCommunity: []kyma.ModuleTemplate{testCommunityModuleTemplate},
+		}
+		moduleSet := &ModuleSet{
+			CommunityModules: []ModuleSetCommunityModule{
+				{Template: "default/my-module-1.0.0", Version: "2.0.0"},
+			},
+		}
+
+		_, err := PlanApply(context.Background(), &client, repo, moduleSet, false)
+		require.ErrorContains(t, err, "contains version 1.0.0, expected 2.0.0")
+	})
+}
+
+func TestExecuteApplyPlan(t *testing.T) {
+	t.Run("update and remove core modules", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		managed := false
+		kymaClient := fake.KymaClient{
+			ReturnDefaultKyma: kyma.Kyma{
+				Spec: kyma.KymaSpec{
+					Modules: []kyma.Module{
+						{Name: "keda", Channel: "regular"},
+						{Name: "istio"},
+					},
+				},
+			},
+		}
+		client := fake.KubeClient{
+			TestKymaInterface: &kymaClient,
+		}
+		plan := &ApplyPlan{
+			CoreModulesToUpdate: []CoreModuleUpdate{
+				{
+					Desired: ModuleSetCoreModule{Name: "keda", Channel: "fast", Managed: &managed},
+					Current: kyma.Module{Name: "keda", Channel: "regular"},
+				},
+			},
+			CoreModulesToRemove: []string{"istio"},
+		}
+
+		clierr := executeApplyPlan(out.NewToWriter(buffer), context.Background(), &client, &modulesfake.ModuleTemplatesRepo{}, plan)
+		require.Nil(t, clierr)
+		require.Len(t, kymaClient.UpdateDefaultKymas, 1)
+		require.Equal(t, kyma.Module{
+			Name:                 "keda",
+			Channel:              "fast",
+			CustomResourcePolicy: kyma.CustomResourcePolicyCreateAndDelete,
+			Managed:              &managed,
+		}, kymaClient.UpdateDefaultKymas[0].Spec.Modules[0])
+		require.Equal(t, []string{"istio"}, kymaClient.DisabledModules)
+		require.Contains(t, buffer.String(), "keda module updated")
+		require.Contains(t, buffer.String(), "istio module disabled")
+	})
+
+	t.Run("add unmanaged core module with a single Kyma CR update", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		managed := false
+		kymaClient := fake.KymaClient{
+			ReturnModuleTemplateList: kyma.ModuleTemplateList{
+				Items: []kyma.ModuleTemplate{testKedaModuleTemplate},
+			},
+			ReturnModuleReleaseMetaList: kyma.ModuleReleaseMetaList{
+				Items: []kyma.ModuleReleaseMeta{testKedaModuleReleaseMeta},
+			},
+		}
+		client := fake.KubeClient{
+			TestKymaInterface: &kymaClient,
+		}
+		plan := &ApplyPlan{
+			CoreModulesToAdd: []ModuleSetCoreModule{{Name: "keda", Channel: "fast", Managed: &managed}},
+		}
+
+		clierr := executeApplyPlan(out.NewToWriter(buffer), context.Background(), &client, &modulesfake.ModuleTemplatesRepo{}, plan)
+		require.Nil(t, clierr)
+		require.Empty(t, kymaClient.EnabledModules)
+		require.Len(t, kymaClient.UpdateDefaultKymas, 1)
+		require.Equal(t, []kyma.Module{{
+			Name:                 "keda",
+			Channel:              "fast",
+			CustomResourcePolicy: kyma.CustomResourcePolicyCreateAndDelete,
+			Managed:              &managed,
+		}}, kymaClient.UpdateDefaultKymas[0].Spec.Modules)
+		require.Contains(t, buffer.String(), "keda module added as unmanaged from the fast channel")
+	})
+
+	t.Run("apply community module config", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		rootlessDynamic := fake.RootlessDynamicClient{}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &rootlessDynamic,
+		}
+		configCR := map[string]any{
+			"apiVersion": "test/v1",
+			"kind":       "MyModule",
+			"metadata":   map[string]any{"name": "default"},
+		}
+		plan := &ApplyPlan{
+			CommunityModulesToApply: []CommunityModuleChange{
+				{
+					Desired:        ModuleSetCommunityModule{Template: "default/my-module-1.0.0", ConfigCR: configCR},
+					ModuleTemplate: testCommunityModuleTemplate,
+					ConfigOnly:     true,
+				},
+			},
+		}
+
+		clierr := executeApplyPlan(out.NewToWriter(buffer), context.Background(), &client, &modulesfake.ModuleTemplatesRepo{}, plan)
+		require.Nil(t, clierr)
+		require.Len(t, rootlessDynamic.ApplyObjs, 1)
+		require.Equal(t, schema.GroupVersionKind{Group: "test", Version: "v1", Kind: "MyModule"}, rootlessDynamic.ApplyObjs[0].GroupVersionKind())
+		require.Contains(t, buffer.String(), "my-module community module configured")
+	})
+}
+
+func TestPrintApplyPlan(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{})
+	plan := &ApplyPlan{
+		CoreModulesToAdd: []ModuleSetCoreModule{{Name: "keda", Channel: "fast"}},
+		CoreModulesToUpdate: []CoreModuleUpdate{
+			{
+				Desired: ModuleSetCoreModule{Name: "serverless", Channel: "fast"},
+				Current: kyma.Module{Name: "serverless"},
+			},
+		},
+		CoreModulesToRemove:      []string{"istio"},
+		CommunityModulesToRemove: []kyma.ModuleTemplate{testCommunityModuleTemplate},
+	}
+
+	printApplyPlan(out.NewToWriter(buffer), plan)
+	require.Equal(t, "planned changes:\n"+
+		"  + keda (channel: fast, customResourcePolicy: CreateAndDelete, managed: true)\n"+
+		"  ~ serverless (channel: default -> fast)\n"+
+		"  - istio\n"+
+		"  - default/my-module-1.0.0\n", buffer.String())
+}
+
+func writeModuleSetFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "modules.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func testManagerWithVersion(version string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{
+			"labels": map[string]any{
+				"app.kubernetes.io/version": version,
+			},
+		},
+	}}
+}
@@ -0,0 +1,307 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"gopkg.in/yaml.v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	backupModuleFile    = "module.yaml"
+	backupConfigCRFile  = "config-cr.yaml"
+	backupResourcesFile = "resources.yaml"
+)
+
+// ModuleBackup describes the module saved in the backup directory
+type ModuleBackup struct {
+	Name                 string `yaml:"name"`
+	Channel              string `yaml:"channel,omitempty"`
+	CustomResourcePolicy string `yaml:"customResourcePolicy,omitempty"`
+	// Origin of the community module in format <namespace>/<module-template-name>
+	Origin string `yaml:"origin,omitempty"`
+}
+
+// BackupModule writes the module config CR and the user-defined resources of the core module to the dir
+func BackupModule(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, dir string) clierror.Error {
+	return backupModule(out.Default, ctx, client, repo, module, dir)
+}
+
+func backupModule(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, dir string) clierror.Error {
+	kymaCR, err := client.Kyma().GetDefaultKyma(ctx)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to get the Kyma CR from the target Kyma environment"))
+	}
+
+	moduleSpec := getKymaModuleSpec(kymaCR, module)
+	if moduleSpec == nil {
+		return clierror.New(fmt.Sprintf("module %s is not added to the Kyma CR", module))
+	}
+
+	moduleTemplate, err := FindInstalledModuleTemplate(ctx, client, module)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to find the module template of the %s module", module)))
+	}
+
+	backup := ModuleBackup{
+		Name:                 module,
+		Channel:              moduleSpec.Channel,
+		CustomResourcePolicy: getCustomResourcePolicy(moduleSpec),
+	}
+
+	return writeModuleBackup(printer, ctx, client, repo, moduleTemplate, backup, dir)
+}
+
+// BackupCommunityModule writes the module config CR and the user-defined resources of the community module to the dir
+func BackupCommunityModule(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleTemplate *kyma.ModuleTemplate, dir string) clierror.Error {
+	backup := ModuleBackup{
+		Name:   moduleTemplate.Spec.ModuleName,
+		Origin: fmt.Sprintf("%s/%s", moduleTemplate.GetNamespace(), moduleTemplate.GetName()),
+	}
+
+	return writeModuleBackup(out.Default, ctx, client, repo, moduleTemplate, backup, dir)
+}
+
+func writeModuleBackup(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleTemplate *kyma.ModuleTemplate, backup ModuleBackup, dir string) clierror.Error {
+	configCRs := []unstructured.Unstructured{}
+	if len(moduleTemplate.Spec.Data.Object) != 0 {
+		configCR, err := getModuleConfig(ctx, client, moduleTemplate)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return clierror.Wrap(err, clierror.New("failed to get the module config CR"))
+		}
+		if err == nil {
+			configCRs = append(configCRs, *cleanBackupObject(configCR))
+		}
+	}
+
+	userDefinedResources, err := repo.RunningUserDefinedResourcesOfModule(ctx, *moduleTemplate)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to get resources of the %s module", backup.Name)))
+	}
+
+	backupResources := []unstructured.Unstructured{}
+	for _, resource := range userDefinedResources {
+		backupResources = append(backupResources, *cleanBackupObject(&resource))
+	}
+
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to create the backup directory"))
+	}
+
+	backupBytes, err := yaml.Marshal(backup)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to marshal the module backup"))
+	}
+
+	err = os.WriteFile(filepath.Join(dir, backupModuleFile), backupBytes, 0600)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to write the module backup"))
+	}
+
+	clierr := writeBackupObjects(filepath.Join(dir, backupConfigCRFile), configCRs)
+	if clierr != nil {
+		return clierr
+	}
+
+	clierr = writeBackupObjects(filepath.Join(dir, backupResourcesFile), backupResources)
+	if clierr != nil {
+		return clierr
+	}
+
+	printer.Msgfln("%s module backed up to %s (config CRs: %d, resources: %d)", backup.Name, dir, len(configCRs), len(backupResources))
+	return nil
+}
+
+// cleanBackupObject removes fields set by the cluster that can't be applied on a new resource
+func cleanBackupObject(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	cleaned := obj.DeepCopy()
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "creationTimestamp", "generation", "ownerReferences"} {
+		unstructured.RemoveNestedField(cleaned.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(cleaned.Object, "status")
+	return cleaned
+}
+
+func writeBackupObjects(path string, objs []unstructured.Unstructured) clierror.Error {
+	if len(objs) == 0 {
+		// skip if there is nothing to save
+		return nil
+	}
+
+	documents := []string{}
+	for _, obj := range objs {
+		bytes, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to marshal the %s/%s resource", obj.GetNamespace(), obj.GetName())))
+		}
+		documents = append(documents, string(bytes))
+	}
+
+	err := os.WriteFile(path, []byte(strings.Join(documents, "---\n")), 0600)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to write the %s file", path)))
+	}
+
+	return nil
+}
+
+// RestoreModule adds the module saved in the dir with its config CR
+// and re-creates user-defined resources after their CRDs are available
+func RestoreModule(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, dir string, timeout time.Duration) clierror.Error {
+	return restoreModule(out.Default, ctx, client, repo, dir, timeout)
+}
+
+func restoreModule(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, dir string, timeout time.Duration) clierror.Error {
+	backup, clierr := readModuleBackup(dir)
+	if clierr != nil {
+		return clierr
+	}
+
+	configCRs, clierr := readBackupObjects(filepath.Join(dir, backupConfigCRFile))
+	if clierr != nil {
+		return clierr
+	}
+
+	backupResources, clierr := readBackupObjects(filepath.Join(dir, backupResourcesFile))
+	if clierr != nil {
+		return clierr
+	}
+
+	if backup.Origin != "" {
+		clierr = restoreCommunityModule(ctx, client, repo, backup, configCRs)
+	} else {
+		// backups without the policy follow the Kyma CR default
+		defaultCR := backup.CustomResourcePolicy == "" || backup.CustomResourcePolicy == kyma.CustomResourcePolicyCreateAndDelete
+		clierr = enable(printer, ctx, client, repo, backup.Name, backup.Channel, defaultCR, timeout, configCRs...)
+	}
+	if clierr != nil {
+		return clierr
+	}
+
+	clierr = waitForResourcesCRDs(printer, ctx, client, backupResources, timeout)
+	if clierr != nil {
+		return clierr
+	}
+
+	for _, resource := range backupResources {
+		printer.Debugfln("applying %s/%s %s", resource.GetNamespace(), resource.GetName(), resource.GetKind())
+		err := client.RootlessDynamic().Apply(ctx, &resource, false)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to restore the %s/%s %s", resource.GetNamespace(), resource.GetName(), resource.GetKind())))
+		}
+	}
+
+	printer.Msgfln("%s module restored from %s (resources: %d)", backup.Name, dir, len(backupResources))
+	return nil
+}
+
+func restoreCommunityModule(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, backup *ModuleBackup, configCRs []unstructured.Unstructured) clierror.Error {
+	namespace, moduleTemplateName, ok := strings.Cut(backup.Origin, "/")
+	if !ok {
+		return clierror.New(fmt.Sprintf("invalid origin '%s' in the module backup", backup.Origin), "expected format <namespace>/<module-template-name>")
+	}
+
+	moduleTemplate, err := FindCommunityModuleTemplate(ctx, namespace, moduleTemplateName, repo)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(
+			fmt.Sprintf("failed to retrieve the module '%s'", backup.Origin),
+			"to pull the module, call the `kyma module pull` command",
+		))
+	}
+
+	return Install(ctx, client, repo, InstallCommunityModuleData{
+		CommunityModuleTemplate: moduleTemplate,
+		CustomResources:         configCRs,
+	})
+}
+
+func readModuleBackup(dir string) (*ModuleBackup, clierror.Error) {
+	backupBytes, err := os.ReadFile(filepath.Join(dir, backupModuleFile))
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New(
+			"failed to read the module backup",
+			"make sure the directory was created by the `kyma module delete --backup-dir` command",
+		))
+	}
+
+	backup := &ModuleBackup{}
+	err = yaml.Unmarshal(backupBytes, backup)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to parse the module backup"))
+	}
+
+	if backup.Name == "" {
+		return nil, clierror.New(fmt.Sprintf("module name is missing in the %s file", backupModuleFile))
+	}
+
+	return backup, nil
+}
+
+func readBackupObjects(path string) ([]unstructured.Unstructured, clierror.Error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		// nothing was saved
+		return nil, nil
+	}
+
+	objs, err := resources.ReadFromFiles(path)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to read the %s file", path)))
+	}
+
+	return objs, nil
+}
+
+// waitForResourcesCRDs waits until the API server serves kinds of all given resources
+func waitForResourcesCRDs(printer *out.Printer, ctx context.Context, client kube.Client, objs []unstructured.Unstructured, timeout time.Duration) clierror.Error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(stateCheckInterval)
+	defer ticker.Stop()
+
+	checked := map[string]bool{}
+	for _, obj := range objs {
+		kind := fmt.Sprintf("%s %s", obj.GetAPIVersion(), obj.GetKind())
+		if checked[kind] {
+			continue
+		}
+
+		for {
+			_, err := client.RootlessDynamic().List(timeoutCtx, &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": obj.GetAPIVersion(),
+				"kind":       obj.GetKind(),
+			}}, &rootlessdynamic.ListOptions{AllNamespaces: true})
+			if err == nil {
+				break
+			}
+
+			printer.Debugfln("waiting for the %s CRD: %v", kind, err)
+			select {
+			case <-timeoutCtx.Done():
+				return clierror.Wrap(err, clierror.New(
+					fmt.Sprintf("failed to wait for the CRD of the %s resources", kind),
+					"make sure the module is ready and run the command again",
+				))
+			case <-ticker.C:
+			}
+		}
+
+		checked[kind] = true
+	}
+
+	return nil
+}
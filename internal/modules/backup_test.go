@@ -0,0 +1,236 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	modulesfake "github.com/kyma-project/cli.v3/internal/modules/fake"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var testScaledObject = unstructured.Unstructured{Object: map[string]any{
+	"apiVersion": "keda.sh/v1alpha1",
+	"kind":       "ScaledObject",
+	"metadata": map[string]any{
+		"name":            "my-scaler",
+		"namespace":       "default",
+		"uid":             "1234",
+		"resourceVersion": "5",
+	},
+	"spec":   map[string]any{"scaleTargetRef": map[string]any{"name": "my-app"}},
+	"status": map[string]any{"health": "ok"},
+}}
+
+func TestBackupModule(t *testing.T) {
+	t.Run("backup config CR and user-defined resources", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		dir := filepath.Join(t.TempDir(), "keda-backup")
+		liveKedaCR := testKedaCR.DeepCopy()
+		liveKedaCR.Object["spec"] = map[string]any{"logging": "debug"}
+		liveKedaCR.Object["status"] = map[string]any{"state": "Ready"}
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: kyma.Kyma{
+					Spec: kyma.KymaSpec{
+						Modules: []kyma.Module{{Name: "keda", Channel: "fast", CustomResourcePolicy: "Ignore"}},
+					},
+				},
+				ReturnModuleInfo: kyma.KymaModuleInfo{
+					Status: kyma.ModuleStatus{Name: "keda", Version: "1.0.0"},
+				},
+				ReturnModuleTemplateList: kyma.ModuleTemplateList{
+					Items: []kyma.ModuleTemplate{testWaitModuleTemplate},
+				},
+			},
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnGetObj: *liveKedaCR,
+			},
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnUserDefinedResourcesOfModule: []unstructured.Unstructured{testScaledObject},
+		}
+
+		err := backupModule(out.NewToWriter(buffer), context.Background(), client, repo, "keda", dir)
+		require.Nil(t, err)
+		require.Equal(t, "keda module backed up to "+dir+" (config CRs: 1, resources: 1)\n", buffer.String())
+
+		moduleFile, readErr := os.ReadFile(filepath.Join(dir, backupModuleFile))
+		require.NoError(t, readErr)
+		require.Equal(t, "name: keda\nchannel: fast\ncustomResourcePolicy: Ignore\n", string(moduleFile))
+
+		configCRFile, readErr := os.ReadFile(filepath.Join(dir, backupConfigCRFile))
+		require.NoError(t, readErr)
+		require.Contains(t, string(configCRFile), "logging: debug\n")
+		require.NotContains(t, string(configCRFile), "status")
+
+		resourcesFile, readErr := os.ReadFile(filepath.Join(dir, backupResourcesFile))
+		require.NoError(t, readErr)
+		require.Contains(t, string(resourcesFile), "name: my-scaler\n")
+		require.NotContains(t, string(resourcesFile), "uid")
+		require.NotContains(t, string(resourcesFile), "resourceVersion")
+	})
+
+	t.Run("module not added to the Kyma CR", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		dir := t.TempDir()
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{},
+		}
+
+		err := backupModule(out.NewToWriter(buffer), context.Background(), client, &modulesfake.ModuleTemplatesRepo{}, "keda", dir)
+		require.NotNil(t, err)
+		require.Contains(t, err.String(), "module keda is not added to the Kyma CR")
+		require.NoFileExists(t, filepath.Join(dir, backupModuleFile))
+	})
+}
+
+func TestBackupAndRestoreModule_EmptyCustomResourcePolicy(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "keda-backup")
+	backupClient := &fake.KubeClient{
+		TestKymaInterface: &fake.KymaClient{
+			ReturnDefaultKyma: kyma.Kyma{
+				Spec: kyma.KymaSpec{
+					Modules: []kyma.Module{{Name: "keda", Channel: "fast"}},
+				},
+			},
+			ReturnModuleInfo: kyma.KymaModuleInfo{
+				Status: kyma.ModuleStatus{Name: "keda", Version: "1.0.0"},
+			},
+			ReturnModuleTemplateList: kyma.ModuleTemplateList{
+				Items: []kyma.ModuleTemplate{testWaitModuleTemplate},
+			},
+		},
+		TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+			ReturnGetObj: *testKedaCR.DeepCopy(),
+		},
+	}
+
+	clierr := backupModule(out.NewToWriter(bytes.NewBuffer([]byte{})), context.Background(), backupClient, &modulesfake.ModuleTemplatesRepo{}, "keda", dir)
+	require.Nil(t, clierr)
+
+	moduleFile, readErr := os.ReadFile(filepath.Join(dir, backupModuleFile))
+	require.NoError(t, readErr)
+	require.Equal(t, "name: keda\nchannel: fast\ncustomResourcePolicy: CreateAndDelete\n", string(moduleFile))
+
+	kymaClient := &fake.KymaClient{
+		ReturnModuleTemplateList: kyma.ModuleTemplateList{
+			Items: []kyma.ModuleTemplate{testKedaModuleTemplate},
+		},
+		ReturnModuleReleaseMetaList: kyma.ModuleReleaseMetaList{
+			Items: []kyma.ModuleReleaseMeta{testKedaModuleReleaseMeta},
+		},
+	}
+	restoreClient := &fake.KubeClient{
+		TestKymaInterface:            kymaClient,
+		TestRootlessDynamicInterface: &fake.RootlessDynamicClient{},
+	}
+
+	clierr = restoreModule(out.NewToWriter(bytes.NewBuffer([]byte{})), context.Background(), restoreClient, &modulesfake.ModuleTemplatesRepo{}, dir, time.Minute)
+	require.Nil(t, clierr)
+	require.Equal(t, []fake.FakeEnabledModule{{Name: "keda", Channel: "fast", CustomResourcePolicy: "CreateAndDelete"}}, kymaClient.EnabledModules)
+}
+
+func TestRestoreModule(t *testing.T) {
+	writeBackup := func(t *testing.T, files map[string]string) string {
+		dir := t.TempDir()
+		for name, content := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+		}
+		return dir
+	}
+
+	t.Run("restore core module with config CR and resources", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		dir := writeBackup(t, map[string]string{
+			backupModuleFile:    "name: keda\nchannel: fast\ncustomResourcePolicy: CreateAndDelete\n",
+			backupConfigCRFile:  "apiVersion: test/v1\nkind: Keda\nmetadata:\n  name: default\n  namespace: kyma-system\n",
+			backupResourcesFile: "apiVersion: keda.sh/v1alpha1\nkind: ScaledObject\nmetadata:\n  name: my-scaler\n  namespace: default\n",
+		})
+		kymaClient := &fake.KymaClient{
+			ReturnModuleTemplateList: kyma.ModuleTemplateList{
+				Items: []kyma.ModuleTemplate{testKedaModuleTemplate},
+			},
+			ReturnModuleReleaseMetaList: kyma.ModuleReleaseMetaList{
+				Items: []kyma.ModuleReleaseMeta{testKedaModuleReleaseMeta},
+			},
+		}
+		rootlessDynamicClient := &fake.RootlessDynamicClient{}
+		client := &fake.KubeClient{
+			TestKymaInterface:            kymaClient,
+			TestRootlessDynamicInterface: rootlessDynamicClient,
+		}
+
+		err := restoreModule(out.NewToWriter(buffer), context.Background(), client, &modulesfake.ModuleTemplatesRepo{}, dir, time.Minute)
+		require.Nil(t, err)
+		require.Equal(t, []fake.FakeEnabledModule{{Name: "keda", Channel: "fast", CustomResourcePolicy: "CreateAndDelete"}}, kymaClient.EnabledModules)
+		require.Len(t, rootlessDynamicClient.ApplyObjs, 2)
+		require.Equal(t, "Keda", rootlessDynamicClient.ApplyObjs[0].GetKind())
+		require.Equal(t, "ScaledObject", rootlessDynamicClient.ApplyObjs[1].GetKind())
+		require.Len(t, rootlessDynamicClient.ListObjs, 1)
+		require.Contains(t, buffer.String(), "keda module restored from "+dir+" (resources: 1)\n")
+	})
+
+	t.Run("restore core module from backup without custom resource policy", func(t *testing.T) {
+		dir := writeBackup(t, map[string]string{
+			backupModuleFile: "name: keda\nchannel: fast\n",
+		})
+		kymaClient := &fake.KymaClient{
+			ReturnModuleTemplateList: kyma.ModuleTemplateList{
+				Items: []kyma.ModuleTemplate{testKedaModuleTemplate},
+			},
+			ReturnModuleReleaseMetaList: kyma.ModuleReleaseMetaList{
+				Items: []kyma.ModuleReleaseMeta{testKedaModuleReleaseMeta},
+			},
+		}
+		client := &fake.KubeClient{
+			TestKymaInterface:            kymaClient,
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{},
+		}
+
+		err := restoreModule(out.NewToWriter(bytes.NewBuffer([]byte{})), context.Background(), client, &modulesfake.ModuleTemplatesRepo{}, dir, time.Minute)
+		require.Nil(t, err)
+		require.Equal(t, []fake.FakeEnabledModule{{Name: "keda", Channel: "fast", CustomResourcePolicy: "CreateAndDelete"}}, kymaClient.EnabledModules)
+	})
+
+	t.Run("CRD not available", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		dir := writeBackup(t, map[string]string{
+			backupModuleFile:    "name: keda\nchannel: fast\n",
+			backupResourcesFile: "apiVersion: keda.sh/v1alpha1\nkind: ScaledObject\nmetadata:\n  name: my-scaler\n",
+		})
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnModuleTemplateList: kyma.ModuleTemplateList{
+					Items: []kyma.ModuleTemplate{testKedaModuleTemplate},
+				},
+				ReturnModuleReleaseMetaList: kyma.ModuleReleaseMetaList{
+					Items: []kyma.ModuleReleaseMeta{testKedaModuleReleaseMeta},
+				},
+			},
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnErr: errors.New("no matches for kind ScaledObject"),
+			},
+		}
+
+		err := restoreModule(out.NewToWriter(buffer), context.Background(), client, &modulesfake.ModuleTemplatesRepo{}, dir, time.Millisecond)
+		require.NotNil(t, err)
+		require.Contains(t, err.String(), "failed to wait for the CRD of the keda.sh/v1alpha1 ScaledObject resources")
+	})
+
+	t.Run("missing backup", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+
+		err := restoreModule(out.NewToWriter(buffer), context.Background(), &fake.KubeClient{}, &modulesfake.ModuleTemplatesRepo{}, t.TempDir(), time.Minute)
+		require.NotNil(t, err)
+		require.Contains(t, err.String(), "failed to read the module backup")
+	})
+}
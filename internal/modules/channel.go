@@ -0,0 +1,332 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/kyma-project/cli.v3/internal/render"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultChannelDescription contains the default channel of the Kyma CR and channels available for modules
+type DefaultChannelDescription struct {
+	Channel string                  `json:"channel" yaml:"channel"`
+	Modules []ModuleChannelsSummary `json:"modules" yaml:"modules"`
+}
+
+type ModuleChannelsSummary struct {
+	Name     string                          `json:"name" yaml:"name"`
+	Channels []kyma.ChannelVersionAssignment `json:"channels" yaml:"channels"`
+}
+
+// ChannelChangePlan describes how modules from the Kyma CR are affected by the change of the default channel
+type ChannelChangePlan struct {
+	CurrentChannel string
+	Channel        string
+	Modules        []ModuleChannelChange
+}
+
+type ModuleChannelChange struct {
+	Name           string
+	CurrentVersion string
+	NewVersion     string
+	// ChannelOverride is the channel set for the module in the Kyma CR, the default channel doesn't affect the module
+	ChannelOverride string
+	// Unmanaged is true for modules not managed by the Kyma CR, the default channel doesn't affect the module
+	Unmanaged bool
+}
+
+// followsDefaultChannel returns true if the module version is selected by the default channel of the Kyma CR
+func (c *ModuleChannelChange) followsDefaultChannel() bool {
+	return c.ChannelOverride == "" && !c.Unmanaged
+}
+
+// Affected returns true if the module uses the default channel and its version is changed
+func (c *ModuleChannelChange) Affected() bool {
+	return c.followsDefaultChannel() && c.NewVersion != c.CurrentVersion
+}
+
+// Unavailable returns true if the module uses the default channel and has no version assigned to the new channel
+func (c *ModuleChannelChange) Unavailable() bool {
+	return c.followsDefaultChannel() && c.NewVersion == ""
+}
+
+// AffectedModules returns modules whose versions are changed by the new default channel
+func (p *ChannelChangePlan) AffectedModules() []ModuleChannelChange {
+	affected := []ModuleChannelChange{}
+	for _, module := range p.Modules {
+		if module.Affected() {
+			affected = append(affected, module)
+		}
+	}
+
+	return affected
+}
+
+// GetDefaultChannel returns the default channel of the Kyma CR and channels available for modules from ModuleReleaseMetas
+func GetDefaultChannel(ctx context.Context, client kube.Client) (*DefaultChannelDescription, clierror.Error) {
+	defaultKyma, err := client.Kyma().GetDefaultKyma(ctx)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to get default Kyma CR from the target Kyma environment"))
+	}
+
+	releaseMetas, err := client.Kyma().ListModuleReleaseMeta(ctx)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to list module release metas"))
+	}
+
+	description := &DefaultChannelDescription{
+		Channel: defaultKyma.Spec.Channel,
+		Modules: []ModuleChannelsSummary{},
+	}
+	for _, releaseMeta := range releaseMetas.Items {
+		description.Modules = append(description.Modules, ModuleChannelsSummary{
+			Name:     releaseMeta.Spec.ModuleName,
+			Channels: releaseMeta.Spec.Channels,
+		})
+	}
+	slices.SortFunc(description.Modules, func(a, b ModuleChannelsSummary) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return description, nil
+}
+
+// PlanDefaultChannelChange previews versions of modules from the Kyma CR after switching the default channel
+func PlanDefaultChannelChange(ctx context.Context, client kube.Client, channel string) (*ChannelChangePlan, clierror.Error) {
+	defaultKyma, err := client.Kyma().GetDefaultKyma(ctx)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to get default Kyma CR from the target Kyma environment"))
+	}
+
+	releaseMetas, err := client.Kyma().ListModuleReleaseMeta(ctx)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to list module release metas"))
+	}
+
+	if !slices.Contains(getAvailableChannels(*releaseMetas), channel) {
+		return nil, clierror.New(
+			fmt.Sprintf("the %s channel is not available for any module", channel),
+			"to list available channels, call the `kyma module channel get` command",
+		)
+	}
+
+	return planDefaultChannelChange(defaultKyma, *releaseMetas, channel), nil
+}
+
+func planDefaultChannelChange(defaultKyma *kyma.Kyma, releaseMetas kyma.ModuleReleaseMetaList, channel string) *ChannelChangePlan {
+	plan := &ChannelChangePlan{
+		CurrentChannel: defaultKyma.Spec.Channel,
+		Channel:        channel,
+		Modules:        []ModuleChannelChange{},
+	}
+
+	for _, module := range defaultKyma.Spec.Modules {
+		change := ModuleChannelChange{
+			Name:            module.Name,
+			CurrentVersion:  getKymaModuleStatus(defaultKyma, module.Name).Version,
+			NewVersion:      getVersionForChannel(releaseMetas, module.Name, channel),
+			ChannelOverride: module.Channel,
+			Unmanaged:       module.Managed != nil && !*module.Managed,
+		}
+		if !change.followsDefaultChannel() {
+			change.NewVersion = change.CurrentVersion
+		}
+
+		plan.Modules = append(plan.Modules, change)
+	}
+
+	return plan
+}
+
+// SetDefaultChannel updates the default channel in the Kyma CR
+func SetDefaultChannel(ctx context.Context, client kube.Client, channel string) clierror.Error {
+	defaultKyma, err := client.Kyma().GetDefaultKyma(ctx)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to get default Kyma CR from the target Kyma environment"))
+	}
+
+	defaultKyma.Spec.Channel = channel
+	err = client.Kyma().UpdateDefaultKyma(ctx, defaultKyma)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to update the default channel in the Kyma CR"))
+	}
+
+	return nil
+}
+
+// WaitForChannelChange waits until all affected modules are in new versions and are ready again
+func WaitForChannelChange(ctx context.Context, client kube.Client, plan *ChannelChangePlan, timeout time.Duration) clierror.Error {
+	return waitForChannelChange(out.Default, ctx, client, plan, timeout)
+}
+
+func waitForChannelChange(printer *out.Printer, ctx context.Context, client kube.Client, plan *ChannelChangePlan, timeout time.Duration) clierror.Error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	observer := newKymaObserver(printer, client)
+	defer observer.stop()
+
+	pending := plan.AffectedModules()
+	lastStates := map[string]string{}
+	for len(pending) > 0 {
+		defaultKyma, err := client.Kyma().GetDefaultKyma(timeoutCtx)
+		if err != nil {
+			printer.Debugfln("failed to get default Kyma CR: %v", err)
+		}
+
+		if defaultKyma != nil {
+			stillPending := []ModuleChannelChange{}
+			for _, module := range pending {
+				status := getKymaModuleStatus(defaultKyma, module.Name)
+				state := fmt.Sprintf("%s %s", valueOrNone(status.Version), valueOrNone(status.State))
+				if lastStates[module.Name] != state {
+					printer.Msgfln("%s module: version %s, state %s", module.Name, valueOrNone(status.Version), valueOrNone(status.State))
+					lastStates[module.Name] = state
+				}
+
+				if status.Version == module.NewVersion && status.State == "Error" {
+					return clierror.New(
+						fmt.Sprintf("the %s module is in the Error state", module.Name),
+						fmt.Sprintf("to see the module details, call the `kyma module describe %s` command", module.Name),
+					)
+				}
+				if status.Version != module.NewVersion || (status.State != "Ready" && status.State != "Warning") {
+					stillPending = append(stillPending, module)
+				}
+			}
+			pending = stillPending
+		}
+
+		if len(pending) == 0 {
+			break
+		}
+
+		if !observer.next(timeoutCtx) {
+			names := []string{}
+			for _, module := range pending {
+				names = append(names, module.Name)
+			}
+			return clierror.Wrap(timeoutCtx.Err(), clierror.New(
+				fmt.Sprintf("failed to wait for modules: %s", strings.Join(names, ", ")),
+				"to check the state of modules, call the `kyma module list` command",
+			))
+		}
+	}
+
+	return nil
+}
+
+// RenderDefaultChannel prints the default channel and channels available for modules
+func RenderDefaultChannel(description *DefaultChannelDescription, format types.Format) error {
+	return renderDefaultChannel(out.Default, description, format)
+}
+
+func renderDefaultChannel(printer *out.Printer, description *DefaultChannelDescription, format types.Format) error {
+	switch format {
+	case types.JSONFormat:
+		obj, err := json.MarshalIndent(description, "", "  ")
+		if err != nil {
+			return err
+		}
+		printer.Msgln(string(obj))
+	case types.YAMLFormat:
+		obj, err := yaml.Marshal(description)
+		if err != nil {
+			return err
+		}
+		printer.Msgln(string(obj))
+	default:
+		printer.Msgfln("Default channel: %s\n", valueOrNone(description.Channel))
+
+		rows := [][]interface{}{}
+		for _, module := range description.Modules {
+			channels := []string{}
+			for _, assignment := range module.Channels {
+				channels = append(channels, fmt.Sprintf("%s(%s)", assignment.Channel, assignment.Version))
+			}
+			rows = append(rows, []interface{}{module.Name, strings.Join(channels, ", ")})
+		}
+		render.Table(printer, []interface{}{"MODULE", "CHANNELS"}, rows)
+	}
+
+	return nil
+}
+
+// RenderChannelChangePlan prints versions of modules from the Kyma CR before and after the change of the default channel
+func RenderChannelChangePlan(plan *ChannelChangePlan) {
+	renderChannelChangePlan(out.Default, plan)
+}
+
+func renderChannelChangePlan(printer *out.Printer, plan *ChannelChangePlan) {
+	printer.Msgfln("Switching the default channel from %s to %s:\n", valueOrNone(plan.CurrentChannel), plan.Channel)
+
+	rows := [][]interface{}{}
+	for _, module := range plan.Modules {
+		change := "unchanged"
+		switch {
+		case module.Unmanaged:
+			change = "unmanaged"
+		case module.ChannelOverride != "":
+			change = fmt.Sprintf("unchanged (uses the %s channel)", module.ChannelOverride)
+		case module.Unavailable():
+			change = fmt.Sprintf("not available in the %s channel", plan.Channel)
+		case module.Affected():
+			change = "upgraded"
+			if isNewerModuleVersion(module.CurrentVersion, module.NewVersion) {
+				change = "downgraded"
+			}
+		}
+
+		rows = append(rows, []interface{}{module.Name, valueOrNone(module.CurrentVersion), valueOrNone(module.NewVersion), change})
+	}
+	render.Table(printer, []interface{}{"MODULE", "CURRENT VERSION", "NEW VERSION", "CHANGE"}, rows)
+}
+
+func getAvailableChannels(releaseMetas kyma.ModuleReleaseMetaList) []string {
+	channels := []string{}
+	for _, releaseMeta := range releaseMetas.Items {
+		for _, assignment := range releaseMeta.Spec.Channels {
+			if !slices.Contains(channels, assignment.Channel) {
+				channels = append(channels, assignment.Channel)
+			}
+		}
+	}
+
+	return channels
+}
+
+func getVersionForChannel(releaseMetas kyma.ModuleReleaseMetaList, moduleName, channel string) string {
+	for _, releaseMeta := range releaseMetas.Items {
+		if releaseMeta.Spec.ModuleName != moduleName {
+			continue
+		}
+
+		for _, assignment := range releaseMeta.Spec.Channels {
+			if assignment.Channel == channel {
+				return assignment.Version
+			}
+		}
+	}
+
+	return ""
+}
+
+func getKymaModuleStatus(defaultKyma *kyma.Kyma, moduleName string) kyma.ModuleStatus {
+	for _, status := range defaultKyma.Status.Modules {
+		if status.Name == moduleName {
+			return status
+		}
+	}
+
+	return kyma.ModuleStatus{Name: moduleName}
+}
@@ -0,0 +1,268 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func Test_planDefaultChannelChange(t *testing.T) {
+	defaultKyma := &kyma.Kyma{
+		Spec: kyma.KymaSpec{
+			Channel: "regular",
+			Modules: []kyma.Module{
+				{Name: "keda"},
+				{Name: "serverless", Channel: "regular"},
+				{Name: "istio"},
+				{Name: "btp-operator"},
+			},
+		},
+		Status: kyma.KymaStatus{
+			Modules: []kyma.ModuleStatus{
+				{Name: "keda", Version: "1.0.0"},
+				{Name: "serverless", Version: "1.1.0"},
+				{Name: "istio", Version: "2.0.0"},
+				{Name: "btp-operator", Version: "0.9.0"},
+			},
+		},
+	}
+
+	plan := planDefaultChannelChange(defaultKyma, fixChannelReleaseMetas(), "fast")
+
+	require.Equal(t, &ChannelChangePlan{
+		CurrentChannel: "regular",
+		Channel:        "fast",
+		Modules: []ModuleChannelChange{
+			{Name: "keda", CurrentVersion: "1.0.0", NewVersion: "1.1.0"},
+			{Name: "serverless", CurrentVersion: "1.1.0", NewVersion: "1.1.0", ChannelOverride: "regular"},
+			{Name: "istio", CurrentVersion: "2.0.0", NewVersion: "2.0.0"},
+			{Name: "btp-operator", CurrentVersion: "0.9.0", NewVersion: ""},
+		},
+	}, plan)
+	require.Equal(t, []ModuleChannelChange{
+		{Name: "keda", CurrentVersion: "1.0.0", NewVersion: "1.1.0"},
+		{Name: "btp-operator", CurrentVersion: "0.9.0", NewVersion: ""},
+	}, plan.AffectedModules())
+	require.True(t, plan.Modules[3].Unavailable())
+}
+
+func Test_planDefaultChannelChange_unmanagedModule(t *testing.T) {
+	defaultKyma := &kyma.Kyma{
+		Spec: kyma.KymaSpec{
+			Channel: "regular",
+			Modules: []kyma.Module{
+				{Name: "keda", Managed: ptr.To(false)},
+				{Name: "btp-operator", Managed: ptr.To(false)},
+			},
+		},
+		Status: kyma.KymaStatus{
+			Modules: []kyma.ModuleStatus{
+				{Name: "keda", Version: "1.0.0"},
+				{Name: "btp-operator", Version: "0.9.0"},
+			},
+		},
+	}
+
+	plan := planDefaultChannelChange(defaultKyma, fixChannelReleaseMetas(), "fast")
+
+	require.Equal(t, []ModuleChannelChange{
+		{Name: "keda", CurrentVersion: "1.0.0", NewVersion: "1.0.0", Unmanaged: true},
+		{Name: "btp-operator", CurrentVersion: "0.9.0", NewVersion: "0.9.0", Unmanaged: true},
+	}, plan.Modules)
+	require.Empty(t, plan.AffectedModules())
+	require.False(t, plan.Modules[1].Unavailable())
+}
+
+func TestPlanDefaultChannelChange(t *testing.T) {
+	t.Run("unknown channel", func(t *testing.T) {
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnModuleReleaseMetaList: fixChannelReleaseMetas(),
+			},
+		}
+
+		plan, clierr := PlanDefaultChannelChange(context.Background(), client, "experimental")
+
+		require.Nil(t, plan)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the experimental channel is not available for any module")
+	})
+}
+
+func TestSetDefaultChannel(t *testing.T) {
+	kymaClient := &fake.KymaClient{
+		ReturnDefaultKyma: kyma.Kyma{Spec: kyma.KymaSpec{Channel: "regular"}},
+	}
+	client := &fake.KubeClient{TestKymaInterface: kymaClient}
+
+	clierr := SetDefaultChannel(context.Background(), client, "fast")
+
+	require.Nil(t, clierr)
+	require.Equal(t, "fast", kymaClient.UpdateDefaultKymas[0].Spec.Channel)
+}
+
+func Test_renderChannelChangePlan(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{})
+	plan := &ChannelChangePlan{
+		CurrentChannel: "regular",
+		Channel:        "fast",
+		Modules: []ModuleChannelChange{
+			{Name: "keda", CurrentVersion: "1.0.0", NewVersion: "1.1.0"},
+			{Name: "serverless", CurrentVersion: "1.1.0", NewVersion: "1.1.0", ChannelOverride: "regular"},
+			{Name: "istio", CurrentVersion: "2.1.0", NewVersion: "2.0.0"},
+			{Name: "btp-operator", CurrentVersion: "0.9.0"},
+			{Name: "telemetry", CurrentVersion: "1.2.0", NewVersion: "1.2.0", Unmanaged: true},
+		},
+	}
+
+	renderChannelChangePlan(out.NewToWriter(buffer), plan)
+
+	require.Contains(t, buffer.String(), "Switching the default channel from regular to fast:\n")
+	require.Regexp(t, `keda\s+1.0.0\s+1.1.0\s+upgraded`, buffer.String())
+	require.Regexp(t, `serverless\s+1.1.0\s+1.1.0\s+unchanged \(uses the regular channel\)`, buffer.String())
+	require.Regexp(t, `istio\s+2.1.0\s+2.0.0\s+downgraded`, buffer.String())
+	require.Regexp(t, `btp-operator\s+0.9.0\s+<none>\s+not available in the fast channel`, buffer.String())
+	require.Regexp(t, `telemetry\s+1.2.0\s+1.2.0\s+unmanaged`, buffer.String())
+}
+
+func Test_renderDefaultChannel(t *testing.T) {
+	description := &DefaultChannelDescription{
+		Channel: "regular",
+		Modules: []ModuleChannelsSummary{
+			{Name: "keda", Channels: []kyma.ChannelVersionAssignment{{Channel: "regular", Version: "1.0.0"}, {Channel: "fast", Version: "1.1.0"}}},
+		},
+	}
+
+	t.Run("render table", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+
+		err := renderDefaultChannel(out.NewToWriter(buffer), description, types.DefaultFormat)
+
+		require.NoError(t, err)
+		require.Contains(t, buffer.String(), "Default channel: regular\n")
+		require.Regexp(t, `keda\s+regular\(1.0.0\), fast\(1.1.0\)`, buffer.String())
+	})
+
+	t.Run("render json", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+
+		err := renderDefaultChannel(out.NewToWriter(buffer), description, types.JSONFormat)
+
+		require.NoError(t, err)
+		require.Contains(t, buffer.String(), `"channel": "regular"`)
+		require.Contains(t, buffer.String(), `"version": "1.1.0"`)
+	})
+}
+
+func Test_waitForChannelChange(t *testing.T) {
+	plan := &ChannelChangePlan{
+		Channel: "fast",
+		Modules: []ModuleChannelChange{
+			{Name: "keda", CurrentVersion: "1.0.0", NewVersion: "1.1.0"},
+			{Name: "serverless", CurrentVersion: "1.1.0", NewVersion: "1.1.0", ChannelOverride: "regular"},
+		},
+	}
+
+	t.Run("affected modules are ready", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: kyma.Kyma{Status: kyma.KymaStatus{Modules: []kyma.ModuleStatus{
+					{Name: "keda", Version: "1.1.0", State: "Ready"},
+					{Name: "serverless", Version: "1.1.0", State: "Processing"},
+				}}},
+			},
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{},
+		}
+
+		clierr := waitForChannelChange(out.NewToWriter(buffer), context.Background(), client, plan, time.Second)
+
+		require.Nil(t, clierr)
+		require.Equal(t, "keda module: version 1.1.0, state Ready\n", buffer.String())
+	})
+
+	t.Run("wait for Kyma CR changes", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := &fake.KubeClient{
+			TestKymaInterface: &sequenceKymaClient{
+				defaultKymas: []kyma.Kyma{
+					{Status: kyma.KymaStatus{Modules: []kyma.ModuleStatus{{Name: "keda", Version: "1.0.0", State: "Ready"}}}},
+					{Status: kyma.KymaStatus{Modules: []kyma.ModuleStatus{{Name: "keda", Version: "1.1.0", State: "Processing"}}}},
+					{Status: kyma.KymaStatus{Modules: []kyma.ModuleStatus{{Name: "keda", Version: "1.1.0", State: "Ready"}}}},
+				},
+			},
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnWatcher: newTestWatcher(2),
+			},
+		}
+
+		clierr := waitForChannelChange(out.NewToWriter(buffer), context.Background(), client, plan, time.Second)
+
+		require.Nil(t, clierr)
+		require.Equal(t, "keda module: version 1.0.0, state Ready\n"+
+			"keda module: version 1.1.0, state Processing\n"+
+			"keda module: version 1.1.0, state Ready\n", buffer.String())
+	})
+
+	t.Run("affected module is in the Error state", func(t *testing.T) {
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: kyma.Kyma{Status: kyma.KymaStatus{Modules: []kyma.ModuleStatus{
+					{Name: "keda", Version: "1.1.0", State: "Error"},
+				}}},
+			},
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{},
+		}
+
+		clierr := waitForChannelChange(out.NewToWriter(bytes.NewBuffer([]byte{})), context.Background(), client, plan, time.Second)
+
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the keda module is in the Error state")
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: kyma.Kyma{Status: kyma.KymaStatus{Modules: []kyma.ModuleStatus{
+					{Name: "keda", Version: "1.0.0", State: "Ready"},
+				}}},
+			},
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{},
+		}
+
+		clierr := waitForChannelChange(out.NewToWriter(bytes.NewBuffer([]byte{})), context.Background(), client, plan, 10*time.Millisecond)
+
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to wait for modules: keda")
+	})
+}
+
+func fixChannelReleaseMetas() kyma.ModuleReleaseMetaList {
+	return kyma.ModuleReleaseMetaList{
+		Items: []kyma.ModuleReleaseMeta{
+			{Spec: kyma.ModuleReleaseMetaSpec{ModuleName: "keda", Channels: []kyma.ChannelVersionAssignment{
+				{Channel: "regular", Version: "1.0.0"},
+				{Channel: "fast", Version: "1.1.0"},
+			}}},
+			{Spec: kyma.ModuleReleaseMetaSpec{ModuleName: "serverless", Channels: []kyma.ChannelVersionAssignment{
+				{Channel: "regular", Version: "1.1.0"},
+				{Channel: "fast", Version: "1.2.0"},
+			}}},
+			{Spec: kyma.ModuleReleaseMetaSpec{ModuleName: "istio", Channels: []kyma.ChannelVersionAssignment{
+				{Channel: "regular", Version: "2.0.0"},
+				{Channel: "fast", Version: "2.0.0"},
+			}}},
+			{Spec: kyma.ModuleReleaseMetaSpec{ModuleName: "btp-operator", Channels: []kyma.ChannelVersionAssignment{
+				{Channel: "regular", Version: "0.9.0"},
+			}}},
+		},
+	}
+}
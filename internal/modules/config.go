@@ -0,0 +1,162 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/extensions/parameters"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// FindInstalledModuleTemplate returns the module template of the core module installed in the Kyma CR
+func FindInstalledModuleTemplate(ctx context.Context, client kube.Client, module string) (*kyma.ModuleTemplate, error) {
+	info, err := client.Kyma().GetModuleInfo(ctx, module)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the module info from the target Kyma environment")
+	}
+
+	if info.Status.Version == "" {
+		return nil, errors.Errorf("module %s is not installed", module)
+	}
+
+	return findMatchingModuleTemplate(ctx, client, info.Status)
+}
+
+// GetModuleConfig returns the live config CR of the module
+// the CR is identified by the default CR (spec.data) from the module template
+func GetModuleConfig(ctx context.Context, client kube.Client, moduleTemplate *kyma.ModuleTemplate) (*unstructured.Unstructured, clierror.Error) {
+	data := moduleTemplate.Spec.Data
+	if len(data.Object) == 0 {
+		return nil, clierror.New(fmt.Sprintf("the %s module has no config CR", moduleTemplate.Spec.ModuleName))
+	}
+
+	cr, err := getModuleConfig(ctx, client, moduleTemplate)
+	if apierrors.IsNotFound(err) {
+		return nil, clierror.Wrap(err, clierror.New(
+			fmt.Sprintf("the %s module config CR %s/%s does not exist", moduleTemplate.Spec.ModuleName, getModuleConfigNamespace(data), data.GetName()),
+			"to create the default config CR, add the module with the --default-config-cr flag",
+		))
+	}
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to get the module config CR"))
+	}
+
+	return cleanModuleConfig(cr), nil
+}
+
+func getModuleConfig(ctx context.Context, client kube.Client, moduleTemplate *kyma.ModuleTemplate) (*unstructured.Unstructured, error) {
+	data := moduleTemplate.Spec.Data
+	unstruct := generateUnstruct(data.GetAPIVersion(), data.GetKind(), data.GetName(), getModuleConfigNamespace(data))
+	return client.RootlessDynamic().Get(ctx, &unstruct)
+}
+
+func getModuleConfigNamespace(data unstructured.Unstructured) string {
+	if data.GetNamespace() == "" {
+		return "kyma-system"
+	}
+
+	return data.GetNamespace()
+}
+
+// cleanModuleConfig removes fields managed by the cluster that can't be applied back
+func cleanModuleConfig(cr *unstructured.Unstructured) *unstructured.Unstructured {
+	cleaned := cr.DeepCopy()
+	unstructured.RemoveNestedField(cleaned.Object, "metadata", "managedFields")
+	return cleaned
+}
+
+// cleanAppliedModuleConfig removes fields managed by the cluster so they are not sent back with the applied config
+func cleanAppliedModuleConfig(cr *unstructured.Unstructured) *unstructured.Unstructured {
+	cleaned := cr.DeepCopy()
+	for _, field := range []string{"managedFields", "resourceVersion"} {
+		unstructured.RemoveNestedField(cleaned.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(cleaned.Object, "status")
+	return cleaned
+}
+
+// SetModuleConfigValues sets values in format <path>=<value> in the config CR
+// type of every value is based on the field type from the CR's CRD schema
+func SetModuleConfigValues(ctx context.Context, client kube.Client, cr *unstructured.Unstructured, values []string) clierror.Error {
+	crSchema, err := getCRSchema(ctx, client, cr)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to get the config CR schema"))
+	}
+
+	return setModuleConfigValues(crSchema, cr, values)
+}
+
+func setModuleConfigValues(crSchema map[string]any, cr *unstructured.Unstructured, values []string) clierror.Error {
+	typedValues := []parameters.Value{}
+	for _, value := range values {
+		path, rawValue, ok := strings.Cut(value, "=")
+		if !ok || path == "" {
+			return clierror.New(
+				fmt.Sprintf("invalid value '%s'", value),
+				"values must be in format <path>=<value>, for example: spec.logging.level=debug",
+			)
+		}
+
+		if !strings.HasPrefix(path, ".") {
+			path = "." + path
+		}
+
+		typedValue := parameters.NewTyped(getValueType(getPathSchema(crSchema, path)), path)
+		err := typedValue.Set(rawValue)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to parse value for path %s", path)))
+		}
+
+		typedValues = append(typedValues, typedValue)
+	}
+
+	return parameters.Set(cr.Object, typedValues)
+}
+
+func getValueType(valueSchema map[string]any) parameters.ConfigFieldType {
+	switch valueSchema["type"] {
+	case "integer":
+		return parameters.IntCustomType
+	case "number":
+		return parameters.NumberCustomType
+	case "boolean":
+		return parameters.BoolCustomType
+	default:
+		return parameters.StringCustomType
+	}
+}
+
+// ApplyModuleConfig validates the config CR against its CRD schema and applies it
+func ApplyModuleConfig(ctx context.Context, client kube.Client, cr *unstructured.Unstructured) clierror.Error {
+	return applyModuleConfig(out.Default, ctx, client, cr)
+}
+
+func applyModuleConfig(printer *out.Printer, ctx context.Context, client kube.Client, cr *unstructured.Unstructured) clierror.Error {
+	crSchema, err := getCRSchema(ctx, client, cr)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to get the config CR schema"))
+	}
+
+	violations := validateCRSpec(crSchema, cr)
+	if len(violations) > 0 {
+		return clierror.New(
+			fmt.Sprintf("the %s/%s CR is not valid", cr.GetNamespace(), cr.GetName()),
+			violations...,
+		)
+	}
+
+	err = client.RootlessDynamic().Apply(ctx, cleanAppliedModuleConfig(cr), false)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to apply the %s/%s CR", cr.GetNamespace(), cr.GetName())))
+	}
+
+	printer.Msgfln("%s/%s CR applied", cr.GetNamespace(), cr.GetName())
+	return nil
+}
@@ -0,0 +1,192 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestGetModuleConfig(t *testing.T) {
+	t.Run("get module config", func(t *testing.T) {
+		liveCR := testKedaCR.DeepCopy()
+		liveCR.Object["metadata"].(map[string]any)["managedFields"] = []any{map[string]any{"manager": "cli"}}
+		liveCR.Object["spec"] = map[string]any{"replicas": int64(1)}
+		rootlessDynamic := &fake.RootlessDynamicClient{
+			ReturnGetObj: *liveCR,
+		}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: rootlessDynamic,
+		}
+
+		cr, clierr := GetModuleConfig(context.Background(), &client, &testWaitModuleTemplate)
+		require.Nil(t, clierr)
+		require.Equal(t, map[string]any{"replicas": int64(1)}, cr.Object["spec"])
+		_, found, _ := unstructured.NestedSlice(cr.Object, "metadata", "managedFields")
+		require.False(t, found)
+		require.Equal(t, "default", rootlessDynamic.GetObjs[0].GetName())
+		require.Equal(t, "kyma-system", rootlessDynamic.GetObjs[0].GetNamespace())
+	})
+
+	t.Run("module without config CR", func(t *testing.T) {
+		moduleTemplate := &kyma.ModuleTemplate{
+			Spec: kyma.ModuleTemplateSpec{ModuleName: "keda"},
+		}
+
+		cr, clierr := GetModuleConfig(context.Background(), &fake.KubeClient{}, moduleTemplate)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the keda module has no config CR")
+		require.Nil(t, cr)
+	})
+
+	t.Run("config CR not found", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnGetErr: apierrors.NewNotFound(schema.GroupResource{Group: "test", Resource: "kedas"}, "default"),
+			},
+		}
+
+		cr, clierr := GetModuleConfig(context.Background(), &client, &testWaitModuleTemplate)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the keda module config CR kyma-system/default does not exist")
+		require.Contains(t, clierr.String(), "--default-config-cr")
+		require.Nil(t, cr)
+	})
+}
+
+func TestSetModuleConfigValues(t *testing.T) {
+	t.Run("set typed values", func(t *testing.T) {
+		cr := testKedaCR.DeepCopy()
+		cr.Object["spec"] = map[string]any{"logging": map[string]any{"level": "info"}}
+
+		clierr := setModuleConfigValues(testKedaCRSchema, cr, []string{
+			"spec.logging.level=debug",
+			".spec.replicas=3",
+			"spec.enabled=true",
+			"spec.cpu=2",
+		})
+		require.Nil(t, clierr)
+		require.Equal(t, map[string]any{
+			"logging":  map[string]any{"level": "debug"},
+			"replicas": int64(3),
+			"enabled":  true,
+			"cpu":      "2",
+		}, cr.Object["spec"])
+	})
+
+	t.Run("set number value", func(t *testing.T) {
+		cr := testKedaCR.DeepCopy()
+
+		clierr := setModuleConfigValues(testKedaCRSchema, cr, []string{"spec.ratio=0.75"})
+		require.Nil(t, clierr)
+		require.Equal(t, map[string]any{"ratio": 0.75}, cr.Object["spec"])
+		require.Empty(t, validateCRSpec(testKedaCRSchema, cr))
+	})
+
+	t.Run("number value of wrong type", func(t *testing.T) {
+		clierr := setModuleConfigValues(testKedaCRSchema, testKedaCR.DeepCopy(), []string{"spec.ratio=high"})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to parse value for path .spec.ratio")
+	})
+
+	t.Run("invalid value format", func(t *testing.T) {
+		clierr := setModuleConfigValues(testKedaCRSchema, testKedaCR.DeepCopy(), []string{"spec.replicas"})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "invalid value 'spec.replicas'")
+	})
+
+	t.Run("value of wrong type", func(t *testing.T) {
+		clierr := setModuleConfigValues(testKedaCRSchema, testKedaCR.DeepCopy(), []string{"spec.replicas=three"})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to parse value for path .spec.replicas")
+	})
+}
+
+func TestApplyModuleConfig(t *testing.T) {
+	t.Run("apply valid config", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		cr := testKedaCR.DeepCopy()
+		cr.Object["spec"] = map[string]any{"replicas": int64(2)}
+		rootlessDynamic := &fake.RootlessDynamicClient{
+			ReturnListObjs: &unstructured.UnstructuredList{
+				Items: []unstructured.Unstructured{testKedaCRD},
+			},
+		}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: rootlessDynamic,
+		}
+
+		clierr := applyModuleConfig(out.NewToWriter(buffer), context.Background(), &client, cr)
+		require.Nil(t, clierr)
+		require.Equal(t, []unstructured.Unstructured{*cr}, rootlessDynamic.ApplyObjs)
+		require.Equal(t, "kyma-system/default CR applied\n", buffer.String())
+	})
+
+	t.Run("apply config without fields managed by the cluster", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		cr := testKedaCR.DeepCopy()
+		cr.Object["spec"] = map[string]any{"replicas": int64(2)}
+		cr.Object["status"] = map[string]any{"state": "Ready"}
+		cr.SetResourceVersion("123")
+		cr.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl"}})
+		rootlessDynamic := &fake.RootlessDynamicClient{
+			ReturnListObjs: &unstructured.UnstructuredList{
+				Items: []unstructured.Unstructured{testKedaCRD},
+			},
+		}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: rootlessDynamic,
+		}
+
+		clierr := applyModuleConfig(out.NewToWriter(buffer), context.Background(), &client, cr)
+		require.Nil(t, clierr)
+		require.Len(t, rootlessDynamic.ApplyObjs, 1)
+		applied := rootlessDynamic.ApplyObjs[0]
+		require.Empty(t, applied.GetResourceVersion())
+		require.Empty(t, applied.GetManagedFields())
+		require.NotContains(t, applied.Object, "status")
+		require.Equal(t, map[string]any{"replicas": int64(2)}, applied.Object["spec"])
+	})
+
+	t.Run("do not apply invalid config", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		cr := testKedaCR.DeepCopy()
+		cr.Object["spec"] = map[string]any{"replicas": "two"}
+		rootlessDynamic := &fake.RootlessDynamicClient{
+			ReturnListObjs: &unstructured.UnstructuredList{
+				Items: []unstructured.Unstructured{testKedaCRD},
+			},
+		}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: rootlessDynamic,
+		}
+
+		clierr := applyModuleConfig(out.NewToWriter(buffer), context.Background(), &client, cr)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the kyma-system/default CR is not valid")
+		require.Contains(t, clierr.String(), ".spec.replicas: expected integer, got string")
+		require.Empty(t, rootlessDynamic.ApplyObjs)
+	})
+
+	t.Run("apply error", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnErr: errors.New("test error"),
+			},
+		}
+
+		clierr := applyModuleConfig(out.NewToWriter(buffer), context.Background(), &client, testKedaCR.DeepCopy())
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to get the config CR schema")
+	})
+}
@@ -0,0 +1,353 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/kube/snapshot"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/out"
+)
+
+// annotations are read only by the CLI, so they use the CLI prefix instead of the Kyma operator one
+// see docs/contributor/module-template-annotations.md
+const (
+	// RequiresAnnotation contains comma-separated names of modules the module can't work without
+	RequiresAnnotation = "cli.kyma-project.io/requires"
+	// ConflictsAnnotation contains comma-separated names of modules the module can't be installed with
+	ConflictsAnnotation = "cli.kyma-project.io/conflicts"
+)
+
+// InstallPlan describes what must be done before the module is added
+type InstallPlan struct {
+	Module string
+	// Dependencies contains names of missing core modules in the order they must be added
+	Dependencies []string
+	// Conflicts contains descriptions of conflicts with installed or planned modules
+	Conflicts []string
+}
+
+// PlanCoreModuleInstall resolves dependencies and conflicts of the core module from the channel
+// dependencies are read from the version of the module assigned to the channel or from the latest one
+func PlanCoreModuleInstall(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, channel string) (*InstallPlan, clierror.Error) {
+	resolver, clierr := newDependencyResolver(ctx, client, repo)
+	if clierr != nil {
+		return nil, clierr
+	}
+
+	moduleTemplate := resolver.availableCoreModuleTemplate(module, channel)
+	if moduleTemplate == nil {
+		// skip because availability of the module is validated when it's enabled
+		return &InstallPlan{Module: module}, nil
+	}
+
+	return resolver.planWithClierr(moduleTemplate, false)
+}
+
+// PlanCommunityModuleInstall resolves dependencies and conflicts of the community module
+func PlanCommunityModuleInstall(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleTemplate *kyma.ModuleTemplate) (*InstallPlan, clierror.Error) {
+	resolver, clierr := newDependencyResolver(ctx, client, repo)
+	if clierr != nil {
+		return nil, clierr
+	}
+
+	return resolver.planWithClierr(moduleTemplate, true)
+}
+
+// FindDependents returns names of installed modules that require the module
+func FindDependents(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module string) ([]string, clierror.Error) {
+	resolver, clierr := newDependencyResolver(ctx, client, repo)
+	if clierr != nil {
+		return nil, clierr
+	}
+
+	return resolver.dependents(module), nil
+}
+
+// CheckDependents returns error if installed modules require the module
+// it prints a warning and doesn't block the deletion if installed modules can't be checked
+func CheckDependents(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module string) clierror.Error {
+	return checkDependents(out.Default, ctx, client, repo, module)
+}
+
+func checkDependents(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module string) clierror.Error {
+	dependents, clierr := FindDependents(ctx, client, repo, module)
+	if clierr != nil {
+		printer.Errfln("Warning:\n  failed to check if installed modules require the %s module, use the --debug flag to see more details\n", module)
+		printer.Debugfln("%s", clierr.String())
+		return nil
+	}
+
+	if len(dependents) > 0 {
+		return clierror.New(
+			fmt.Sprintf("failed to delete the %s module because installed modules require it: %s", module, strings.Join(dependents, ", ")),
+			"delete modules that require it first",
+			"to delete the module anyway, use the --force flag",
+		)
+	}
+
+	return nil
+}
+
+type dependencyResolver struct {
+	coreModuleTemplates      []kyma.ModuleTemplate
+	communityModuleTemplates []kyma.ModuleTemplate
+	moduleReleaseMetas       kyma.ModuleReleaseMetaList
+	defaultChannel           string
+	installed                ModulesList
+}
+
+func newDependencyResolver(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository) (*dependencyResolver, clierror.Error) {
+	client = snapshot.NewClient(client)
+
+	installed, err := ListInstalled(ctx, client, repo, false)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to list installed modules"))
+	}
+
+	moduleTemplates, err := client.Kyma().ListModuleTemplate(ctx)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to list available modules"))
+	}
+
+	communityModuleTemplates, err := repo.Community(ctx)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to list community modules"))
+	}
+
+	resolver := &dependencyResolver{
+		communityModuleTemplates: communityModuleTemplates,
+		installed:                installed,
+	}
+
+	for _, moduleTemplate := range moduleTemplates.Items {
+		if moduleTemplate.Spec.ModuleName != "" && !isCommunityModule(&moduleTemplate) {
+			resolver.coreModuleTemplates = append(resolver.coreModuleTemplates, moduleTemplate)
+		}
+	}
+
+	// ModuleReleaseMetas and the Kyma CR don't exist on older or unmanaged clusters
+	// in this case dependencies are read from the latest versions of modules
+	if moduleReleaseMetas, err := client.Kyma().ListModuleReleaseMeta(ctx); err == nil {
+		resolver.moduleReleaseMetas = *moduleReleaseMetas
+	}
+	if defaultKyma, err := client.Kyma().GetDefaultKyma(ctx); err == nil {
+		resolver.defaultChannel = defaultKyma.Spec.Channel
+	}
+
+	return resolver, nil
+}
+
+func (r *dependencyResolver) planWithClierr(moduleTemplate *kyma.ModuleTemplate, community bool) (*InstallPlan, clierror.Error) {
+	plan, err := r.plan(moduleTemplate, community)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New(
+			"failed to resolve module dependencies",
+			fmt.Sprintf("make sure modules listed in the %s annotation are available in the catalog", RequiresAnnotation),
+		))
+	}
+
+	return plan, nil
+}
+
+func (r *dependencyResolver) plan(moduleTemplate *kyma.ModuleTemplate, community bool) (*InstallPlan, error) {
+	module := moduleTemplate.Spec.ModuleName
+	plan := &InstallPlan{
+		Module: module,
+	}
+
+	planned := map[string]*kyma.ModuleTemplate{}
+	err := r.addDependencies(plan, moduleTemplate, planned, map[string]bool{module: true})
+	if err != nil {
+		return nil, err
+	}
+	planned[module] = moduleTemplate
+
+	conflicts := map[string]bool{}
+	addConflict := func(module, conflictingModule, msg string) {
+		// both modules can declare the same conflict
+		key := strings.Join(slices.Sorted(slices.Values([]string{module, conflictingModule})), "/")
+		if !conflicts[key] {
+			conflicts[key] = true
+			plan.Conflicts = append(plan.Conflicts, msg)
+		}
+	}
+
+	for _, name := range append(slices.Clone(plan.Dependencies), module) {
+		for _, conflictingModule := range getConflictingModules(planned[name]) {
+			if r.isInstalled(conflictingModule) || planned[conflictingModule] != nil {
+				addConflict(name, conflictingModule, fmt.Sprintf("the %s module conflicts with the %s module", name, conflictingModule))
+			}
+		}
+
+		for _, installedModule := range r.installed {
+			if installedModule.Name == name {
+				if name == module && installedModule.CommunityModule != community {
+					// module with the same name can't be installed as the core and the community module at the same time
+					addConflict(name, name, fmt.Sprintf("the %s %s module conflicts with the installed %s %s module", moduleKind(community), name, moduleKind(installedModule.CommunityModule), name))
+				}
+				continue
+			}
+
+			installedModuleTemplate := r.installedModuleTemplate(installedModule)
+			if slices.Contains(getConflictingModules(installedModuleTemplate), name) {
+				addConflict(name, installedModule.Name, fmt.Sprintf("the installed %s module conflicts with the %s module", installedModule.Name, name))
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+// addDependencies adds missing dependencies to the plan, dependencies of a module are added before the module
+func (r *dependencyResolver) addDependencies(plan *InstallPlan, moduleTemplate *kyma.ModuleTemplate, planned map[string]*kyma.ModuleTemplate, path map[string]bool) error {
+	for _, dependency := range getRequiredModules(moduleTemplate) {
+		if r.isInstalled(dependency) || planned[dependency] != nil {
+			continue
+		}
+
+		if path[dependency] {
+			return fmt.Errorf("the %s and %s modules require each other", moduleTemplate.Spec.ModuleName, dependency)
+		}
+
+		dependencyTemplate := r.availableCoreModuleTemplate(dependency, "")
+		if dependencyTemplate == nil {
+			return fmt.Errorf("the %s module requires the %s module that is not available in the catalog", moduleTemplate.Spec.ModuleName, dependency)
+		}
+
+		path[dependency] = true
+		err := r.addDependencies(plan, dependencyTemplate, planned, path)
+		if err != nil {
+			return err
+		}
+		delete(path, dependency)
+
+		planned[dependency] = dependencyTemplate
+		plan.Dependencies = append(plan.Dependencies, dependency)
+	}
+
+	return nil
+}
+
+func (r *dependencyResolver) dependents(module string) []string {
+	dependents := []string{}
+	for _, installedModule := range r.installed {
+		if installedModule.Name == module {
+			continue
+		}
+
+		installedModuleTemplate := r.installedModuleTemplate(installedModule)
+		if slices.Contains(getRequiredModules(installedModuleTemplate), module) {
+			dependents = append(dependents, installedModule.Name)
+		}
+	}
+
+	return dependents
+}
+
+func (r *dependencyResolver) isInstalled(module string) bool {
+	for _, installedModule := range r.installed {
+		if installedModule.Name == module {
+			return true
+		}
+	}
+
+	return false
+}
+
+// availableCoreModuleTemplate returns the ModuleTemplate of the core module assigned to the channel
+// or the latest one if the module isn't assigned to the channel
+func (r *dependencyResolver) availableCoreModuleTemplate(module, channel string) *kyma.ModuleTemplate {
+	if channel == "" {
+		channel = r.defaultChannel
+	}
+
+	var latest *kyma.ModuleTemplate
+	for i, moduleTemplate := range r.coreModuleTemplates {
+		if moduleTemplate.Spec.ModuleName != module {
+			continue
+		}
+
+		if slices.Contains(getAssignedChannels(r.moduleReleaseMetas, module, moduleTemplate.Spec.Version), channel) {
+			return &r.coreModuleTemplates[i]
+		}
+
+		if latest == nil || isNewerModuleVersion(moduleTemplate.Spec.Version, latest.Spec.Version) {
+			latest = &r.coreModuleTemplates[i]
+		}
+	}
+
+	return latest
+}
+
+func (r *dependencyResolver) installedModuleTemplate(module Module) *kyma.ModuleTemplate {
+	if module.CommunityModule {
+		for i, moduleTemplate := range r.communityModuleTemplates {
+			if getModulesOrigin(&moduleTemplate) == module.Origin {
+				return &r.communityModuleTemplates[i]
+			}
+		}
+		return nil
+	}
+
+	for i, moduleTemplate := range r.coreModuleTemplates {
+		if moduleTemplate.Spec.ModuleName == module.Name && moduleTemplate.Spec.Version == module.InstallDetails.Version {
+			return &r.coreModuleTemplates[i]
+		}
+	}
+
+	return r.availableCoreModuleTemplate(module.Name, module.InstallDetails.Channel)
+}
+
+func getRequiredModules(moduleTemplate *kyma.ModuleTemplate) []string {
+	if moduleTemplate == nil {
+		return nil
+	}
+	return parseModuleNames(moduleTemplate.Annotations[RequiresAnnotation])
+}
+
+func getConflictingModules(moduleTemplate *kyma.ModuleTemplate) []string {
+	if moduleTemplate == nil {
+		return nil
+	}
+	return parseModuleNames(moduleTemplate.Annotations[ConflictsAnnotation])
+}
+
+func parseModuleNames(value string) []string {
+	names := []string{}
+	for name := range strings.SplitSeq(value, ",") {
+		name = strings.TrimSpace(name)
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+func isNewerModuleVersion(newVersion, oldVersion string) bool {
+	newV, err := semver.NewVersion(newVersion)
+	if err != nil {
+		return false
+	}
+
+	oldV, err := semver.NewVersion(oldVersion)
+	if err != nil {
+		return true
+	}
+
+	return newV.GreaterThan(oldV)
+}
+
+func moduleKind(community bool) string {
+	if community {
+		return "community"
+	}
+	return "core"
+}
@@ -0,0 +1,231 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	modulesfake "github.com/kyma-project/cli.v3/internal/modules/fake"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDependencyResolver_plan(t *testing.T) {
+	t.Run("adds missing dependencies before modules that require them", func(t *testing.T) {
+		resolver := &dependencyResolver{
+			coreModuleTemplates: []kyma.ModuleTemplate{
+				fixDependencyModuleTemplate("api-gateway", "1.0.0", "istio", ""),
+				fixDependencyModuleTemplate("istio", "1.0.0", "", ""),
+				fixDependencyModuleTemplate("serverless", "1.0.0", "", ""),
+			},
+		}
+		app := fixDependencyModuleTemplate("app", "1.0.0", "api-gateway, istio,serverless", "")
+
+		plan, err := resolver.plan(&app, false)
+
+		require.NoError(t, err)
+		require.Equal(t, &InstallPlan{
+			Module:       "app",
+			Dependencies: []string{"istio", "api-gateway", "serverless"},
+		}, plan)
+	})
+
+	t.Run("skips installed dependencies", func(t *testing.T) {
+		resolver := &dependencyResolver{
+			coreModuleTemplates: []kyma.ModuleTemplate{
+				fixDependencyModuleTemplate("api-gateway", "1.0.0", "istio", ""),
+				fixDependencyModuleTemplate("istio", "1.0.0", "", ""),
+			},
+			installed: ModulesList{{Name: "istio"}},
+		}
+		app := fixDependencyModuleTemplate("app", "1.0.0", "api-gateway", "")
+
+		plan, err := resolver.plan(&app, false)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"api-gateway"}, plan.Dependencies)
+	})
+
+	t.Run("reads dependencies from the version assigned to the default channel", func(t *testing.T) {
+		resolver := &dependencyResolver{
+			coreModuleTemplates: []kyma.ModuleTemplate{
+				fixDependencyModuleTemplate("api-gateway", "2.0.0", "istio", ""),
+				fixDependencyModuleTemplate("api-gateway", "1.0.0", "", ""),
+				fixDependencyModuleTemplate("istio", "1.0.0", "", ""),
+			},
+			moduleReleaseMetas: kyma.ModuleReleaseMetaList{
+				Items: []kyma.ModuleReleaseMeta{
+					{
+						Spec: kyma.ModuleReleaseMetaSpec{
+							ModuleName: "api-gateway",
+							Channels: []kyma.ChannelVersionAssignment{
+								{Channel: "regular", Version: "1.0.0"},
+								{Channel: "fast", Version: "2.0.0"},
+							},
+						},
+					},
+				},
+			},
+			defaultChannel: "regular",
+		}
+		app := fixDependencyModuleTemplate("app", "1.0.0", "api-gateway", "")
+
+		plan, err := resolver.plan(&app, false)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"api-gateway"}, plan.Dependencies)
+	})
+
+	t.Run("dependency not available in the catalog", func(t *testing.T) {
+		resolver := &dependencyResolver{}
+		app := fixDependencyModuleTemplate("app", "1.0.0", "istio", "")
+
+		plan, err := resolver.plan(&app, false)
+
+		require.EqualError(t, err, "the app module requires the istio module that is not available in the catalog")
+		require.Nil(t, plan)
+	})
+
+	t.Run("circular dependency", func(t *testing.T) {
+		resolver := &dependencyResolver{
+			coreModuleTemplates: []kyma.ModuleTemplate{
+				fixDependencyModuleTemplate("api-gateway", "1.0.0", "istio", ""),
+				fixDependencyModuleTemplate("istio", "1.0.0", "api-gateway", ""),
+			},
+		}
+		app := fixDependencyModuleTemplate("app", "1.0.0", "api-gateway", "")
+
+		plan, err := resolver.plan(&app, false)
+
+		require.EqualError(t, err, "the istio and api-gateway modules require each other")
+		require.Nil(t, plan)
+	})
+
+	t.Run("conflicts declared by the module and by installed modules", func(t *testing.T) {
+		resolver := &dependencyResolver{
+			coreModuleTemplates: []kyma.ModuleTemplate{
+				fixDependencyModuleTemplate("keda", "1.0.0", "", "app"),
+				fixDependencyModuleTemplate("serverless", "1.0.0", "", ""),
+			},
+			installed: ModulesList{
+				{Name: "keda", InstallDetails: ModuleInstallDetails{Version: "1.0.0"}},
+				{Name: "serverless", InstallDetails: ModuleInstallDetails{Version: "1.0.0"}},
+			},
+		}
+		app := fixDependencyModuleTemplate("app", "1.0.0", "", "serverless,keda")
+
+		plan, err := resolver.plan(&app, false)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"the app module conflicts with the serverless module",
+			"the app module conflicts with the keda module",
+		}, plan.Conflicts)
+	})
+
+	t.Run("community module conflicts with the installed core module with the same name", func(t *testing.T) {
+		resolver := &dependencyResolver{
+			installed: ModulesList{{Name: "keda"}},
+		}
+		communityKeda := fixDependencyModuleTemplate("keda", "1.0.0", "", "")
+
+		plan, err := resolver.plan(&communityKeda, true)
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"the community keda module conflicts with the installed core keda module"}, plan.Conflicts)
+	})
+}
+
+func TestDependencyResolver_dependents(t *testing.T) {
+	communityApp := fixDependencyModuleTemplate("app", "0.1.0", "api-gateway", "")
+	communityApp.Namespace = "default"
+	communityApp.Name = "app-0.1.0"
+
+	resolver := &dependencyResolver{
+		coreModuleTemplates: []kyma.ModuleTemplate{
+			fixDependencyModuleTemplate("api-gateway", "1.0.0", "istio", ""),
+			fixDependencyModuleTemplate("istio", "1.0.0", "", ""),
+			fixDependencyModuleTemplate("serverless", "1.0.0", "", ""),
+		},
+		communityModuleTemplates: []kyma.ModuleTemplate{communityApp},
+		installed: ModulesList{
+			{Name: "api-gateway", InstallDetails: ModuleInstallDetails{Version: "1.0.0"}},
+			{Name: "istio", InstallDetails: ModuleInstallDetails{Version: "1.0.0"}},
+			{Name: "serverless", InstallDetails: ModuleInstallDetails{Version: "1.0.0"}},
+			{Name: "app", CommunityModule: true, Origin: "default/app-0.1.0"},
+		},
+	}
+
+	require.Equal(t, []string{"api-gateway"}, resolver.dependents("istio"))
+	require.Equal(t, []string{"app"}, resolver.dependents("api-gateway"))
+	require.Empty(t, resolver.dependents("serverless"))
+}
+
+func Test_checkDependents(t *testing.T) {
+	t.Run("warn when installed modules can't be listed", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnErr: errors.New("forbidden"),
+			},
+		}
+
+		clierr := checkDependents(out.NewToWriter(buffer), context.Background(), client, &modulesfake.ModuleTemplatesRepo{}, "istio")
+
+		require.Nil(t, clierr)
+		require.Equal(t, "Warning:\n  failed to check if installed modules require the istio module, use the --debug flag to see more details\n\n", buffer.String())
+	})
+}
+
+func TestPlanCoreModuleInstall(t *testing.T) {
+	t.Run("plans install of the module from the catalog", func(t *testing.T) {
+		app := fixDependencyModuleTemplate("app", "1.0.0", "istio", "")
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnModuleTemplateList: kyma.ModuleTemplateList{
+					Items: []kyma.ModuleTemplate{app, fixDependencyModuleTemplate("istio", "1.0.0", "", "")},
+				},
+			},
+		}
+
+		plan, clierr := PlanCoreModuleInstall(context.Background(), client, &modulesfake.ModuleTemplatesRepo{}, "app", "")
+
+		require.Nil(t, clierr)
+		require.Equal(t, &InstallPlan{Module: "app", Dependencies: []string{"istio"}}, plan)
+	})
+
+	t.Run("empty plan for the module missing in the catalog", func(t *testing.T) {
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{},
+		}
+
+		plan, clierr := PlanCoreModuleInstall(context.Background(), client, &modulesfake.ModuleTemplatesRepo{}, "app", "")
+
+		require.Nil(t, clierr)
+		require.Equal(t, &InstallPlan{Module: "app"}, plan)
+	})
+}
+
+func fixDependencyModuleTemplate(module, version, requires, conflicts string) kyma.ModuleTemplate {
+	return kyma.ModuleTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      module + "-" + version,
+			Namespace: "kyma-system",
+			Labels: map[string]string{
+				"operator.kyma-project.io/managed-by": "kyma",
+			},
+			Annotations: map[string]string{
+				RequiresAnnotation:  requires,
+				ConflictsAnnotation: conflicts,
+			},
+		},
+		Spec: kyma.ModuleTemplateSpec{
+			ModuleName: module,
+			Version:    version,
+		},
+	}
+}
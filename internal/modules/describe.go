@@ -0,0 +1,455 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/kyma-project/cli.v3/internal/render"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// maxDescribedEvents is the number of the most recent events shown for the module
+const maxDescribedEvents = 10
+
+type ModuleDescription struct {
+	Name                string                       `json:"name" yaml:"name"`
+	CommunityModule     bool                         `json:"communityModule" yaml:"communityModule"`
+	KymaCR              *KymaCRModuleDescription     `json:"kymaCR,omitempty" yaml:"kymaCR,omitempty"`
+	ModuleTemplate      *ModuleTemplateDescription   `json:"moduleTemplate,omitempty" yaml:"moduleTemplate,omitempty"`
+	Manager             *ManagerDescription          `json:"manager,omitempty" yaml:"manager,omitempty"`
+	ModuleCRs           []ModuleCRDescription        `json:"moduleCRs" yaml:"moduleCRs"`
+	AssociatedResources []AssociatedResourcesSummary `json:"associatedResources" yaml:"associatedResources"`
+	Events              []ModuleEventDescription     `json:"events" yaml:"events"`
+}
+
+// KymaCRModuleDescription contains the module entry from the spec and the status of the Kyma CR
+type KymaCRModuleDescription struct {
+	Channel              string `json:"channel" yaml:"channel"`
+	CustomResourcePolicy string `json:"customResourcePolicy" yaml:"customResourcePolicy"`
+	Managed              bool   `json:"managed" yaml:"managed"`
+	StatusChannel        string `json:"statusChannel" yaml:"statusChannel"`
+	StatusVersion        string `json:"statusVersion" yaml:"statusVersion"`
+	State                string `json:"state" yaml:"state"`
+}
+
+type ModuleTemplateDescription struct {
+	Name          string   `json:"name" yaml:"name"`
+	Namespace     string   `json:"namespace" yaml:"namespace"`
+	Version       string   `json:"version" yaml:"version"`
+	Channels      []string `json:"channels" yaml:"channels"`
+	Repository    string   `json:"repository" yaml:"repository"`
+	Documentation string   `json:"documentation" yaml:"documentation"`
+}
+
+type ManagerDescription struct {
+	Kind           string `json:"kind" yaml:"kind"`
+	Name           string `json:"name" yaml:"name"`
+	Namespace      string `json:"namespace" yaml:"namespace"`
+	Installed      bool   `json:"installed" yaml:"installed"`
+	ReadyReplicas  int64  `json:"readyReplicas" yaml:"readyReplicas"`
+	WantedReplicas int64  `json:"wantedReplicas" yaml:"wantedReplicas"`
+	State          string `json:"state" yaml:"state"`
+}
+
+type ModuleCRDescription struct {
+	Kind       string   `json:"kind" yaml:"kind"`
+	Name       string   `json:"name" yaml:"name"`
+	Namespace  string   `json:"namespace" yaml:"namespace"`
+	State      string   `json:"state" yaml:"state"`
+	Conditions []string `json:"conditions" yaml:"conditions"`
+}
+
+type AssociatedResourcesSummary struct {
+	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string `json:"kind" yaml:"kind"`
+	Count      int    `json:"count" yaml:"count"`
+}
+
+type ModuleEventDescription struct {
+	Type    string `json:"type" yaml:"type"`
+	Reason  string `json:"reason" yaml:"reason"`
+	Object  string `json:"object" yaml:"object"`
+	Message string `json:"message" yaml:"message"`
+	Age     string `json:"age" yaml:"age"`
+}
+
+// DescribeModule collects details about the core module based on the Kyma CR and its module template
+func DescribeModule(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module string) (*ModuleDescription, error) {
+	defaultKyma, err := client.Kyma().GetDefaultKyma(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get default Kyma CR from the target Kyma environment")
+	}
+
+	moduleSpec := getKymaModuleSpec(defaultKyma, module)
+	if moduleSpec == nil {
+		return nil, errors.Errorf("module %s is not added to the Kyma CR", module)
+	}
+
+	moduleStatus := kyma.ModuleStatus{Name: module}
+	for _, status := range defaultKyma.Status.Modules {
+		if status.Name == module {
+			moduleStatus = status
+		}
+	}
+
+	description := &ModuleDescription{
+		Name: module,
+		KymaCR: &KymaCRModuleDescription{
+			Channel:              moduleSpec.Channel,
+			CustomResourcePolicy: getCustomResourcePolicy(moduleSpec),
+			Managed:              getManaged(moduleSpec) == ManagedTrue,
+			StatusChannel:        moduleStatus.Channel,
+			StatusVersion:        moduleStatus.Version,
+			State:                moduleStatus.State,
+		},
+	}
+
+	if moduleStatus.Version == "" {
+		// module is not installed yet, so there is no module template to describe
+		return fillDescription(ctx, client, repo, description, nil), nil
+	}
+
+	moduleTemplate, err := findMatchingModuleTemplate(ctx, client, moduleStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	return fillDescription(ctx, client, repo, description, moduleTemplate), nil
+}
+
+// DescribeCommunityModule collects details about the community module based on its module template
+func DescribeCommunityModule(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleTemplate *kyma.ModuleTemplate) *ModuleDescription {
+	description := &ModuleDescription{
+		Name:            moduleTemplate.Spec.ModuleName,
+		CommunityModule: true,
+	}
+
+	return fillDescription(ctx, client, repo, description, moduleTemplate)
+}
+
+// fillDescription collects details that are common for core and community modules
+// failures are printed as debug messages only, so that the rest of the description can still be shown
+func fillDescription(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, description *ModuleDescription, moduleTemplate *kyma.ModuleTemplate) *ModuleDescription {
+	description.ModuleCRs = []ModuleCRDescription{}
+	description.AssociatedResources = []AssociatedResourcesSummary{}
+	description.Events = []ModuleEventDescription{}
+
+	if moduleTemplate == nil {
+		return description
+	}
+
+	description.ModuleTemplate = describeModuleTemplate(ctx, client, moduleTemplate)
+
+	involvedObjects := []corev1.ObjectReference{}
+	manager, err := describeManager(ctx, client, moduleTemplate.Spec.Manager)
+	if err != nil {
+		out.Debugfln("failed to get the %s module manager: %v", description.Name, err)
+	}
+	if manager != nil {
+		description.Manager = manager
+		involvedObjects = append(involvedObjects, corev1.ObjectReference{Kind: manager.Kind, Name: manager.Name, Namespace: manager.Namespace})
+	}
+
+	moduleCRs, err := listModuleCRs(ctx, client, moduleTemplate.Spec.Data)
+	if err != nil {
+		out.Debugfln("failed to list the %s module CRs: %v", description.Name, err)
+	}
+	for _, moduleCR := range moduleCRs {
+		state, _, _ := unstructured.NestedString(moduleCR.Object, "status", "state")
+		description.ModuleCRs = append(description.ModuleCRs, ModuleCRDescription{
+			Kind:       moduleCR.GetKind(),
+			Name:       moduleCR.GetName(),
+			Namespace:  moduleCR.GetNamespace(),
+			State:      state,
+			Conditions: getConditionsDescription(moduleCR),
+		})
+		involvedObjects = append(involvedObjects, corev1.ObjectReference{Kind: moduleCR.GetKind(), Name: moduleCR.GetName(), Namespace: moduleCR.GetNamespace()})
+	}
+
+	associatedResources, err := repo.RunningAssociatedResourcesOfModule(ctx, *moduleTemplate)
+	if err != nil {
+		out.Debugfln("failed to get the %s module associated resources: %v", description.Name, err)
+	}
+	description.AssociatedResources = summarizeAssociatedResources(associatedResources)
+
+	events, err := getModuleEvents(ctx, client, involvedObjects)
+	if err != nil {
+		out.Debugfln("failed to get the %s module events: %v", description.Name, err)
+	}
+	description.Events = events
+
+	return description
+}
+
+func describeModuleTemplate(ctx context.Context, client kube.Client, moduleTemplate *kyma.ModuleTemplate) *ModuleTemplateDescription {
+	channels := []string{}
+	if !isCommunityModule(moduleTemplate) {
+		releaseMetas, err := client.Kyma().ListModuleReleaseMeta(ctx)
+		if err != nil {
+			out.Debugfln("failed to list module release metas: %v", err)
+		} else {
+			channels = getAssignedChannels(*releaseMetas, moduleTemplate.Spec.ModuleName, moduleTemplate.Spec.Version)
+		}
+	}
+
+	return &ModuleTemplateDescription{
+		Name:          moduleTemplate.GetName(),
+		Namespace:     moduleTemplate.GetNamespace(),
+		Version:       moduleTemplate.Spec.Version,
+		Channels:      channels,
+		Repository:    moduleTemplate.Spec.Info.Repository,
+		Documentation: moduleTemplate.Spec.Info.Documentation,
+	}
+}
+
+func describeManager(ctx context.Context, client kube.Client, manager *kyma.Manager) (*ManagerDescription, error) {
+	if manager == nil {
+		return nil, nil
+	}
+
+	description := &ManagerDescription{
+		Kind:      manager.Kind,
+		Name:      manager.Name,
+		Namespace: manager.Namespace,
+		State:     NotRunningValue,
+	}
+	if description.Namespace == "" {
+		description.Namespace = "kyma-system"
+	}
+
+	installedManager, err := getManager(ctx, client, manager)
+	if err != nil {
+		description.State = UnknownValue
+		return description, err
+	}
+	if installedManager == nil {
+		return description, nil
+	}
+
+	description.Installed = true
+	description.ReadyReplicas, _, _ = unstructured.NestedInt64(installedManager.Object, "status", "readyReplicas")
+	description.WantedReplicas, _, _ = unstructured.NestedInt64(installedManager.Object, "spec", "replicas")
+	description.State = getManagerStatus(installedManager)
+
+	return description, nil
+}
+
+// summarizeAssociatedResources counts resources grouped by their apiVersion and kind
+func summarizeAssociatedResources(resources []unstructured.Unstructured) []AssociatedResourcesSummary {
+	summaries := []AssociatedResourcesSummary{}
+	for _, resource := range resources {
+		found := false
+		for i := range summaries {
+			if summaries[i].APIVersion == resource.GetAPIVersion() && summaries[i].Kind == resource.GetKind() {
+				summaries[i].Count++
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			summaries = append(summaries, AssociatedResourcesSummary{
+				APIVersion: resource.GetAPIVersion(),
+				Kind:       resource.GetKind(),
+				Count:      1,
+			})
+		}
+	}
+
+	return summaries
+}
+
+// getModuleEvents returns the most recent events related to given objects, sorted from the oldest
+func getModuleEvents(ctx context.Context, client kube.Client, involvedObjects []corev1.ObjectReference) ([]ModuleEventDescription, error) {
+	descriptions := []ModuleEventDescription{}
+	if len(involvedObjects) == 0 {
+		return descriptions, nil
+	}
+
+	eventList, err := client.Static().CoreV1().Events("").List(ctx, metav1.ListOptions{})
+	if apierrors.IsNotFound(err) {
+		return descriptions, nil
+	}
+	if err != nil {
+		return descriptions, err
+	}
+
+	events := []corev1.Event{}
+	for _, event := range eventList.Items {
+		if isEventInvolved(event, involvedObjects) {
+			events = append(events, event)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return getEventTime(events[i]).Before(getEventTime(events[j]))
+	})
+
+	if len(events) > maxDescribedEvents {
+		events = events[len(events)-maxDescribedEvents:]
+	}
+
+	for _, event := range events {
+		descriptions = append(descriptions, ModuleEventDescription{
+			Type:    event.Type,
+			Reason:  event.Reason,
+			Object:  fmt.Sprintf("%s/%s", strings.ToLower(event.InvolvedObject.Kind), event.InvolvedObject.Name),
+			Message: event.Message,
+			Age:     getEventAge(event),
+		})
+	}
+
+	return descriptions, nil
+}
+
+func isEventInvolved(event corev1.Event, involvedObjects []corev1.ObjectReference) bool {
+	for _, object := range involvedObjects {
+		if event.InvolvedObject.Kind == object.Kind &&
+			event.InvolvedObject.Name == object.Name &&
+			event.InvolvedObject.Namespace == object.Namespace {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getEventTime(event corev1.Event) time.Time {
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	return event.FirstTimestamp.Time
+}
+
+func getEventAge(event corev1.Event) string {
+	eventTime := getEventTime(event)
+	if eventTime.IsZero() {
+		return UnknownValue
+	}
+
+	return duration.HumanDuration(time.Since(eventTime))
+}
+
+// RenderModuleDescription uses standard output to print the module description
+func RenderModuleDescription(description *ModuleDescription, format types.Format) error {
+	return renderModuleDescription(out.Default, description, format)
+}
+
+func renderModuleDescription(printer *out.Printer, description *ModuleDescription, format types.Format) error {
+	switch format {
+	case types.JSONFormat:
+		obj, err := json.MarshalIndent(description, "", "  ")
+		if err != nil {
+			return err
+		}
+		printer.Msgln(string(obj))
+	case types.YAMLFormat:
+		obj, err := yaml.Marshal(description)
+		if err != nil {
+			return err
+		}
+		printer.Msgln(string(obj))
+	default:
+		renderModuleDescriptionText(printer, description)
+	}
+
+	return nil
+}
+
+func renderModuleDescriptionText(printer *out.Printer, description *ModuleDescription) {
+	printer.Msgfln("Name: %s", description.Name)
+	if description.CommunityModule {
+		printer.Msgln("Type: community")
+	} else {
+		printer.Msgln("Type: core")
+	}
+
+	if description.KymaCR != nil {
+		printer.Msgln("\nKyma CR:")
+		printer.Msgfln("  Channel: %s", valueOrNone(description.KymaCR.Channel))
+		printer.Msgfln("  Custom Resource Policy: %s", description.KymaCR.CustomResourcePolicy)
+		printer.Msgfln("  Managed: %t", description.KymaCR.Managed)
+		printer.Msgfln("  Status Channel: %s", valueOrNone(description.KymaCR.StatusChannel))
+		printer.Msgfln("  Status Version: %s", valueOrNone(description.KymaCR.StatusVersion))
+		printer.Msgfln("  State: %s", valueOrNone(description.KymaCR.State))
+	}
+
+	if description.ModuleTemplate != nil {
+		printer.Msgln("\nModule Template:")
+		printer.Msgfln("  Name: %s/%s", description.ModuleTemplate.Namespace, description.ModuleTemplate.Name)
+		printer.Msgfln("  Version: %s", description.ModuleTemplate.Version)
+		if !description.CommunityModule {
+			printer.Msgfln("  Channels: %s", valueOrNone(strings.Join(description.ModuleTemplate.Channels, ", ")))
+		}
+		printer.Msgfln("  Repository: %s", valueOrNone(description.ModuleTemplate.Repository))
+		printer.Msgfln("  Documentation: %s", valueOrNone(description.ModuleTemplate.Documentation))
+	}
+
+	if description.Manager != nil {
+		printer.Msgln("\nManager:")
+		printer.Msgfln("  Resource: %s %s/%s", description.Manager.Kind, description.Manager.Namespace, description.Manager.Name)
+		if description.Manager.Installed {
+			printer.Msgfln("  Replicas: %d/%d", description.Manager.ReadyReplicas, description.Manager.WantedReplicas)
+		}
+		printer.Msgfln("  State: %s", description.Manager.State)
+	}
+
+	printer.Msgln("\nModule CRs:")
+	if len(description.ModuleCRs) == 0 {
+		printer.Msgln("  <none>")
+	}
+	for _, moduleCR := range description.ModuleCRs {
+		printer.Msgfln("  %s %s/%s: %s", moduleCR.Kind, moduleCR.Namespace, moduleCR.Name, valueOrNone(moduleCR.State))
+		for _, condition := range moduleCR.Conditions {
+			printer.Msgfln("    %s", condition)
+		}
+	}
+
+	printer.Msgln("\nAssociated Resources:")
+	if len(description.AssociatedResources) == 0 {
+		printer.Msgln("  <none>")
+	} else {
+		rows := [][]interface{}{}
+		for _, resource := range description.AssociatedResources {
+			rows = append(rows, []interface{}{resource.APIVersion, resource.Kind, resource.Count})
+		}
+		render.Table(printer, []interface{}{"API VERSION", "KIND", "COUNT"}, rows)
+	}
+
+	printer.Msgln("\nEvents:")
+	if len(description.Events) == 0 {
+		printer.Msgln("  <none>")
+	} else {
+		rows := [][]interface{}{}
+		for _, event := range description.Events {
+			rows = append(rows, []interface{}{event.Type, event.Reason, event.Age, event.Object, event.Message})
+		}
+		render.Table(printer, []interface{}{"TYPE", "REASON", "AGE", "OBJECT", "MESSAGE"}, rows)
+	}
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+
+	return value
+}
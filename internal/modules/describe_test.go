@@ -0,0 +1,210 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	modulesfake "github.com/kyma-project/cli.v3/internal/modules/fake"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDescribeModule(t *testing.T) {
+	moduleTemplate := testWaitModuleTemplate
+	moduleTemplate.Labels = map[string]string{"operator.kyma-project.io/managed-by": "kyma"}
+	moduleTemplate.Spec.Info = kyma.ModuleInfo{
+		Repository:    "https://github.com/kyma-project/keda-manager",
+		Documentation: "https://kyma-project.io/#/keda-manager/user/README",
+	}
+
+	moduleCR := testKedaCR.DeepCopy()
+	moduleCR.Object["status"] = map[string]any{
+		"state": "Ready",
+		"conditions": []any{
+			map[string]any{"type": "Installed", "status": "True", "reason": "Verified"},
+		},
+	}
+
+	kymaClient := &fake.KymaClient{
+		ReturnDefaultKyma: kyma.Kyma{
+			Spec: kyma.KymaSpec{
+				Modules: []kyma.Module{{Name: "keda", Channel: "fast"}},
+			},
+			Status: kyma.KymaStatus{
+				Modules: []kyma.ModuleStatus{{Name: "keda", Channel: "fast", Version: "1.0.0", State: "Ready"}},
+			},
+		},
+		ReturnModuleTemplateList: kyma.ModuleTemplateList{
+			Items: []kyma.ModuleTemplate{moduleTemplate},
+		},
+		ReturnModuleReleaseMetaList: kyma.ModuleReleaseMetaList{
+			Items: []kyma.ModuleReleaseMeta{testKedaModuleReleaseMeta},
+		},
+	}
+	staticClient := k8sfake.NewSimpleClientset(
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "keda-manager.1", Namespace: "kyma-system"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Deployment", Name: "keda-manager", Namespace: "kyma-system"},
+			Type:           "Normal",
+			Reason:         "ScalingReplicaSet",
+			Message:        "Scaled up replica set keda-manager to 1",
+			LastTimestamp:  metav1.NewTime(time.Now().Add(-time.Minute)),
+		},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "other.1", Namespace: "kyma-system"},
+			InvolvedObject: corev1.ObjectReference{Kind: "Deployment", Name: "other", Namespace: "kyma-system"},
+			Type:           "Normal",
+			Reason:         "ScalingReplicaSet",
+		},
+	)
+
+	t.Run("describe core module", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestKymaInterface:       kymaClient,
+			TestKubernetesInterface: staticClient,
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnGetObj: testReadyManager,
+				ReturnListObjs: &unstructured.UnstructuredList{
+					Items: []unstructured.Unstructured{*moduleCR},
+				},
+			},
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnRunningAssociatedResourcesOfModule: []unstructured.Unstructured{
+				{Object: map[string]any{"apiVersion": "keda.sh/v1alpha1", "kind": "ScaledObject"}},
+				{Object: map[string]any{"apiVersion": "keda.sh/v1alpha1", "kind": "ScaledObject"}},
+				{Object: map[string]any{"apiVersion": "keda.sh/v1alpha1", "kind": "ScaledJob"}},
+			},
+		}
+
+		description, err := DescribeModule(context.Background(), &client, repo, "keda")
+		require.NoError(t, err)
+		require.Equal(t, &KymaCRModuleDescription{
+			Channel:              "fast",
+			CustomResourcePolicy: "CreateAndDelete",
+			Managed:              true,
+			StatusChannel:        "fast",
+			StatusVersion:        "1.0.0",
+			State:                "Ready",
+		}, description.KymaCR)
+		require.Equal(t, &ModuleTemplateDescription{
+			Name:          "keda-1.0.0",
+			Namespace:     "kyma-system",
+			Version:       "1.0.0",
+			Channels:      []string{"fast"},
+			Repository:    "https://github.com/kyma-project/keda-manager",
+			Documentation: "https://kyma-project.io/#/keda-manager/user/README",
+		}, description.ModuleTemplate)
+		require.Equal(t, &ManagerDescription{
+			Kind:           "Deployment",
+			Name:           "keda-manager",
+			Namespace:      "kyma-system",
+			Installed:      true,
+			ReadyReplicas:  1,
+			WantedReplicas: 1,
+			State:          "Ready",
+		}, description.Manager)
+		require.Equal(t, []ModuleCRDescription{{
+			Kind:       "Keda",
+			Name:       "default",
+			Namespace:  "kyma-system",
+			State:      "Ready",
+			Conditions: []string{"kyma-system/default Installed=True: Verified"},
+		}}, description.ModuleCRs)
+		require.Equal(t, []AssociatedResourcesSummary{
+			{APIVersion: "keda.sh/v1alpha1", Kind: "ScaledObject", Count: 2},
+			{APIVersion: "keda.sh/v1alpha1", Kind: "ScaledJob", Count: 1},
+		}, description.AssociatedResources)
+		require.Len(t, description.Events, 1)
+		require.Equal(t, "deployment/keda-manager", description.Events[0].Object)
+		require.Equal(t, "Scaled up replica set keda-manager to 1", description.Events[0].Message)
+	})
+
+	t.Run("module not added to the Kyma CR", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{},
+		}
+
+		description, err := DescribeModule(context.Background(), &client, &modulesfake.ModuleTemplatesRepo{}, "keda")
+		require.ErrorContains(t, err, "module keda is not added to the Kyma CR")
+		require.Nil(t, description)
+	})
+
+	t.Run("describe community module without manager", func(t *testing.T) {
+		communityModuleTemplate := testWaitModuleTemplate
+		communityModuleTemplate.Spec.Manager = nil
+		client := fake.KubeClient{
+			TestKubernetesInterface: staticClient,
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnListObjs: &unstructured.UnstructuredList{},
+			},
+		}
+
+		description := DescribeCommunityModule(context.Background(), &client, &modulesfake.ModuleTemplatesRepo{}, &communityModuleTemplate)
+		require.True(t, description.CommunityModule)
+		require.Nil(t, description.KymaCR)
+		require.Nil(t, description.Manager)
+		require.Equal(t, "1.0.0", description.ModuleTemplate.Version)
+		require.Empty(t, description.ModuleTemplate.Channels)
+		require.Empty(t, description.ModuleCRs)
+		require.Empty(t, description.Events)
+	})
+}
+
+func TestRenderModuleDescription(t *testing.T) {
+	description := &ModuleDescription{
+		Name: "keda",
+		KymaCR: &KymaCRModuleDescription{
+			Channel:              "fast",
+			CustomResourcePolicy: "CreateAndDelete",
+			Managed:              true,
+			StatusVersion:        "1.0.0",
+			State:                "Ready",
+		},
+		ModuleCRs:           []ModuleCRDescription{},
+		AssociatedResources: []AssociatedResourcesSummary{{APIVersion: "keda.sh/v1alpha1", Kind: "ScaledObject", Count: 2}},
+		Events:              []ModuleEventDescription{},
+	}
+
+	t.Run("render text", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+
+		err := renderModuleDescription(out.NewToWriter(buffer), description, types.DefaultFormat)
+		require.NoError(t, err)
+		require.Contains(t, buffer.String(), "Name: keda\nType: core\n")
+		require.Contains(t, buffer.String(), "  Channel: fast\n")
+		require.Contains(t, buffer.String(), "  Status Channel: <none>\n")
+		require.Contains(t, buffer.String(), "Module CRs:\n  <none>\n")
+		require.Contains(t, buffer.String(), "ScaledObject")
+		require.Contains(t, buffer.String(), "Events:\n  <none>\n")
+	})
+
+	t.Run("render json", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+
+		err := renderModuleDescription(out.NewToWriter(buffer), description, types.JSONFormat)
+		require.NoError(t, err)
+		require.Contains(t, buffer.String(), `"name": "keda"`)
+		require.Contains(t, buffer.String(), `"customResourcePolicy": "CreateAndDelete"`)
+		require.Contains(t, buffer.String(), `"count": 2`)
+	})
+
+	t.Run("render yaml", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+
+		err := renderModuleDescription(out.NewToWriter(buffer), description, types.YAMLFormat)
+		require.NoError(t, err)
+		require.Contains(t, buffer.String(), "name: keda\n")
+		require.Contains(t, buffer.String(), "statusVersion: 1.0.0\n")
+		require.Contains(t, buffer.String(), "events: []\n")
+	})
+}
@@ -16,13 +16,14 @@ import (
 
 // Disable takes care about disabling module whatever if CustomResourcePolicy is set to Ignore or CreateAndDelete
 // if CustomResourcePolicy is Ignore then it first deletes module CR and waits for removal
+// module CRs removal is bounded by the timeout
 // at the end removes module from the target Kyma environment
-func Disable(ctx context.Context, client kube.Client, module string) clierror.Error {
-	return disable(out.Default, ctx, client, module)
+func Disable(ctx context.Context, client kube.Client, module string, timeout time.Duration) clierror.Error {
+	return disable(out.Default, ctx, client, module, timeout)
 }
 
-func disable(printer *out.Printer, ctx context.Context, client kube.Client, module string) clierror.Error {
-	clierr := removeModuleCR(printer, ctx, client, module)
+func disable(printer *out.Printer, ctx context.Context, client kube.Client, module string, timeout time.Duration) clierror.Error {
+	clierr := removeModuleCR(printer, ctx, client, module, timeout)
 	if clierr != nil {
 		return clierr
 	}
@@ -37,7 +38,7 @@ func disable(printer *out.Printer, ctx context.Context, client kube.Client, modu
 	return nil
 }
 
-func removeModuleCR(printer *out.Printer, ctx context.Context, client kube.Client, module string) clierror.Error {
+func removeModuleCR(printer *out.Printer, ctx context.Context, client kube.Client, module string, timeout time.Duration) clierror.Error {
 	info, err := client.Kyma().GetModuleInfo(ctx, module)
 	if err != nil {
 		return clierror.Wrap(err, clierror.New("failed to get the module info from the target Kyma environment"))
@@ -87,11 +88,11 @@ func removeModuleCR(printer *out.Printer, ctx context.Context, client kube.Clien
 		}
 	}
 
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	for i, moduleCR := range list.Items {
 		printer.Msgfln("waiting for %s/%s CR to be removed", moduleCR.GetNamespace(), moduleCR.GetName())
-		timeoutCtx, cancel := context.WithTimeout(ctx, time.Second*100)
-		defer cancel()
-
 		clierr := waitForDeletion(timeoutCtx, watchers[i])
 		if clierr != nil {
 			return clierr
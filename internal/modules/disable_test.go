@@ -48,7 +48,7 @@ func TestDisableCore(t *testing.T) {
 			TestKymaInterface: &fakeKymaClient,
 		}
 
-		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda")
+		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda", DefaultWaitTimeout)
 		require.Nil(t, err)
 		require.Equal(t, []string{"keda"}, fakeKymaClient.DisabledModules)
 		require.Equal(t, "removing the keda module from the target Kyma environment\nkeda module disabled\n", buffer.String())
@@ -75,7 +75,7 @@ func TestDisableCore(t *testing.T) {
 			TestKymaInterface: &fakeKymaClient,
 		}
 
-		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda")
+		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda", DefaultWaitTimeout)
 		require.Nil(t, err)
 		require.Equal(t, []string{"keda"}, fakeKymaClient.DisabledModules)
 		require.Equal(t, "removing the keda module from the target Kyma environment\nkeda module disabled\n", buffer.String())
@@ -112,7 +112,7 @@ func TestDisableCore(t *testing.T) {
 
 		fakeWatcher.Delete(nil)
 
-		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda")
+		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda", DefaultWaitTimeout)
 		require.Nil(t, err)
 		require.Equal(t, []string{"keda"}, fakeKymaClient.DisabledModules)
 		require.Equal(t, "removing kyma-system/default CR\nwaiting for kyma-system/default CR to be removed\nremoving the keda module from the target Kyma environment\nkeda module disabled\n", buffer.String())
@@ -137,7 +137,7 @@ func TestDisableCore(t *testing.T) {
 			clierror.New("failed to disable the module"),
 		)
 
-		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda")
+		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda", DefaultWaitTimeout)
 		require.Equal(t, expectedCliErr, err)
 		require.Equal(t, "removing the keda module from the target Kyma environment\n", buffer.String())
 	})
@@ -156,7 +156,7 @@ func TestDisableCore(t *testing.T) {
 			clierror.New("failed to get the module info from the target Kyma environment"),
 		)
 
-		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda")
+		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda", DefaultWaitTimeout)
 		require.Equal(t, expectedCliErr, err)
 		require.Empty(t, fakeKymaClient.DisabledModules)
 		require.Empty(t, buffer.String())
@@ -181,7 +181,7 @@ func TestDisableCore(t *testing.T) {
 			clierror.New("failed to get ModuleTemplate CR for module"),
 		)
 
-		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda")
+		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda", DefaultWaitTimeout)
 		require.Equal(t, expectedCliErr, err)
 		require.Empty(t, fakeKymaClient.DisabledModules)
 		require.Empty(t, buffer.String())
@@ -221,7 +221,7 @@ func TestDisableCore(t *testing.T) {
 			clierror.New("failed to remove kyma-system/default CR"),
 		)
 
-		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda")
+		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda", DefaultWaitTimeout)
 		require.Equal(t, expectedCliErr, err)
 		require.Empty(t, fakeKymaClient.DisabledModules)
 		require.Equal(t, "removing kyma-system/default CR\n", buffer.String())
@@ -261,7 +261,7 @@ func TestDisableCore(t *testing.T) {
 			clierror.New("failed to watch resource kyma-system/default"),
 		)
 
-		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda")
+		err := disable(out.NewToWriter(buffer), context.Background(), &fakeKubeClient, "keda", DefaultWaitTimeout)
 		require.Equal(t, expectedCliErr, err)
 		require.Empty(t, fakeKymaClient.DisabledModules)
 	})
@@ -307,7 +307,7 @@ func TestDisableCore(t *testing.T) {
 			cancel()
 		}()
 
-		err := disable(out.NewToWriter(buffer), ctx, &fakeKubeClient, "keda")
+		err := disable(out.NewToWriter(buffer), ctx, &fakeKubeClient, "keda", DefaultWaitTimeout)
 		require.Equal(t, expectedCliErr, err)
 		require.Equal(t, "removing kyma-system/default CR\nwaiting for kyma-system/default CR to be removed\n", buffer.String())
 	})
@@ -0,0 +1,296 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DryRunOptions defines how changes are previewed
+type DryRunOptions struct {
+	// Server sends all objects to the API server with the dry run option to validate them
+	Server bool
+	// Format of printed objects (yaml by default)
+	Format types.Format
+}
+
+// kymaCRChange modifies the given Kyma CR
+type kymaCRChange func(*kyma.Kyma) (*kyma.Kyma, error)
+
+// DryRunEnable prints the Kyma CR patch and custom CRs applied by the Enable function without applying them
+func DryRunEnable(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, channel string, defaultCR bool, opts DryRunOptions, crs ...unstructured.Unstructured) clierror.Error {
+	return dryRunEnable(out.Default, ctx, client, repo, module, channel, defaultCR, opts, crs...)
+}
+
+func dryRunEnable(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, channel string, defaultCR bool, opts DryRunOptions, crs ...unstructured.Unstructured) clierror.Error {
+	if err := validateModuleAvailability(ctx, client, repo, module, channel); err != nil {
+		hints := []string{
+			"ensure you provide a valid module name and channel (or version)",
+			"to list available modules, call the `kyma module catalog` command",
+		}
+		return clierror.Wrap(err, clierror.New("unknown module name or channel", hints...))
+	}
+
+	crPolicy := kyma.CustomResourcePolicyIgnore
+	if defaultCR {
+		crPolicy = kyma.CustomResourcePolicyCreateAndDelete
+	}
+
+	kymaPatch, clierr := getKymaCRPatch(ctx, client, func(kymaCR *kyma.Kyma) (*kyma.Kyma, error) {
+		return kyma.EnableModuleInKymaCR(kymaCR, module, channel, crPolicy), nil
+	})
+	if clierr != nil {
+		return clierr
+	}
+
+	return dryRunApply(printer, ctx, client, opts, append([]unstructured.Unstructured{*kymaPatch}, crs...))
+}
+
+// DryRunDisable prints the Kyma CR patch applied by the Disable function without applying it
+func DryRunDisable(ctx context.Context, client kube.Client, module string, opts DryRunOptions) clierror.Error {
+	return dryRunKymaCRChange(out.Default, ctx, client, opts, func(kymaCR *kyma.Kyma) (*kyma.Kyma, error) {
+		return kyma.DisableModuleInKymaCR(kymaCR, module), nil
+	})
+}
+
+// DryRunUnmanage prints the Kyma CR patch that sets the module as unmanaged without applying it
+func DryRunUnmanage(ctx context.Context, client kube.Client, module string, opts DryRunOptions) clierror.Error {
+	return dryRunKymaCRChange(out.Default, ctx, client, opts, func(kymaCR *kyma.Kyma) (*kyma.Kyma, error) {
+		return kyma.UnmanageModuleInKymaCR(kymaCR, module)
+	})
+}
+
+// DryRunManage prints the Kyma CR patch that sets the module as managed without applying it
+// if the module is missing in the Kyma CR, the patch adds it in the default Kyma CR channel
+func DryRunManage(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, policy string, opts DryRunOptions) clierror.Error {
+	return dryRunManage(out.Default, ctx, client, repo, module, policy, opts)
+}
+
+func dryRunManage(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, policy string, opts DryRunOptions) clierror.Error {
+	exists, err := ModuleExistsInKymaCR(ctx, client, module)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to check if module exists in the target Kyma environment"))
+	}
+
+	if exists {
+		return dryRunKymaCRChange(printer, ctx, client, opts, func(kymaCR *kyma.Kyma) (*kyma.Kyma, error) {
+			return kyma.ManageModuleInKymaCR(kymaCR, module, policy)
+		})
+	}
+
+	channel, err := getManagedModuleChannel(ctx, client, repo, module)
+	if errors.Is(err, ErrModuleInstalledVersionNotInKymaChannel) {
+		return clierror.Wrap(err, clierror.New(
+			"failed to preview the module changes",
+			"to select one of the available channels, run the command without the --dry-run flag",
+		))
+	}
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to set the module as managed"))
+	}
+
+	return dryRunKymaCRChange(printer, ctx, client, opts, func(kymaCR *kyma.Kyma) (*kyma.Kyma, error) {
+		return kyma.EnableModuleInKymaCR(kymaCR, module, channel, policy), nil
+	})
+}
+
+func dryRunKymaCRChange(printer *out.Printer, ctx context.Context, client kube.Client, opts DryRunOptions, change kymaCRChange) clierror.Error {
+	kymaPatch, clierr := getKymaCRPatch(ctx, client, change)
+	if clierr != nil {
+		return clierr
+	}
+
+	return dryRunApply(printer, ctx, client, opts, []unstructured.Unstructured{*kymaPatch})
+}
+
+// getKymaCRPatch returns the default Kyma CR with modules list after the change
+func getKymaCRPatch(ctx context.Context, client kube.Client, change kymaCRChange) (*unstructured.Unstructured, clierror.Error) {
+	kymaCR, err := client.Kyma().GetDefaultKyma(ctx)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to get the Kyma CR from the target Kyma environment"))
+	}
+
+	kymaCR, err = change(kymaCR)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to prepare the Kyma CR changes"))
+	}
+
+	kymaObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(kymaCR)
+	if err != nil {
+		return nil, clierror.Wrap(err, clierror.New("failed to convert the Kyma CR"))
+	}
+
+	modules, _, _ := unstructured.NestedSlice(kymaObj, "spec", "modules")
+	if modules == nil {
+		// Kyma CR without modules
+		modules = []any{}
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": kyma.GVRKyma.GroupVersion().String(),
+		"kind":       "Kyma",
+		"metadata": map[string]any{
+			"name":      kyma.DefaultKymaName,
+			"namespace": kyma.DefaultKymaNamespace,
+		},
+		"spec": map[string]any{
+			"modules": modules,
+		},
+	}}, nil
+}
+
+// DryRunInstall prints resources of the community module and its config CR applied by the Install function without applying them
+func DryRunInstall(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, data InstallCommunityModuleData, opts DryRunOptions) clierror.Error {
+	return dryRunInstall(out.Default, ctx, client, repo, data, opts)
+}
+
+func dryRunInstall(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, data InstallCommunityModuleData, opts DryRunOptions) clierror.Error {
+	if data.CommunityModuleTemplate == nil {
+		return clierror.New("cannot install non-existing module")
+	}
+
+	moduleResources, err := repo.Resources(ctx, *data.CommunityModuleTemplate)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to get resources of the %s module", data.CommunityModuleTemplate.Spec.ModuleName)))
+	}
+
+	objs := []unstructured.Unstructured{}
+	for _, resource := range moduleResources {
+		objs = append(objs, unstructured.Unstructured{Object: resource})
+	}
+
+	if data.IsDefaultCRApplicable && len(data.CommunityModuleTemplate.Spec.Data.Object) != 0 {
+		objs = append(objs, data.CommunityModuleTemplate.Spec.Data)
+	}
+
+	return dryRunApply(printer, ctx, client, opts, append(objs, data.CustomResources...))
+}
+
+// DryRunUninstall prints resources removed by the Uninstall function without removing them
+func DryRunUninstall(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleTemplate *kyma.ModuleTemplate, opts DryRunOptions) clierror.Error {
+	return dryRunUninstall(out.Default, ctx, client, repo, moduleTemplate, opts)
+}
+
+func dryRunUninstall(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleTemplate *kyma.ModuleTemplate, opts DryRunOptions) clierror.Error {
+	moduleName := moduleTemplate.Spec.ModuleName
+
+	associatedResources, err := repo.RunningAssociatedResourcesOfModule(ctx, *moduleTemplate)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to get resources for the module %v", moduleName)))
+	}
+
+	moduleResources, err := repo.Resources(ctx, *moduleTemplate)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to get resources for the module %v", moduleName)))
+	}
+
+	// resources are removed in the reversed order
+	slices.Reverse(moduleResources)
+	slices.Reverse(associatedResources)
+
+	objs := slices.Clone(associatedResources)
+	for _, resource := range moduleResources {
+		objs = append(objs, unstructured.Unstructured{Object: resource})
+	}
+
+	if opts.Server {
+		failures := []string{}
+		for _, obj := range objs {
+			err := client.RootlessDynamic().Remove(ctx, &obj, true)
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("%s (%s): %v", obj.GetName(), obj.GetKind(), err))
+			}
+		}
+		if len(failures) > 0 {
+			return clierror.New("server dry run failed for some resources", failures...)
+		}
+	}
+
+	return printDryRunObjects(printer, objs, opts.Format)
+}
+
+// dryRunApply validates objects on the server (only if requested) and prints them
+func dryRunApply(printer *out.Printer, ctx context.Context, client kube.Client, opts DryRunOptions, objs []unstructured.Unstructured) clierror.Error {
+	if opts.Server {
+		failures := []string{}
+		for _, obj := range objs {
+			err := client.RootlessDynamic().Apply(ctx, &obj, true)
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("%s (%s): %v", obj.GetName(), obj.GetKind(), err))
+			}
+		}
+		if len(failures) > 0 {
+			failures = append(failures, "resources based on CRDs installed by the module can't be validated before the module is installed, use --dry-run=client to skip the validation")
+			return clierror.New("server dry run failed for some resources", failures...)
+		}
+	}
+
+	return printDryRunObjects(printer, objs, opts.Format)
+}
+
+// printDryRunObjects prints objects as a multi-document YAML or as a JSON list
+func printDryRunObjects(printer *out.Printer, objs []unstructured.Unstructured, format types.Format) clierror.Error {
+	if format == types.JSONFormat {
+		var toPrint any = newDryRunList(objs)
+		if len(objs) == 1 {
+			toPrint = objs[0].Object
+		}
+
+		bytes, err := json.MarshalIndent(toPrint, "", "  ")
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to render the dry run output"))
+		}
+
+		printer.Msgln(string(bytes))
+		return nil
+	}
+
+	if len(objs) == 0 {
+		// nothing is changed, print the empty list to keep the output parsable
+		bytes, err := yaml.Marshal(newDryRunList(objs))
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to render the dry run output"))
+		}
+
+		printer.Msg(string(bytes))
+		return nil
+	}
+
+	documents := []string{}
+	for _, obj := range objs {
+		bytes, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return clierror.Wrap(err, clierror.New("failed to render the dry run output"))
+		}
+		documents = append(documents, string(bytes))
+	}
+
+	printer.Msg(strings.Join(documents, "---\n"))
+	return nil
+}
+
+func newDryRunList(objs []unstructured.Unstructured) map[string]any {
+	items := []any{}
+	for _, obj := range objs {
+		items = append(items, obj.Object)
+	}
+
+	return map[string]any{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      items,
+	}
+}
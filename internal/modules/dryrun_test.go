@@ -0,0 +1,312 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	modulesfake "github.com/kyma-project/cli.v3/internal/modules/fake"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDryRunEnable(t *testing.T) {
+	t.Run("print Kyma CR patch and custom CR", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		kymaClient := &fake.KymaClient{
+			ReturnModuleTemplateList: kyma.ModuleTemplateList{
+				Items: []kyma.ModuleTemplate{testKedaModuleTemplate},
+			},
+			ReturnModuleReleaseMetaList: kyma.ModuleReleaseMetaList{
+				Items: []kyma.ModuleReleaseMeta{testKedaModuleReleaseMeta},
+			},
+		}
+		rootlessDynamicClient := &fake.RootlessDynamicClient{}
+		client := &fake.KubeClient{
+			TestKymaInterface:            kymaClient,
+			TestRootlessDynamicInterface: rootlessDynamicClient,
+		}
+
+		err := dryRunEnable(out.NewToWriter(buffer), context.Background(), client, &modulesfake.ModuleTemplatesRepo{}, "keda", "fast", true, DryRunOptions{}, testKedaCR)
+		require.Nil(t, err)
+		require.Equal(t, "apiVersion: operator.kyma-project.io/v1beta2\n"+
+			"kind: Kyma\n"+
+			"metadata:\n"+
+			"    name: default\n"+
+			"    namespace: kyma-system\n"+
+			"spec:\n"+
+			"    modules:\n"+
+			"        - channel: fast\n"+
+			"          customResourcePolicy: CreateAndDelete\n"+
+			"          name: keda\n"+
+			"---\n"+
+			"apiVersion: test/v1\n"+
+			"kind: Keda\n"+
+			"metadata:\n"+
+			"    name: default\n"+
+			"    namespace: kyma-system\n", buffer.String())
+		require.Empty(t, kymaClient.UpdateDefaultKymas)
+		require.Empty(t, rootlessDynamicClient.ApplyObjs)
+		require.Empty(t, rootlessDynamicClient.DryRunApplyObjs)
+	})
+
+	t.Run("validate objects on the server", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		rootlessDynamicClient := &fake.RootlessDynamicClient{}
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnModuleTemplateList: kyma.ModuleTemplateList{
+					Items: []kyma.ModuleTemplate{testKedaModuleTemplate},
+				},
+				ReturnModuleReleaseMetaList: kyma.ModuleReleaseMetaList{
+					Items: []kyma.ModuleReleaseMeta{testKedaModuleReleaseMeta},
+				},
+			},
+			TestRootlessDynamicInterface: rootlessDynamicClient,
+		}
+
+		err := dryRunEnable(out.NewToWriter(buffer), context.Background(), client, &modulesfake.ModuleTemplatesRepo{}, "keda", "fast", false, DryRunOptions{Server: true, Format: types.JSONFormat}, testKedaCR)
+		require.Nil(t, err)
+		require.Len(t, rootlessDynamicClient.DryRunApplyObjs, 2)
+		require.Empty(t, rootlessDynamicClient.ApplyObjs)
+		require.Contains(t, buffer.String(), `"kind": "List"`)
+		require.Contains(t, buffer.String(), `"customResourcePolicy": "Ignore"`)
+	})
+
+	t.Run("server dry run failed", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnModuleTemplateList: kyma.ModuleTemplateList{
+					Items: []kyma.ModuleTemplate{testKedaModuleTemplate},
+				},
+				ReturnModuleReleaseMetaList: kyma.ModuleReleaseMetaList{
+					Items: []kyma.ModuleReleaseMeta{testKedaModuleReleaseMeta},
+				},
+			},
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnErr: errors.New("test error"),
+			},
+		}
+
+		err := dryRunEnable(out.NewToWriter(buffer), context.Background(), client, &modulesfake.ModuleTemplatesRepo{}, "keda", "fast", false, DryRunOptions{Server: true})
+		require.NotNil(t, err)
+		require.Contains(t, err.String(), "server dry run failed for some resources")
+		require.Contains(t, err.String(), "default (Kyma): test error")
+		require.Empty(t, buffer.String())
+	})
+
+	t.Run("unknown module", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{},
+		}
+
+		err := dryRunEnable(out.NewToWriter(buffer), context.Background(), client, &modulesfake.ModuleTemplatesRepo{}, "keda", "", false, DryRunOptions{})
+		require.NotNil(t, err)
+		require.Contains(t, err.String(), "unknown module name or channel")
+	})
+}
+
+func TestDryRunKymaCRChange(t *testing.T) {
+	t.Run("print patch without removed module", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		kymaClient := &fake.KymaClient{
+			ReturnDefaultKyma: kyma.Kyma{
+				Spec: kyma.KymaSpec{
+					Modules: []kyma.Module{{Name: "keda"}},
+				},
+			},
+		}
+		client := &fake.KubeClient{
+			TestKymaInterface: kymaClient,
+		}
+
+		err := dryRunKymaCRChange(out.NewToWriter(buffer), context.Background(), client, DryRunOptions{Format: types.JSONFormat}, func(kymaCR *kyma.Kyma) (*kyma.Kyma, error) {
+			return kyma.DisableModuleInKymaCR(kymaCR, "keda"), nil
+		})
+		require.Nil(t, err)
+		require.Contains(t, buffer.String(), `"modules": []`)
+		require.NotContains(t, buffer.String(), `"kind": "List"`)
+		require.Empty(t, kymaClient.UpdateDefaultKymas)
+	})
+
+	t.Run("change failed", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{},
+		}
+
+		err := dryRunKymaCRChange(out.NewToWriter(buffer), context.Background(), client, DryRunOptions{}, func(kymaCR *kyma.Kyma) (*kyma.Kyma, error) {
+			return kyma.UnmanageModuleInKymaCR(kymaCR, "keda")
+		})
+		require.NotNil(t, err)
+		require.Contains(t, err.String(), "failed to prepare the Kyma CR changes")
+		require.Empty(t, buffer.String())
+	})
+}
+
+func TestDryRunManage(t *testing.T) {
+	installedManager := unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":      "keda-manager",
+			"namespace": "kyma-system",
+			"labels":    map[string]any{"app.kubernetes.io/version": "1.0.0"},
+		},
+	}}
+
+	t.Run("add missing module in the default channel", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		kymaClient := &fake.KymaClient{
+			ReturnDefaultKyma: kyma.Kyma{
+				Spec: kyma.KymaSpec{
+					Channel: "fast",
+				},
+			},
+			ReturnModuleReleaseMetaList: kyma.ModuleReleaseMetaList{
+				Items: []kyma.ModuleReleaseMeta{testKedaModuleReleaseMeta},
+			},
+		}
+		client := &fake.KubeClient{
+			TestKymaInterface: kymaClient,
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnCore:             []kyma.ModuleTemplate{testKedaModuleTemplate},
+			ReturnInstalledManager: &installedManager,
+		}
+
+		err := dryRunManage(out.NewToWriter(buffer), context.Background(), client, repo, "keda", "Ignore", DryRunOptions{})
+		require.Nil(t, err)
+		require.Contains(t, buffer.String(), "- channel: fast\n")
+		require.Contains(t, buffer.String(), "customResourcePolicy: Ignore\n")
+		require.Empty(t, kymaClient.EnabledModules)
+		require.Empty(t, kymaClient.UpdateDefaultKymas)
+	})
+
+	t.Run("installed version not in the default channel", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := &fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnDefaultKyma: kyma.Kyma{
+					Spec: kyma.KymaSpec{
+						Channel: "regular",
+					},
+				},
+				ReturnModuleReleaseMetaList: kyma.ModuleReleaseMetaList{
+					Items: []kyma.ModuleReleaseMeta{testKedaModuleReleaseMeta},
+				},
+			},
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnCore:             []kyma.ModuleTemplate{testKedaModuleTemplate},
+			ReturnInstalledManager: &installedManager,
+		}
+
+		err := dryRunManage(out.NewToWriter(buffer), context.Background(), client, repo, "keda", "Ignore", DryRunOptions{})
+		require.NotNil(t, err)
+		require.Contains(t, err.String(), "run the command without the --dry-run flag")
+		require.Empty(t, buffer.String())
+	})
+}
+
+func TestDryRunInstall(t *testing.T) {
+	t.Run("print module resources and default CR", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		rootlessDynamicClient := &fake.RootlessDynamicClient{}
+		client := &fake.KubeClient{
+			TestRootlessDynamicInterface: rootlessDynamicClient,
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnResources: []map[string]any{
+				{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": map[string]any{"name": "keda-manager"}},
+			},
+		}
+		data := InstallCommunityModuleData{
+			CommunityModuleTemplate: &testWaitModuleTemplate,
+			IsDefaultCRApplicable:   true,
+		}
+
+		err := dryRunInstall(out.NewToWriter(buffer), context.Background(), client, repo, data, DryRunOptions{Server: true})
+		require.Nil(t, err)
+		require.Equal(t, "apiVersion: apps/v1\n"+
+			"kind: Deployment\n"+
+			"metadata:\n"+
+			"    name: keda-manager\n"+
+			"---\n"+
+			"apiVersion: test/v1\n"+
+			"kind: Keda\n"+
+			"metadata:\n"+
+			"    name: default\n"+
+			"    namespace: kyma-system\n", buffer.String())
+		require.Len(t, rootlessDynamicClient.DryRunApplyObjs, 2)
+		require.Empty(t, rootlessDynamicClient.ApplyObjs)
+	})
+
+	t.Run("print empty list for module without resources", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		data := InstallCommunityModuleData{
+			CommunityModuleTemplate: &testWaitModuleTemplate,
+		}
+
+		err := dryRunInstall(out.NewToWriter(buffer), context.Background(), &fake.KubeClient{}, &modulesfake.ModuleTemplatesRepo{}, data, DryRunOptions{Format: types.JSONFormat})
+		require.Nil(t, err)
+		require.JSONEq(t, `{"apiVersion": "v1", "kind": "List", "items": []}`, buffer.String())
+	})
+
+	t.Run("failed to get resources", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ResourcesErr: errors.New("test error"),
+		}
+		data := InstallCommunityModuleData{
+			CommunityModuleTemplate: &testWaitModuleTemplate,
+		}
+
+		err := dryRunInstall(out.NewToWriter(buffer), context.Background(), &fake.KubeClient{}, repo, data, DryRunOptions{})
+		require.NotNil(t, err)
+		require.Contains(t, err.String(), "failed to get resources of the keda module")
+	})
+}
+
+func TestDryRunUninstall(t *testing.T) {
+	t.Run("print resources in the removal order", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		rootlessDynamicClient := &fake.RootlessDynamicClient{}
+		client := &fake.KubeClient{
+			TestRootlessDynamicInterface: rootlessDynamicClient,
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnRunningAssociatedResourcesOfModule: []unstructured.Unstructured{testKedaCR},
+			ReturnResources: []map[string]any{
+				{"apiVersion": "v1", "kind": "Namespace", "metadata": map[string]any{"name": "keda"}},
+				{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": map[string]any{"name": "keda-manager"}},
+			},
+		}
+
+		err := dryRunUninstall(out.NewToWriter(buffer), context.Background(), client, repo, &testWaitModuleTemplate, DryRunOptions{Server: true})
+		require.Nil(t, err)
+		require.Len(t, rootlessDynamicClient.DryRunRemovedObjs, 3)
+		require.Equal(t, "Keda", rootlessDynamicClient.DryRunRemovedObjs[0].GetKind())
+		require.Equal(t, "Deployment", rootlessDynamicClient.DryRunRemovedObjs[1].GetKind())
+		require.Equal(t, "Namespace", rootlessDynamicClient.DryRunRemovedObjs[2].GetKind())
+		require.Empty(t, rootlessDynamicClient.RemovedObjs)
+		require.Contains(t, buffer.String(), "kind: Namespace\n")
+	})
+}
+
+func Test_printDryRunObjects(t *testing.T) {
+	t.Run("print empty YAML list", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+
+		err := printDryRunObjects(out.NewToWriter(buffer), []unstructured.Unstructured{}, types.YAMLFormat)
+		require.Nil(t, err)
+		require.Equal(t, "apiVersion: v1\nitems: []\nkind: List\n", buffer.String())
+	})
+}
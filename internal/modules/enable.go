@@ -16,12 +16,12 @@ import (
 
 // Enable takes care about enabling kyma module in order:
 // 1. add module to the Kyma CR with CustomResourcePolicy set to CreateAndDelete if defaultCR is true and to Ignore in any other case
-// 2. if crs array is not empty wait for the module to be ready and add crs to the cluster
-func Enable(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, channel string, defaultCR bool, crs ...unstructured.Unstructured) clierror.Error {
-	return enable(out.Default, ctx, client, repo, module, channel, defaultCR, crs...)
+// 2. if crs array is not empty wait up to the timeout for the module to be ready and add crs to the cluster
+func Enable(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, channel string, defaultCR bool, timeout time.Duration, crs ...unstructured.Unstructured) clierror.Error {
+	return enable(out.Default, ctx, client, repo, module, channel, defaultCR, timeout, crs...)
 }
 
-func enable(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, channel string, defaultCR bool, crs ...unstructured.Unstructured) clierror.Error {
+func enable(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, channel string, defaultCR bool, timeout time.Duration, crs ...unstructured.Unstructured) clierror.Error {
 	if err := validateModuleAvailability(ctx, client, repo, module, channel); err != nil {
 		hints := []string{
 			"ensure you provide a valid module name and channel (or version)",
@@ -42,7 +42,7 @@ func enable(printer *out.Printer, ctx context.Context, client kube.Client, repo
 		return clierror.Wrap(err, clierror.New("failed to enable the module"))
 	}
 
-	clierr := applyCustomCR(printer, ctx, client, module, crs...)
+	clierr := applyCustomCR(printer, ctx, client, module, timeout, crs...)
 	if clierr != nil {
 		return clierr
 	}
@@ -68,13 +68,13 @@ func channelMsgSuffix(channel string) string {
 	return ""
 }
 
-func applyCustomCR(printer *out.Printer, ctx context.Context, client kube.Client, module string, crs ...unstructured.Unstructured) clierror.Error {
+func applyCustomCR(printer *out.Printer, ctx context.Context, client kube.Client, module string, timeout time.Duration, crs ...unstructured.Unstructured) clierror.Error {
 	if len(crs) == 0 {
 		// skip if there is nothing to do
 		return nil
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, time.Second*100)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	printer.Debugln("waiting for module to be ready")
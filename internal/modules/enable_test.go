@@ -76,7 +76,7 @@ func TestEnable(t *testing.T) {
 
 		repo := &modulesfake.ModuleTemplatesRepo{}
 
-		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "fast", true)
+		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "fast", true, DefaultWaitTimeout)
 		require.Nil(t, err)
 		require.Equal(t, "keda module enabled with default module CR from the fast channel\n", buffer.String())
 		require.Equal(t, []fake.FakeEnabledModule{expectedEnabledModule}, kymaClient.EnabledModules)
@@ -110,7 +110,7 @@ func TestEnable(t *testing.T) {
 
 		repo := &modulesfake.ModuleTemplatesRepo{}
 
-		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "", true)
+		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "", true, DefaultWaitTimeout)
 		require.Nil(t, err)
 		require.Equal(t, "keda module enabled with default module CR\n", buffer.String())
 		require.Equal(t, []fake.FakeEnabledModule{expectedEnabledModule}, kymaClient.EnabledModules)
@@ -140,7 +140,7 @@ func TestEnable(t *testing.T) {
 		}
 		repo := &modulesfake.ModuleTemplatesRepo{}
 
-		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "fast", false, testKedaCR)
+		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "fast", false, DefaultWaitTimeout, testKedaCR)
 		require.Nil(t, err)
 		require.Equal(t, "keda module enabled with custom configuration from the fast channel\n", buffer.String())
 		require.Equal(t, []fake.FakeEnabledModule{expectedEnabledModule}, kymaClient.EnabledModules)
@@ -171,7 +171,7 @@ func TestEnable(t *testing.T) {
 			clierror.New("unknown module name or channel", hints...),
 		)
 
-		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "fast", true)
+		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "fast", true, DefaultWaitTimeout)
 		require.Equal(t, expectedCliErr, err)
 	})
 
@@ -200,7 +200,7 @@ func TestEnable(t *testing.T) {
 			clierror.New("unknown module name or channel", hints...),
 		)
 
-		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "fast", true)
+		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "fast", true, DefaultWaitTimeout)
 		require.Equal(t, expectedCliErr, err)
 	})
 
@@ -232,7 +232,7 @@ func TestEnable(t *testing.T) {
 			clierror.New("unknown module name or channel", hints...),
 		)
 
-		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "regular", true)
+		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "regular", true, DefaultWaitTimeout)
 		require.Equal(t, expectedCliErr, err)
 	})
 
@@ -257,7 +257,7 @@ func TestEnable(t *testing.T) {
 			clierror.New("failed to check the module state"),
 		)
 
-		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "fast", false, testKedaCR)
+		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "fast", false, DefaultWaitTimeout, testKedaCR)
 		require.Equal(t, expectedCliErr, err)
 		require.Equal(t, "", buffer.String())
 	})
@@ -287,7 +287,7 @@ func TestEnable(t *testing.T) {
 			clierror.New("failed to apply a custom CR from path"),
 		)
 
-		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "fast", false, testKedaCR)
+		err := enable(out.NewToWriter(buffer), context.Background(), &client, repo, "keda", "fast", false, DefaultWaitTimeout, testKedaCR)
 		require.Equal(t, expectedCliErr, err)
 		require.Equal(t, "", buffer.String())
 	})
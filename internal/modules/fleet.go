@@ -0,0 +1,89 @@
+package modules
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/snapshot"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+)
+
+// ClusterModules contains modules installed in the cluster of the kubeconfig context
+type ClusterModules struct {
+	Context string
+	Modules ModulesList
+	Err     error
+}
+
+// FleetModule contains installation details of the module in every cluster where it's installed
+type FleetModule struct {
+	Name           string
+	InstallDetails map[string]ModuleInstallDetails
+}
+
+// ListInstalledInFleet returns modules installed in clusters of all contexts
+// clusters are listed concurrently and a failure in one cluster doesn't stop listing others
+func ListInstalledInFleet(ctx context.Context, contexts []string, clientForContext func(string) (kube.Client, error), showErrors bool) []ClusterModules {
+	fleet := make([]ClusterModules, len(contexts))
+	var wg sync.WaitGroup
+
+	for i, kubeContext := range contexts {
+		wg.Add(1)
+		go func(i int, kubeContext string) {
+			defer wg.Done()
+			fleet[i] = ClusterModules{Context: kubeContext}
+
+			client, err := clientForContext(kubeContext)
+			if err != nil {
+				fleet[i].Err = err
+				return
+			}
+			client = snapshot.NewClient(client)
+
+			fleet[i].Modules, fleet[i].Err = ListInstalled(ctx, client, repo.NewModuleTemplatesRepo(client), showErrors)
+		}(i, kubeContext)
+	}
+
+	wg.Wait()
+	return fleet
+}
+
+// FleetModules groups modules from all clusters by name
+func FleetModules(fleet []ClusterModules) []FleetModule {
+	byName := map[string]*FleetModule{}
+	for _, cluster := range fleet {
+		for _, module := range cluster.Modules {
+			fleetModule, ok := byName[module.Name]
+			if !ok {
+				fleetModule = &FleetModule{Name: module.Name, InstallDetails: map[string]ModuleInstallDetails{}}
+				byName[module.Name] = fleetModule
+			}
+			fleetModule.InstallDetails[cluster.Context] = module.InstallDetails
+		}
+	}
+
+	fleetModules := make([]FleetModule, 0, len(byName))
+	for _, fleetModule := range byName {
+		fleetModules = append(fleetModules, *fleetModule)
+	}
+	sort.Slice(fleetModules, func(i, j int) bool {
+		return fleetModules[i].Name < fleetModules[j].Name
+	})
+
+	return fleetModules
+}
+
+// HasVersionDrift returns true if the module is installed in different versions across clusters
+func (m *FleetModule) HasVersionDrift() bool {
+	version := ""
+	for _, details := range m.InstallDetails {
+		if version != "" && details.Version != version {
+			return true
+		}
+		version = details.Version
+	}
+
+	return false
+}
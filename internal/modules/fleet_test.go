@@ -0,0 +1,54 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/stretchr/testify/require"
+)
+
+var testFleet = []ClusterModules{
+	{
+		Context: "dev",
+		Modules: ModulesList{
+			{Name: "keda", InstallDetails: ModuleInstallDetails{Version: "1.1.0", Channel: "fast", ModuleState: "Ready", InstallationState: "Ready"}},
+			{Name: "serverless", InstallDetails: ModuleInstallDetails{Version: "1.0.0", Channel: "regular", ModuleState: "Ready", InstallationState: "Ready"}},
+		},
+	},
+	{
+		Context: "prod",
+		Modules: ModulesList{
+			{Name: "keda", InstallDetails: ModuleInstallDetails{Version: "1.0.0", Channel: "regular", ModuleState: "Warning", InstallationState: "Ready"}},
+			{Name: "serverless", InstallDetails: ModuleInstallDetails{Version: "1.0.0", Channel: "regular", ModuleState: "Ready", InstallationState: "Ready"}},
+		},
+	},
+	{
+		Context: "stage",
+		Err:     errors.New("connection refused"),
+	},
+}
+
+func TestListInstalledInFleet(t *testing.T) {
+	clientForContext := func(kubeContext string) (kube.Client, error) {
+		return nil, errors.New("context " + kubeContext + " does not exist")
+	}
+
+	fleet := ListInstalledInFleet(context.Background(), []string{"dev", "prod"}, clientForContext, false)
+	require.Equal(t, []ClusterModules{
+		{Context: "dev", Err: errors.New("context dev does not exist")},
+		{Context: "prod", Err: errors.New("context prod does not exist")},
+	}, fleet)
+}
+
+func TestFleetModules(t *testing.T) {
+	fleetModules := FleetModules(testFleet)
+
+	require.Len(t, fleetModules, 2)
+	require.Equal(t, "keda", fleetModules[0].Name)
+	require.Equal(t, "1.0.0", fleetModules[0].InstallDetails["prod"].Version)
+	require.True(t, fleetModules[0].HasVersionDrift())
+	require.Equal(t, "serverless", fleetModules[1].Name)
+	require.False(t, fleetModules[1].HasVersionDrift())
+}
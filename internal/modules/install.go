@@ -3,15 +3,15 @@ package modules
 import (
 	"context"
 	"fmt"
-	"io"
-	"net/http"
 	"strings"
 	"time"
 
 	"github.com/kyma-project/cli.v3/internal/clierror"
 	"github.com/kyma-project/cli.v3/internal/kube"
 	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modules/integrity"
 	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/modules/source"
 	"github.com/kyma-project/cli.v3/internal/out"
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
@@ -22,6 +22,9 @@ type InstallCommunityModuleData struct {
 	CommunityModuleTemplate *kyma.ModuleTemplate
 	IsDefaultCRApplicable   bool
 	CustomResources         []unstructured.Unstructured
+	// Verifier checks integrity of module resources before they are applied
+	// if not set, only digests are checked
+	Verifier *integrity.Verifier
 }
 
 // Install takes care of enabling the community module on the cluster.
@@ -33,8 +36,13 @@ func Install(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesR
 		return clierror.New("cannot install non-existing module")
 	}
 
-	if err := installModuleResources(ctx, client, data.CommunityModuleTemplate); err != nil {
-		return clierror.Wrap(err, clierror.New("failed to install community module"))
+	verifier := data.Verifier
+	if verifier == nil {
+		verifier = integrity.NewDigestVerifier()
+	}
+
+	if err := installModuleResources(ctx, client, data.CommunityModuleTemplate, verifier); err != nil {
+		return clierror.Wrap(err, clierror.New("failed to install community module", localLinkHints(err)...))
 	}
 
 	if err := applyCustomResources(ctx, client, data.CommunityModuleTemplate, data); err != nil {
@@ -45,6 +53,15 @@ func Install(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesR
 	return nil
 }
 
+// localLinkHints returns hints for errors caused by resources linked as local files
+func localLinkHints(err error) []string {
+	if !errors.Is(err, source.ErrLocalLink) {
+		return nil
+	}
+
+	return []string{"if the module template comes from a trusted local catalog or mirror, use the --allow-local-resources flag to read its local files"}
+}
+
 func FindCommunityModuleTemplate(ctx context.Context, namespace, moduleTemplate string, repo repo.ModuleTemplatesRepository) (*kyma.ModuleTemplate, error) {
 	communityModules, err := repo.Community(ctx)
 	if err != nil {
@@ -89,13 +106,28 @@ func applyCustomResources(ctx context.Context, client kube.Client, existingModul
 	return nil
 }
 
-func installModuleResources(ctx context.Context, client kube.Client, existingModule *kyma.ModuleTemplate) error {
+func installModuleResources(ctx context.Context, client kube.Client, existingModule *kyma.ModuleTemplate, verifier *integrity.Verifier) error {
+	// all resources are verified before anything is applied
+	manifests := [][]byte{}
 	for _, res := range existingModule.Spec.Resources {
 		if res.Name != "rawManifest" {
 			continue
 		}
-		url := res.Link
-		if err := applyResourcesFromURL(ctx, client, url); err != nil {
+
+		manifest, err := verifier.ReadResource(res.Link)
+		if err != nil {
+			return err
+		}
+
+		if err := verifier.Verify(res, manifest); err != nil {
+			return errors.Wrap(err, "failed to verify module resources")
+		}
+
+		manifests = append(manifests, manifest)
+	}
+
+	for _, manifest := range manifests {
+		if err := applyResourcesFromManifest(ctx, client, manifest); err != nil {
 			return errors.Wrap(err, "failed to apply resources from link")
 		}
 	}
@@ -103,11 +135,8 @@ func installModuleResources(ctx context.Context, client kube.Client, existingMod
 	return nil
 }
 
-func applyResourcesFromURL(ctx context.Context, client kube.Client, url string) error {
-	resourceYamlStrings, err := getResourceYamlStringsFromURL(url)
-	if err != nil {
-		return err
-	}
+func applyResourcesFromManifest(ctx context.Context, client kube.Client, manifest []byte) error {
+	resourceYamlStrings := splitResourceYamlStrings(manifest)
 
 	var parsedResources []map[string]any
 
@@ -140,19 +169,8 @@ func applyResourceWithRollback(ctx context.Context, client kube.Client, parsedRe
 	return parsedResource, nil
 }
 
-func getResourceYamlStringsFromURL(url string) ([]string, error) {
-	resp, err := http.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("failed to download resource from %s: %w", url, err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read resource body: %w", err)
-	}
-
-	parts := strings.Split(string(body), "---")
+func splitResourceYamlStrings(manifest []byte) []string {
+	parts := strings.Split(string(manifest), "---")
 	var result []string
 	for _, part := range parts {
 		trimmed := strings.TrimSpace(part)
@@ -161,7 +179,7 @@ func getResourceYamlStringsFromURL(url string) ([]string, error) {
 		}
 	}
 
-	return result, nil
+	return result
 }
 
 func applyDefaultCustomResource(ctx context.Context, client kube.Client, existingModule *kyma.ModuleTemplate) error {
@@ -2,15 +2,20 @@ package modules
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/kyma-project/cli.v3/internal/clierror"
 	"github.com/kyma-project/cli.v3/internal/kube/fake"
 	"github.com/kyma-project/cli.v3/internal/kube/kyma"
 	modulesfake "github.com/kyma-project/cli.v3/internal/modules/fake"
+	"github.com/kyma-project/cli.v3/internal/modules/integrity"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
@@ -135,6 +140,62 @@ func TestInstall_ModuleSuccessfullyInstalledFromRemote(t *testing.T) {
 	require.Nil(t, clierr)
 }
 
+func TestInstall_VerifyResources(t *testing.T) {
+	ctx := context.Background()
+
+	testHttpServer := getTestHttpServerWithResponse(validResourceYaml)
+	defer testHttpServer.Close()
+
+	t.Run("apply resources matching digest", func(t *testing.T) {
+		testModuleTemplate := getModuleTemplateSpecWithResourceLink(testHttpServer.URL)
+		sum := sha256.Sum256([]byte(validResourceYaml))
+		testModuleTemplate.Spec.Resources[0].Digest = "sha256:" + hex.EncodeToString(sum[:])
+		rootlessDynamicClient := fake.RootlessDynamicClient{}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &rootlessDynamicClient,
+		}
+
+		clierr := Install(ctx, &client, &modulesfake.ModuleTemplatesRepo{}, InstallCommunityModuleData{
+			CommunityModuleTemplate: &testModuleTemplate,
+			Verifier:                &integrity.Verifier{RequireVerified: true},
+		})
+		require.Nil(t, clierr)
+		require.Len(t, rootlessDynamicClient.ApplyObjs, 2)
+	})
+
+	t.Run("refuse resources not matching digest", func(t *testing.T) {
+		testModuleTemplate := getModuleTemplateSpecWithResourceLink(testHttpServer.URL)
+		testModuleTemplate.Spec.Resources[0].Digest = "sha256:1234"
+		rootlessDynamicClient := fake.RootlessDynamicClient{}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &rootlessDynamicClient,
+		}
+
+		clierr := Install(ctx, &client, &modulesfake.ModuleTemplatesRepo{}, InstallCommunityModuleData{
+			CommunityModuleTemplate: &testModuleTemplate,
+		})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to verify digest of resource")
+		require.Empty(t, rootlessDynamicClient.ApplyObjs)
+	})
+
+	t.Run("refuse unverified resources", func(t *testing.T) {
+		testModuleTemplate := getModuleTemplateSpecWithResourceLink(testHttpServer.URL)
+		rootlessDynamicClient := fake.RootlessDynamicClient{}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &rootlessDynamicClient,
+		}
+
+		clierr := Install(ctx, &client, &modulesfake.ModuleTemplatesRepo{}, InstallCommunityModuleData{
+			CommunityModuleTemplate: &testModuleTemplate,
+			Verifier:                &integrity.Verifier{RequireVerified: true},
+		})
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "has neither digest nor signature")
+		require.Empty(t, rootlessDynamicClient.ApplyObjs)
+	})
+}
+
 func TestInstall_ModuleSuccessfullyInstalledFromLocal(t *testing.T) {
 	ctx := context.Background()
 
@@ -170,6 +231,54 @@ func TestInstall_ModuleSuccessfullyInstalledFromLocal(t *testing.T) {
 	require.Nil(t, clierr)
 }
 
+func TestInstall_LocalResourceLinks(t *testing.T) {
+	ctx := context.Background()
+
+	manifestPath := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(manifestPath, []byte(validResourceYaml), 0600))
+
+	for _, link := range []string{manifestPath, "file://" + manifestPath} {
+		t.Run("refuse local link "+link+" of the module template from the cluster", func(t *testing.T) {
+			testModuleTemplate := getModuleTemplateSpecWithResourceLink(link)
+			rootlessDynamicClient := fake.RootlessDynamicClient{}
+			client := fake.KubeClient{
+				TestKymaInterface: &fake.KymaClient{
+					ReturnModuleTemplateList: kyma.ModuleTemplateList{
+						Items: []kyma.ModuleTemplate{testModuleTemplate},
+					},
+				},
+				TestRootlessDynamicInterface: &rootlessDynamicClient,
+			}
+
+			moduleTemplates, err := client.Kyma().ListModuleTemplate(ctx)
+			require.NoError(t, err)
+
+			clierr := Install(ctx, &client, &modulesfake.ModuleTemplatesRepo{}, InstallCommunityModuleData{
+				CommunityModuleTemplate: &moduleTemplates.Items[0],
+			})
+			require.NotNil(t, clierr)
+			require.Contains(t, clierr.String(), "local file links are allowed only in module templates from local catalogs")
+			require.Contains(t, clierr.String(), "--allow-local-resources")
+			require.Empty(t, rootlessDynamicClient.ApplyObjs)
+		})
+	}
+
+	t.Run("apply resources from local link when allowed", func(t *testing.T) {
+		testModuleTemplate := getModuleTemplateSpecWithResourceLink(manifestPath)
+		rootlessDynamicClient := fake.RootlessDynamicClient{}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &rootlessDynamicClient,
+		}
+
+		clierr := Install(ctx, &client, &modulesfake.ModuleTemplatesRepo{}, InstallCommunityModuleData{
+			CommunityModuleTemplate: &testModuleTemplate,
+			Verifier:                integrity.NewDigestVerifier().WithLocalLinks(),
+		})
+		require.Nil(t, clierr)
+		require.Len(t, rootlessDynamicClient.ApplyObjs, 2)
+	})
+}
+
 func TestInstall_ModuleSuccessfullyInstalledWithDefaultCR(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,221 @@
+package integrity
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modules/source"
+)
+
+// Status is the result of the module integrity check
+type Status string
+
+const (
+	StatusVerified   Status = "verified"
+	StatusUnverified Status = "unverified"
+	StatusFailed     Status = "failed"
+
+	sha256DigestPrefix = "sha256:"
+)
+
+// Verifier checks digests and signatures of module resources
+// zero value checks only digests and accepts unverified resources
+type Verifier struct {
+	// RequireVerified rejects resources without digest or signature
+	RequireVerified bool
+
+	publicKeys []crypto.PublicKey
+	// skipSignatures accepts signed resources without checking their signatures
+	skipSignatures bool
+	// allowLocalLinks allows reading resources and signatures from local files
+	allowLocalLinks bool
+}
+
+// NewDigestVerifier returns verifier checking only digests of resources
+// it's used to read resources of modules when no public keys are provided
+func NewDigestVerifier() *Verifier {
+	return &Verifier{
+		skipSignatures: true,
+	}
+}
+
+// NewVerifier returns verifier using PEM-encoded public keys from given files to check signatures
+func NewVerifier(requireVerified bool, publicKeyPaths ...string) (*Verifier, error) {
+	verifier := &Verifier{
+		RequireVerified: requireVerified,
+	}
+
+	for _, path := range publicKeyPaths {
+		publicKey, err := readPublicKey(path)
+		if err != nil {
+			return nil, err
+		}
+
+		verifier.publicKeys = append(verifier.publicKeys, publicKey)
+	}
+
+	return verifier, nil
+}
+
+// WithLocalLinks returns the copy of the verifier that reads resources and signatures linked as local files
+// use it only for module templates from local catalogs or when the user trusts local links of the module template
+func (v *Verifier) WithLocalLinks() *Verifier {
+	verifier := *v
+	verifier.allowLocalLinks = true
+	return &verifier
+}
+
+// ReadResource returns content of the resource link
+// local files are read only if the verifier allows local links
+func (v *Verifier) ReadResource(link string) ([]byte, error) {
+	return source.ReadResource(link, v.allowLocalLinks)
+}
+
+// Verify checks the data downloaded from the resource link against the resource digest and signature
+// returns error if any check fails or if the resource is not verified and verification is required
+func (v *Verifier) Verify(resource kyma.Resource, data []byte) error {
+	status, err := v.check(resource, data)
+	if err != nil {
+		return err
+	}
+
+	if status == StatusUnverified && v.RequireVerified {
+		return fmt.Errorf("resource %s has neither digest nor signature", resource.Link)
+	}
+
+	return nil
+}
+
+// Check downloads all resources of the module template and returns the result of their verification
+func (v *Verifier) Check(moduleTemplate *kyma.ModuleTemplate) Status {
+	if len(moduleTemplate.Spec.Resources) == 0 {
+		return StatusUnverified
+	}
+
+	result := StatusVerified
+	for _, resource := range moduleTemplate.Spec.Resources {
+		data, err := v.ReadResource(resource.Link)
+		if err != nil {
+			return StatusFailed
+		}
+
+		status, err := v.check(resource, data)
+		if err != nil {
+			return StatusFailed
+		}
+
+		if status == StatusUnverified {
+			result = StatusUnverified
+		}
+	}
+
+	return result
+}
+
+func (v *Verifier) check(resource kyma.Resource, data []byte) (Status, error) {
+	if resource.Digest == "" && (resource.Signature == "" || v.skipSignatures) {
+		return StatusUnverified, nil
+	}
+
+	if resource.Digest != "" {
+		err := verifyDigest(resource.Digest, data)
+		if err != nil {
+			return StatusFailed, fmt.Errorf("failed to verify digest of resource %s: %w", resource.Link, err)
+		}
+	}
+
+	if resource.Signature != "" && !v.skipSignatures {
+		err := v.verifySignature(resource.Signature, data)
+		if err != nil {
+			return StatusFailed, fmt.Errorf("failed to verify signature of resource %s: %w", resource.Link, err)
+		}
+	}
+
+	return StatusVerified, nil
+}
+
+func verifyDigest(digest string, data []byte) error {
+	expected, ok := strings.CutPrefix(digest, sha256DigestPrefix)
+	if !ok {
+		return fmt.Errorf("unsupported digest '%s', expected format sha256:<hex>", digest)
+	}
+
+	sum := sha256.Sum256(data)
+	actual := hex.EncodeToString(sum[:])
+	if !strings.EqualFold(expected, actual) {
+		return fmt.Errorf("expected sha256:%s, got sha256:%s", expected, actual)
+	}
+
+	return nil
+}
+
+// verifySignature checks detached signature in the format produced by the `cosign sign-blob` command
+func (v *Verifier) verifySignature(signatureLink string, data []byte) error {
+	if len(v.publicKeys) == 0 {
+		return errors.New("no public key provided to verify the signature")
+	}
+
+	encodedSignature, err := v.ReadResource(signatureLink)
+	if err != nil {
+		return err
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(encodedSignature)))
+	if err != nil {
+		return fmt.Errorf("failed to decode signature from %s: %w", signatureLink, err)
+	}
+
+	for _, publicKey := range v.publicKeys {
+		if verifyWithKey(publicKey, data, signature) {
+			return nil
+		}
+	}
+
+	return errors.New("signature does not match any of the provided public keys")
+}
+
+func verifyWithKey(publicKey crypto.PublicKey, data, signature []byte) bool {
+	digest := sha256.Sum256(data)
+
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, digest[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, data, signature)
+	default:
+		return false
+	}
+}
+
+func readPublicKey(path string) (crypto.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode public key from %s: no PEM data found", path)
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse public key from %s: %w", path, err)
+	}
+
+	return publicKey, nil
+}
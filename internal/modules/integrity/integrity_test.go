@@ -0,0 +1,193 @@
+package integrity
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modules/source"
+	"github.com/stretchr/testify/require"
+)
+
+var testManifest = []byte("apiVersion: v1\nkind: Namespace\nmetadata:\n  name: test\n")
+
+func TestVerifier_Verify(t *testing.T) {
+	sum := sha256.Sum256(testManifest)
+	validDigest := "sha256:" + hex.EncodeToString(sum[:])
+
+	t.Run("matching digest", func(t *testing.T) {
+		verifier := &Verifier{RequireVerified: true}
+
+		err := verifier.Verify(kyma.Resource{Link: "manifest.yaml", Digest: validDigest}, testManifest)
+		require.NoError(t, err)
+	})
+
+	t.Run("digest mismatch", func(t *testing.T) {
+		verifier := &Verifier{}
+
+		err := verifier.Verify(kyma.Resource{Link: "manifest.yaml", Digest: "sha256:1234"}, testManifest)
+		require.ErrorContains(t, err, "failed to verify digest of resource manifest.yaml: expected sha256:1234")
+	})
+
+	t.Run("unsupported digest", func(t *testing.T) {
+		verifier := &Verifier{}
+
+		err := verifier.Verify(kyma.Resource{Link: "manifest.yaml", Digest: "md5:1234"}, testManifest)
+		require.ErrorContains(t, err, "unsupported digest 'md5:1234'")
+	})
+
+	t.Run("unverified resource", func(t *testing.T) {
+		require.NoError(t, (&Verifier{}).Verify(kyma.Resource{Link: "manifest.yaml"}, testManifest))
+
+		err := (&Verifier{RequireVerified: true}).Verify(kyma.Resource{Link: "manifest.yaml"}, testManifest)
+		require.ErrorContains(t, err, "resource manifest.yaml has neither digest nor signature")
+	})
+
+	t.Run("valid ecdsa signature", func(t *testing.T) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		signature, err := ecdsa.SignASN1(rand.Reader, privateKey, sum[:])
+		require.NoError(t, err)
+
+		verifier, err := NewVerifier(true, writePublicKey(t, &privateKey.PublicKey))
+		require.NoError(t, err)
+
+		err = verifier.WithLocalLinks().Verify(kyma.Resource{Link: "manifest.yaml", Signature: writeSignature(t, signature)}, testManifest)
+		require.NoError(t, err)
+	})
+
+	t.Run("valid ed25519 signature", func(t *testing.T) {
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		verifier, err := NewVerifier(true, writePublicKey(t, publicKey))
+		require.NoError(t, err)
+
+		err = verifier.WithLocalLinks().Verify(kyma.Resource{Link: "manifest.yaml", Signature: writeSignature(t, ed25519.Sign(privateKey, testManifest))}, testManifest)
+		require.NoError(t, err)
+	})
+
+	t.Run("signature of other key", func(t *testing.T) {
+		publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		_, otherPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		verifier, err := NewVerifier(false, writePublicKey(t, publicKey))
+		require.NoError(t, err)
+
+		err = verifier.WithLocalLinks().Verify(kyma.Resource{Link: "manifest.yaml", Signature: writeSignature(t, ed25519.Sign(otherPrivateKey, testManifest))}, testManifest)
+		require.ErrorContains(t, err, "signature does not match any of the provided public keys")
+	})
+
+	t.Run("local signature link not allowed", func(t *testing.T) {
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		verifier, err := NewVerifier(true, writePublicKey(t, publicKey))
+		require.NoError(t, err)
+
+		err = verifier.Verify(kyma.Resource{Link: "manifest.yaml", Signature: writeSignature(t, ed25519.Sign(privateKey, testManifest))}, testManifest)
+		require.ErrorIs(t, err, source.ErrLocalLink)
+	})
+
+	t.Run("signature without public key", func(t *testing.T) {
+		err := (&Verifier{}).Verify(kyma.Resource{Link: "manifest.yaml", Signature: writeSignature(t, []byte("sig"))}, testManifest)
+		require.ErrorContains(t, err, "no public key provided to verify the signature")
+	})
+
+	t.Run("digest verifier skips signatures", func(t *testing.T) {
+		verifier := NewDigestVerifier()
+
+		err := verifier.Verify(kyma.Resource{Link: "manifest.yaml", Digest: validDigest, Signature: writeSignature(t, []byte("sig"))}, testManifest)
+		require.NoError(t, err)
+
+		err = verifier.Verify(kyma.Resource{Link: "manifest.yaml", Digest: "sha256:1234", Signature: writeSignature(t, []byte("sig"))}, testManifest)
+		require.ErrorContains(t, err, "failed to verify digest of resource manifest.yaml")
+	})
+}
+
+func TestVerifier_Check(t *testing.T) {
+	manifestPath := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(manifestPath, testManifest, 0600))
+	sum := sha256.Sum256(testManifest)
+
+	tests := []struct {
+		name      string
+		resources []kyma.Resource
+		want      Status
+	}{
+		{
+			name:      "verified",
+			resources: []kyma.Resource{{Link: manifestPath, Digest: "sha256:" + hex.EncodeToString(sum[:])}},
+			want:      StatusVerified,
+		},
+		{
+			name:      "unverified",
+			resources: []kyma.Resource{{Link: manifestPath}},
+			want:      StatusUnverified,
+		},
+		{
+			name:      "failed",
+			resources: []kyma.Resource{{Link: manifestPath, Digest: "sha256:1234"}},
+			want:      StatusFailed,
+		},
+		{
+			name:      "missing resource",
+			resources: []kyma.Resource{{Link: filepath.Join(t.TempDir(), "missing.yaml")}},
+			want:      StatusFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			moduleTemplate := &kyma.ModuleTemplate{
+				Spec: kyma.ModuleTemplateSpec{Resources: tt.resources},
+			}
+
+			require.Equal(t, tt.want, (&Verifier{}).WithLocalLinks().Check(moduleTemplate))
+		})
+	}
+
+	t.Run("local link not allowed", func(t *testing.T) {
+		moduleTemplate := &kyma.ModuleTemplate{
+			Spec: kyma.ModuleTemplateSpec{Resources: []kyma.Resource{{Link: manifestPath, Digest: "sha256:" + hex.EncodeToString(sum[:])}}},
+		}
+
+		require.Equal(t, StatusFailed, (&Verifier{}).Check(moduleTemplate))
+	})
+}
+
+func TestNewVerifier(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "key.pub")
+	require.NoError(t, os.WriteFile(path, []byte("not a key"), 0600))
+
+	verifier, err := NewVerifier(false, path)
+	require.ErrorContains(t, err, "no PEM data found")
+	require.Nil(t, verifier)
+}
+
+func writePublicKey(t *testing.T, publicKey any) string {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "key.pub")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+	return path
+}
+
+func writeSignature(t *testing.T, signature []byte) string {
+	path := filepath.Join(t.TempDir(), "manifest.yaml.sig")
+	require.NoError(t, os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(signature)), 0600))
+	return "file://" + path
+}
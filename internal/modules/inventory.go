@@ -0,0 +1,514 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/kyma-project/cli.v3/internal/render"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	ResourceExists  = "Exists"
+	ResourceMissing = "Missing"
+	ResourceDrifted = "Drifted"
+	// ResourceUnknown is set when the live resource can't be read, the reason is in the resource error
+	ResourceUnknown = "Unknown"
+)
+
+// moduleLabel is set on resources installed by core modules
+const moduleLabel = "kyma-project.io/module"
+
+// discoveredKinds are kinds searched for resources labeled with the module name or owned by module resources
+var discoveredKinds = []struct{ apiVersion, kind string }{
+	{"apps/v1", "Deployment"},
+	{"apps/v1", "StatefulSet"},
+	{"apps/v1", "DaemonSet"},
+	{"apps/v1", "ReplicaSet"},
+	{"batch/v1", "Job"},
+	{"batch/v1", "CronJob"},
+	{"v1", "Pod"},
+	{"v1", "Service"},
+	{"v1", "ServiceAccount"},
+	{"v1", "ConfigMap"},
+	{"v1", "Secret"},
+	{"rbac.authorization.k8s.io/v1", "Role"},
+	{"rbac.authorization.k8s.io/v1", "RoleBinding"},
+	{"rbac.authorization.k8s.io/v1", "ClusterRole"},
+	{"rbac.authorization.k8s.io/v1", "ClusterRoleBinding"},
+	{"networking.k8s.io/v1", "NetworkPolicy"},
+}
+
+// ModuleInventory contains resources owned by the module
+type ModuleInventory struct {
+	Name            string `json:"name" yaml:"name"`
+	CommunityModule bool   `json:"communityModule" yaml:"communityModule"`
+	Version         string `json:"version" yaml:"version"`
+	// ManifestResources contains resources from the module manifest with their live status
+	ManifestResources []InventoryResource `json:"manifestResources" yaml:"manifestResources"`
+	// ModuleCRs contains instances of the module CR and associated resources from all namespaces
+	ModuleCRs []InventoryResource `json:"moduleCRs" yaml:"moduleCRs"`
+	// DiscoveredResources contains resources labeled with the module name or owned by other module resources
+	DiscoveredResources []InventoryResource `json:"discoveredResources" yaml:"discoveredResources"`
+	Warnings            []string            `json:"warnings,omitempty" yaml:"warnings,omitempty"`
+}
+
+type InventoryResource struct {
+	APIVersion string `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string `json:"kind" yaml:"kind"`
+	Name       string `json:"name" yaml:"name"`
+	Namespace  string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Status     string `json:"status,omitempty" yaml:"status,omitempty"`
+	// Source describes why a discovered resource belongs to the module
+	Source string       `json:"source,omitempty" yaml:"source,omitempty"`
+	Drift  []FieldDrift `json:"drift,omitempty" yaml:"drift,omitempty"`
+	Error  string       `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// FieldDrift describes a field of the live resource that differs from the manifest
+// values of redacted fields (like Secret data) are not included
+type FieldDrift struct {
+	Path     string `json:"path" yaml:"path"`
+	Expected any    `json:"expected" yaml:"expected"`
+	Actual   any    `json:"actual" yaml:"actual"`
+	Redacted bool   `json:"redacted,omitempty" yaml:"redacted,omitempty"`
+}
+
+// InventoryModule collects resources owned by the installed core module
+func InventoryModule(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module string) (*ModuleInventory, error) {
+	moduleTemplate, err := findInstalledCoreModuleTemplate(ctx, client, repo, module)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := collectInventory(ctx, client, repo, moduleTemplate)
+	discoverModuleResources(ctx, client, inventory)
+
+	return inventory, nil
+}
+
+// InventoryCommunityModule collects resources owned by the community module
+func InventoryCommunityModule(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleTemplate *kyma.ModuleTemplate) *ModuleInventory {
+	inventory := collectInventory(ctx, client, repo, moduleTemplate)
+	inventory.CommunityModule = true
+
+	return inventory
+}
+
+// findInstalledCoreModuleTemplate returns the module template of the version from the Kyma CR status
+// or the module template with the running manager for modules installed without the Kyma CR
+func findInstalledCoreModuleTemplate(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module string) (*kyma.ModuleTemplate, error) {
+	defaultKyma, err := client.Kyma().GetDefaultKyma(ctx)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, errors.Wrap(err, "failed to get default Kyma CR from the target Kyma environment")
+	}
+	if err == nil {
+		for _, moduleStatus := range defaultKyma.Status.Modules {
+			if moduleStatus.Name == module && moduleStatus.Version != "" {
+				return findMatchingModuleTemplate(ctx, client, moduleStatus)
+			}
+		}
+	}
+
+	coreModuleTemplates, err := repo.Core(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list core modules")
+	}
+	for i, moduleTemplate := range coreModuleTemplates {
+		if moduleTemplate.Spec.ModuleName != module {
+			continue
+		}
+
+		manager, err := repo.InstalledManager(ctx, moduleTemplate)
+		if err == nil && manager != nil {
+			return &coreModuleTemplates[i], nil
+		}
+	}
+
+	return nil, errors.Errorf("module %s is not installed", module)
+}
+
+// collectInventory reads resources from the module manifest, module CRs and associated resources
+// failures are added to warnings, so that the rest of the inventory can still be shown
+func collectInventory(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleTemplate *kyma.ModuleTemplate) *ModuleInventory {
+	inventory := &ModuleInventory{
+		Name:                moduleTemplate.Spec.ModuleName,
+		Version:             moduleTemplate.Spec.Version,
+		ManifestResources:   []InventoryResource{},
+		ModuleCRs:           []InventoryResource{},
+		DiscoveredResources: []InventoryResource{},
+	}
+
+	resources, err := repo.Resources(ctx, *moduleTemplate)
+	if err != nil {
+		inventory.Warnings = append(inventory.Warnings, fmt.Sprintf("failed to read the module manifest: %v", err))
+	}
+	for _, resource := range resources {
+		inventory.ManifestResources = append(inventory.ManifestResources, inspectManifestResource(ctx, client, resource))
+	}
+
+	moduleCRs, err := listModuleCRs(ctx, client, moduleTemplate.Spec.Data)
+	if err != nil {
+		inventory.Warnings = append(inventory.Warnings, fmt.Sprintf("failed to list module CRs: %v", err))
+	}
+	associatedResources, err := repo.RunningAssociatedResourcesOfModule(ctx, *moduleTemplate)
+	if err != nil {
+		inventory.Warnings = append(inventory.Warnings, fmt.Sprintf("failed to list associated resources: %v", err))
+	}
+
+	// the module CR kind is often listed in associated resources too
+	seen := map[string]bool{}
+	for _, resource := range append(moduleCRs, associatedResources...) {
+		key := inventoryKey(resource.GetAPIVersion(), resource.GetKind(), resource.GetNamespace(), resource.GetName())
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		inventory.ModuleCRs = append(inventory.ModuleCRs, inventoryResourceFromUnstruct(resource))
+	}
+
+	return inventory
+}
+
+// inspectManifestResource compares the resource from the manifest with the live one
+func inspectManifestResource(ctx context.Context, client kube.Client, resource map[string]any) InventoryResource {
+	manifest := unstructured.Unstructured{Object: resource}
+	inventoryResource := inventoryResourceFromUnstruct(manifest)
+
+	// namespaced resources without namespace are applied to the kyma-system namespace
+	lookup := &unstructured.Unstructured{Object: normalizeObject(resource)}
+	if lookup.GetNamespace() == "" {
+		lookup.SetNamespace("kyma-system")
+	}
+
+	live, err := client.RootlessDynamic().Get(ctx, lookup)
+	if apierrors.IsNotFound(err) {
+		inventoryResource.Status = ResourceMissing
+		return inventoryResource
+	}
+	if err != nil {
+		inventoryResource.Status = ResourceUnknown
+		inventoryResource.Error = err.Error()
+		return inventoryResource
+	}
+
+	inventoryResource.Namespace = live.GetNamespace()
+	inventoryResource.Drift = diffManifest(resource, live.Object)
+	inventoryResource.Status = ResourceExists
+	if len(inventoryResource.Drift) > 0 {
+		inventoryResource.Status = ResourceDrifted
+	}
+
+	return inventoryResource
+}
+
+// discoverModuleResources adds resources labeled with the module name
+// and resources owned by manifest resources, module CRs, or other discovered resources
+func discoverModuleResources(ctx context.Context, client kube.Client, inventory *ModuleInventory) {
+	known := map[string]bool{}
+	for _, resource := range append(slices.Clone(inventory.ManifestResources), inventory.ModuleCRs...) {
+		known[inventoryKey(resource.APIVersion, resource.Kind, resource.Namespace, resource.Name)] = true
+	}
+
+	candidates := []unstructured.Unstructured{}
+	for _, discoveredKind := range discoveredKinds {
+		list, err := client.RootlessDynamic().List(ctx, &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": discoveredKind.apiVersion,
+				"kind":       discoveredKind.kind,
+			},
+		}, &rootlessdynamic.ListOptions{AllNamespaces: true})
+		if err != nil {
+			out.Debugfln("failed to list %s: %v", discoveredKind.kind, err)
+			continue
+		}
+		candidates = append(candidates, list.Items...)
+	}
+
+	ownerUIDs := map[k8stypes.UID]string{}
+	for _, candidate := range candidates {
+		key := inventoryKey(candidate.GetAPIVersion(), candidate.GetKind(), candidate.GetNamespace(), candidate.GetName())
+		if known[key] {
+			ownerUIDs[candidate.GetUID()] = describeOwner(candidate)
+		}
+	}
+	// module CRs are not listed with discovered kinds
+	for _, resource := range inventory.ModuleCRs {
+		unstruct := generateUnstruct(resource.APIVersion, resource.Kind, resource.Name, resource.Namespace)
+		if live, err := client.RootlessDynamic().Get(ctx, &unstruct); err == nil {
+			ownerUIDs[live.GetUID()] = describeOwner(*live)
+		}
+	}
+
+	// owned resources are discovered until no new owner is found to follow chains like Deployment -> ReplicaSet -> Pod
+	for found := true; found; {
+		found = false
+		for _, candidate := range candidates {
+			key := inventoryKey(candidate.GetAPIVersion(), candidate.GetKind(), candidate.GetNamespace(), candidate.GetName())
+			if known[key] {
+				continue
+			}
+
+			source := getDiscoverySource(candidate, inventory.Name, ownerUIDs)
+			if source == "" {
+				continue
+			}
+
+			known[key] = true
+			ownerUIDs[candidate.GetUID()] = describeOwner(candidate)
+			resource := inventoryResourceFromUnstruct(candidate)
+			resource.Source = source
+			inventory.DiscoveredResources = append(inventory.DiscoveredResources, resource)
+			found = true
+		}
+	}
+}
+
+func getDiscoverySource(resource unstructured.Unstructured, module string, ownerUIDs map[k8stypes.UID]string) string {
+	if resource.GetLabels()[moduleLabel] == module {
+		return fmt.Sprintf("label %s=%s", moduleLabel, module)
+	}
+
+	for _, ownerReference := range resource.GetOwnerReferences() {
+		if owner, ok := ownerUIDs[ownerReference.UID]; ok {
+			return fmt.Sprintf("owned by %s", owner)
+		}
+	}
+
+	return ""
+}
+
+func describeOwner(resource unstructured.Unstructured) string {
+	if resource.GetNamespace() == "" {
+		return fmt.Sprintf("%s %s", resource.GetKind(), resource.GetName())
+	}
+	return fmt.Sprintf("%s %s/%s", resource.GetKind(), resource.GetNamespace(), resource.GetName())
+}
+
+func inventoryResourceFromUnstruct(resource unstructured.Unstructured) InventoryResource {
+	return InventoryResource{
+		APIVersion: resource.GetAPIVersion(),
+		Kind:       resource.GetKind(),
+		Name:       resource.GetName(),
+		Namespace:  resource.GetNamespace(),
+	}
+}
+
+func inventoryKey(apiVersion, kind, namespace, name string) string {
+	return strings.Join([]string{apiVersion, kind, namespace, name}, "/")
+}
+
+// diffManifest returns fields set in the manifest that have different values in the live resource
+// fields added by the cluster are ignored, and only labels and annotations are compared in metadata
+// values of Secret data are redacted so that the drift doesn't reveal them
+func diffManifest(manifest, live map[string]any) []FieldDrift {
+	expected, actual := normalizeObject(manifest), normalizeObject(live)
+
+	drift := []FieldDrift{}
+	for _, key := range slices.Sorted(maps.Keys(expected)) {
+		switch key {
+		case "apiVersion", "kind", "status", "stringData":
+			// stringData is merged into data by the API server
+			continue
+		case "metadata":
+			expectedMetadata, _ := expected[key].(map[string]any)
+			actualMetadata, _ := actual[key].(map[string]any)
+			for _, metadataKey := range []string{"labels", "annotations"} {
+				if value, ok := expectedMetadata[metadataKey]; ok {
+					drift = append(drift, diffFields("metadata."+metadataKey, value, actualMetadata[metadataKey])...)
+				}
+			}
+		case "data":
+			fieldsDrift := diffFields(key, expected[key], actual[key])
+			if expected["kind"] == "Secret" {
+				fieldsDrift = redactDrift(fieldsDrift)
+			}
+			drift = append(drift, fieldsDrift...)
+		default:
+			drift = append(drift, diffFields(key, expected[key], actual[key])...)
+		}
+	}
+
+	return drift
+}
+
+func redactDrift(drift []FieldDrift) []FieldDrift {
+	redacted := []FieldDrift{}
+	for _, fieldDrift := range drift {
+		redacted = append(redacted, FieldDrift{Path: fieldDrift.Path, Redacted: true})
+	}
+
+	return redacted
+}
+
+func diffFields(path string, expected, actual any) []FieldDrift {
+	switch expectedValue := expected.(type) {
+	case map[string]any:
+		actualMap, ok := actual.(map[string]any)
+		if !ok {
+			return []FieldDrift{{Path: path, Expected: expected, Actual: actual}}
+		}
+
+		drift := []FieldDrift{}
+		for _, key := range slices.Sorted(maps.Keys(expectedValue)) {
+			drift = append(drift, diffFields(path+"."+key, expectedValue[key], actualMap[key])...)
+		}
+		return drift
+	case []any:
+		actualList, ok := actual.([]any)
+		if !ok || len(actualList) != len(expectedValue) {
+			return []FieldDrift{{Path: path, Expected: expected, Actual: actual}}
+		}
+
+		drift := []FieldDrift{}
+		for i := range expectedValue {
+			drift = append(drift, diffFields(fmt.Sprintf("%s[%d]", path, i), expectedValue[i], actualList[i])...)
+		}
+		return drift
+	default:
+		if !reflect.DeepEqual(expected, actual) {
+			return []FieldDrift{{Path: path, Expected: expected, Actual: actual}}
+		}
+		return nil
+	}
+}
+
+// normalizeObject converts values to their JSON representation
+// manifests are parsed from YAML with ints while live resources contain int64s
+func normalizeObject(obj map[string]any) map[string]any {
+	normalized := map[string]any{}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return normalized
+	}
+
+	_ = json.Unmarshal(data, &normalized)
+	return normalized
+}
+
+// RenderModuleInventory prints the inventory in the given format, field-level drift is printed only if showDrift is true
+func RenderModuleInventory(inventory *ModuleInventory, format types.Format, showDrift bool) error {
+	return renderModuleInventory(out.Default, inventory, format, showDrift)
+}
+
+func renderModuleInventory(printer *out.Printer, inventory *ModuleInventory, format types.Format, showDrift bool) error {
+	if !showDrift {
+		inventory = withoutDrift(inventory)
+	}
+
+	switch format {
+	case types.JSONFormat:
+		obj, err := json.MarshalIndent(inventory, "", "  ")
+		if err != nil {
+			return err
+		}
+		printer.Msgln(string(obj))
+	case types.YAMLFormat:
+		obj, err := yaml.Marshal(inventory)
+		if err != nil {
+			return err
+		}
+		printer.Msgln(string(obj))
+	default:
+		render.Tree(printer.MsgWriter(), buildInventoryTree(inventory))
+	}
+
+	return nil
+}
+
+func withoutDrift(inventory *ModuleInventory) *ModuleInventory {
+	copied := *inventory
+	copied.ManifestResources = []InventoryResource{}
+	for _, resource := range inventory.ManifestResources {
+		resource.Drift = nil
+		copied.ManifestResources = append(copied.ManifestResources, resource)
+	}
+
+	return &copied
+}
+
+func buildInventoryTree(inventory *ModuleInventory) render.TreeNode {
+	root := render.TreeNode{
+		Text: fmt.Sprintf("%s (%s %s)", inventory.Name, moduleKind(inventory.CommunityModule), valueOrNone(inventory.Version)),
+	}
+
+	manifestNode := render.TreeNode{Text: fmt.Sprintf("Manifest resources (%d)", len(inventory.ManifestResources))}
+	for _, resource := range inventory.ManifestResources {
+		node := render.TreeNode{Text: fmt.Sprintf("%s [%s]", describeInventoryResource(resource), resource.Status)}
+		if resource.Error != "" {
+			node.Children = append(node.Children, render.TreeNode{Text: fmt.Sprintf("error: %s", resource.Error)})
+		}
+		for _, drift := range resource.Drift {
+			node.Children = append(node.Children, render.TreeNode{Text: formatDrift(drift)})
+		}
+		manifestNode.Children = append(manifestNode.Children, node)
+	}
+
+	moduleCRsNode := render.TreeNode{Text: fmt.Sprintf("Module CRs and associated resources (%d)", len(inventory.ModuleCRs))}
+	for _, resource := range inventory.ModuleCRs {
+		moduleCRsNode.Children = append(moduleCRsNode.Children, render.TreeNode{Text: describeInventoryResource(resource)})
+	}
+
+	root.Children = append(root.Children, manifestNode, moduleCRsNode)
+
+	if !inventory.CommunityModule {
+		discoveredNode := render.TreeNode{Text: fmt.Sprintf("Discovered resources (%d)", len(inventory.DiscoveredResources))}
+		for _, resource := range inventory.DiscoveredResources {
+			discoveredNode.Children = append(discoveredNode.Children, render.TreeNode{
+				Text: fmt.Sprintf("%s (%s)", describeInventoryResource(resource), resource.Source),
+			})
+		}
+		root.Children = append(root.Children, discoveredNode)
+	}
+
+	if len(inventory.Warnings) > 0 {
+		warningsNode := render.TreeNode{Text: "Warnings"}
+		for _, warning := range inventory.Warnings {
+			warningsNode.Children = append(warningsNode.Children, render.TreeNode{Text: warning})
+		}
+		root.Children = append(root.Children, warningsNode)
+	}
+
+	return root
+}
+
+func describeInventoryResource(resource InventoryResource) string {
+	if resource.Namespace == "" {
+		return fmt.Sprintf("%s %s", resource.Kind, resource.Name)
+	}
+	return fmt.Sprintf("%s %s/%s", resource.Kind, resource.Namespace, resource.Name)
+}
+
+func formatDrift(drift FieldDrift) string {
+	if drift.Redacted {
+		return fmt.Sprintf("%s: changed", drift.Path)
+	}
+	return fmt.Sprintf("%s: expected %s, actual %s", drift.Path, formatDriftValue(drift.Expected), formatDriftValue(drift.Actual))
+}
+
+func formatDriftValue(value any) string {
+	if value == nil {
+		return "<none>"
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
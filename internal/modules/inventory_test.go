@@ -0,0 +1,374 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
+	"github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	modulesfake "github.com/kyma-project/cli.v3/internal/modules/fake"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgo_fake "k8s.io/client-go/discovery/fake"
+	dynamic_fake "k8s.io/client-go/dynamic/fake"
+	clientgo_testing "k8s.io/client-go/testing"
+)
+
+func TestInventoryModule(t *testing.T) {
+	t.Run("collects manifest resources, module CRs and discovered resources", func(t *testing.T) {
+		client := fixInventoryClient(
+			fixInventoryObject("apps/v1", "Deployment", "sample-manager", "kyma-system", "deployment-uid", "", map[string]any{"replicas": int64(2)}),
+			fixInventoryObject("apps/v1", "ReplicaSet", "sample-manager-abc", "kyma-system", "replicaset-uid", "deployment-uid", nil),
+			fixInventoryObject("v1", "Pod", "sample-manager-abc-xyz", "kyma-system", "pod-uid", "replicaset-uid", nil),
+			fixInventoryObject("v1", "Pod", "other", "default", "other-uid", "", nil),
+			fixInventoryObject("v1", "Secret", "sample-webhook-cert", "kyma-system", "secret-uid", "", nil, map[string]any{moduleLabel: "sample"}),
+			fixInventoryObject("operator.kyma-project.io/v1alpha1", "Sample", "default", "kyma-system", "sample-uid", "", nil),
+			fixInventoryObject("v1", "ConfigMap", "sample-config", "kyma-system", "config-uid", "sample-uid", nil),
+		)
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnResources: []map[string]any{
+				// resources are parsed from yaml so numbers are ints
+				{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": map[string]any{"name": "sample-manager", "namespace": "kyma-system"}, "spec": map[string]any{"replicas": 1}},
+				{"apiVersion": "v1", "kind": "ServiceAccount", "metadata": map[string]any{"name": "sample-manager", "namespace": "kyma-system"}},
+			},
+		}
+
+		inventory, err := InventoryModule(context.Background(), client, repo, "sample")
+
+		require.NoError(t, err)
+		require.Equal(t, &ModuleInventory{
+			Name:    "sample",
+			Version: "1.0.0",
+			ManifestResources: []InventoryResource{
+				{
+					APIVersion: "apps/v1", Kind: "Deployment", Name: "sample-manager", Namespace: "kyma-system", Status: ResourceDrifted,
+					Drift: []FieldDrift{{Path: "spec.replicas", Expected: float64(1), Actual: float64(2)}},
+				},
+				{APIVersion: "v1", Kind: "ServiceAccount", Name: "sample-manager", Namespace: "kyma-system", Status: ResourceMissing},
+			},
+			ModuleCRs: []InventoryResource{
+				{APIVersion: "operator.kyma-project.io/v1alpha1", Kind: "Sample", Name: "default", Namespace: "kyma-system"},
+			},
+			DiscoveredResources: []InventoryResource{
+				{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "sample-manager-abc", Namespace: "kyma-system", Source: "owned by Deployment kyma-system/sample-manager"},
+				{APIVersion: "v1", Kind: "Pod", Name: "sample-manager-abc-xyz", Namespace: "kyma-system", Source: "owned by ReplicaSet kyma-system/sample-manager-abc"},
+				{APIVersion: "v1", Kind: "ConfigMap", Name: "sample-config", Namespace: "kyma-system", Source: "owned by Sample kyma-system/default"},
+				{APIVersion: "v1", Kind: "Secret", Name: "sample-webhook-cert", Namespace: "kyma-system", Source: "label kyma-project.io/module=sample"},
+			},
+		}, inventory)
+	})
+
+	t.Run("module not installed", func(t *testing.T) {
+		client := fixInventoryClient()
+
+		inventory, err := InventoryModule(context.Background(), client, &modulesfake.ModuleTemplatesRepo{}, "other")
+
+		require.EqualError(t, err, "module other is not installed")
+		require.Nil(t, inventory)
+	})
+}
+
+func TestInspectManifestResource(t *testing.T) {
+	resource := map[string]any{"apiVersion": "v1", "kind": "ServiceAccount", "metadata": map[string]any{"name": "sample-manager"}}
+
+	t.Run("resource not found", func(t *testing.T) {
+		client := &fake.KubeClient{
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnGetErr: apierrors.NewNotFound(schema.GroupResource{Resource: "serviceaccounts"}, "sample-manager"),
+			},
+		}
+
+		inventoryResource := inspectManifestResource(context.Background(), client, resource)
+
+		require.Equal(t, InventoryResource{APIVersion: "v1", Kind: "ServiceAccount", Name: "sample-manager", Status: ResourceMissing}, inventoryResource)
+	})
+
+	t.Run("resource can't be read", func(t *testing.T) {
+		client := &fake.KubeClient{
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnGetErr: apierrors.NewForbidden(schema.GroupResource{Resource: "serviceaccounts"}, "sample-manager", errors.New("test error")),
+			},
+		}
+
+		inventoryResource := inspectManifestResource(context.Background(), client, resource)
+
+		require.Equal(t, ResourceUnknown, inventoryResource.Status)
+		require.Contains(t, inventoryResource.Error, "test error")
+	})
+}
+
+func TestDiffManifest(t *testing.T) {
+	manifest := map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":   "sample-manager",
+			"labels": map[string]any{"app": "sample"},
+		},
+		"spec": map[string]any{
+			"replicas": 1,
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{"name": "manager", "image": "sample:1.0.0"},
+					},
+				},
+			},
+		},
+		"status": map[string]any{"readyReplicas": 1},
+	}
+
+	t.Run("ignores fields added by the cluster", func(t *testing.T) {
+		live := map[string]any{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]any{
+				"name":            "sample-manager",
+				"uid":             "uid",
+				"resourceVersion": "1",
+				"labels":          map[string]any{"app": "sample", "extra": "label"},
+			},
+			"spec": map[string]any{
+				"replicas":             int64(1),
+				"revisionHistoryLimit": int64(10),
+				"template": map[string]any{
+					"spec": map[string]any{
+						"containers": []any{
+							map[string]any{"name": "manager", "image": "sample:1.0.0", "imagePullPolicy": "IfNotPresent"},
+						},
+					},
+				},
+			},
+		}
+
+		require.Empty(t, diffManifest(manifest, live))
+	})
+
+	t.Run("finds changed fields", func(t *testing.T) {
+		live := map[string]any{
+			"metadata": map[string]any{
+				"name": "sample-manager",
+			},
+			"spec": map[string]any{
+				"replicas": int64(1),
+				"template": map[string]any{
+					"spec": map[string]any{
+						"containers": []any{
+							map[string]any{"name": "manager", "image": "sample:2.0.0"},
+							map[string]any{"name": "sidecar"},
+						},
+					},
+				},
+			},
+		}
+
+		require.Equal(t, []FieldDrift{
+			{Path: "metadata.labels", Expected: map[string]any{"app": "sample"}, Actual: nil},
+			{
+				Path:     "spec.template.spec.containers",
+				Expected: []any{map[string]any{"name": "manager", "image": "sample:1.0.0"}},
+				Actual:   []any{map[string]any{"name": "manager", "image": "sample:2.0.0"}, map[string]any{"name": "sidecar"}},
+			},
+		}, diffManifest(manifest, live))
+	})
+}
+
+func TestDiffManifest_Secret(t *testing.T) {
+	manifest := map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "sample-credentials"},
+		"data":       map[string]any{"password": "c2VjcmV0", "user": "YWRtaW4="},
+		"type":       "Opaque",
+	}
+	live := map[string]any{
+		"metadata": map[string]any{"name": "sample-credentials"},
+		"data":     map[string]any{"password": "Y2hhbmdlZA==", "user": "YWRtaW4="},
+		"type":     "kubernetes.io/basic-auth",
+	}
+
+	require.Equal(t, []FieldDrift{
+		{Path: "data.password", Redacted: true},
+		{Path: "type", Expected: "Opaque", Actual: "kubernetes.io/basic-auth"},
+	}, diffManifest(manifest, live))
+}
+
+func Test_renderModuleInventory(t *testing.T) {
+	inventory := &ModuleInventory{
+		Name:    "sample",
+		Version: "1.0.0",
+		ManifestResources: []InventoryResource{
+			{
+				APIVersion: "apps/v1", Kind: "Deployment", Name: "sample-manager", Namespace: "kyma-system", Status: ResourceDrifted,
+				Drift: []FieldDrift{{Path: "spec.replicas", Expected: float64(1), Actual: float64(2)}},
+			},
+			{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "sample-manager", Status: ResourceExists},
+		},
+		ModuleCRs: []InventoryResource{
+			{APIVersion: "operator.kyma-project.io/v1alpha1", Kind: "Sample", Name: "default", Namespace: "kyma-system"},
+		},
+		DiscoveredResources: []InventoryResource{
+			{APIVersion: "v1", Kind: "Secret", Name: "sample-webhook-cert", Namespace: "kyma-system", Source: "label kyma-project.io/module=sample"},
+		},
+	}
+
+	t.Run("render tree with drift", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+
+		err := renderModuleInventory(out.NewToWriter(buffer), inventory, types.DefaultFormat, true)
+
+		require.NoError(t, err)
+		require.Equal(t, `sample (core 1.0.0)
+├── Manifest resources (2)
+│   ├── Deployment kyma-system/sample-manager [Drifted]
+│   │   └── spec.replicas: expected 1, actual 2
+│   └── ClusterRole sample-manager [Exists]
+├── Module CRs and associated resources (1)
+│   └── Sample kyma-system/default
+└── Discovered resources (1)
+    └── Secret kyma-system/sample-webhook-cert (label kyma-project.io/module=sample)
+`, buffer.String())
+	})
+
+	t.Run("render tree with redacted drift and errors", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		inventory := &ModuleInventory{
+			Name:    "sample",
+			Version: "1.0.0",
+			ManifestResources: []InventoryResource{
+				{
+					APIVersion: "v1", Kind: "Secret", Name: "sample-credentials", Namespace: "kyma-system", Status: ResourceDrifted,
+					Drift: []FieldDrift{{Path: "data.password", Redacted: true}},
+				},
+				{APIVersion: "v1", Kind: "ServiceAccount", Name: "sample-manager", Status: ResourceUnknown, Error: "forbidden"},
+			},
+			CommunityModule: true,
+		}
+
+		err := renderModuleInventory(out.NewToWriter(buffer), inventory, types.DefaultFormat, true)
+
+		require.NoError(t, err)
+		require.Equal(t, `sample (community 1.0.0)
+├── Manifest resources (2)
+│   ├── Secret kyma-system/sample-credentials [Drifted]
+│   │   └── data.password: changed
+│   └── ServiceAccount sample-manager [Unknown]
+│       └── error: forbidden
+└── Module CRs and associated resources (0)
+`, buffer.String())
+	})
+
+	t.Run("render json without drift", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+
+		err := renderModuleInventory(out.NewToWriter(buffer), inventory, types.JSONFormat, false)
+
+		require.NoError(t, err)
+		require.NotContains(t, buffer.String(), "spec.replicas")
+		require.Contains(t, buffer.String(), `"status": "Drifted"`)
+		require.Len(t, inventory.ManifestResources[0].Drift, 1)
+	})
+}
+
+func fixInventoryClient(objs ...runtime.Object) *fake.KubeClient {
+	moduleTemplate := kyma.ModuleTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-1.0.0", Namespace: "kyma-system"},
+		Spec: kyma.ModuleTemplateSpec{
+			ModuleName: "sample",
+			Version:    "1.0.0",
+			Data: unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "operator.kyma-project.io/v1alpha1",
+				"kind":       "Sample",
+				"metadata":   map[string]any{"name": "default", "namespace": "kyma-system"},
+			}},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	dynamicClient := dynamic_fake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		{Group: "apps", Version: "v1", Resource: "deployments"}:                       "DeploymentList",
+		{Group: "apps", Version: "v1", Resource: "replicasets"}:                       "ReplicaSetList",
+		{Version: "v1", Resource: "pods"}:                                             "PodList",
+		{Version: "v1", Resource: "secrets"}:                                          "SecretList",
+		{Version: "v1", Resource: "configmaps"}:                                       "ConfigMapList",
+		{Version: "v1", Resource: "serviceaccounts"}:                                  "ServiceAccountList",
+		{Group: "operator.kyma-project.io", Version: "v1alpha1", Resource: "samples"}: "SampleList",
+	}, objs...)
+
+	rootlessDynamic := rootlessdynamic.NewClient(dynamicClient, &clientgo_fake.FakeDiscovery{
+		Fake: &clientgo_testing.Fake{
+			Resources: []*metav1.APIResourceList{
+				{
+					GroupVersion: "apps/v1",
+					APIResources: []metav1.APIResource{
+						{Kind: "Deployment", Name: "deployments", Namespaced: true},
+						{Kind: "ReplicaSet", Name: "replicasets", Namespaced: true},
+					},
+				},
+				{
+					GroupVersion: "v1",
+					APIResources: []metav1.APIResource{
+						{Kind: "Pod", Name: "pods", Namespaced: true},
+						{Kind: "Secret", Name: "secrets", Namespaced: true},
+						{Kind: "ConfigMap", Name: "configmaps", Namespaced: true},
+						{Kind: "ServiceAccount", Name: "serviceaccounts", Namespaced: true},
+					},
+				},
+				{
+					GroupVersion: "operator.kyma-project.io/v1alpha1",
+					APIResources: []metav1.APIResource{
+						{Kind: "Sample", Name: "samples", Namespaced: true},
+					},
+				},
+			},
+		},
+	})
+
+	return &fake.KubeClient{
+		TestRootlessDynamicInterface: rootlessDynamic,
+		TestKymaInterface: &fake.KymaClient{
+			ReturnDefaultKyma: kyma.Kyma{
+				Status: kyma.KymaStatus{
+					Modules: []kyma.ModuleStatus{{Name: "sample", Version: "1.0.0"}},
+				},
+			},
+			ReturnModuleTemplateList: kyma.ModuleTemplateList{
+				Items: []kyma.ModuleTemplate{moduleTemplate},
+			},
+		},
+	}
+}
+
+func fixInventoryObject(apiVersion, kind, name, namespace, uid, ownerUID string, spec map[string]any, labels ...map[string]any) *unstructured.Unstructured {
+	metadata := map[string]any{
+		"name":      name,
+		"namespace": namespace,
+		"uid":       uid,
+	}
+	if ownerUID != "" {
+		metadata["ownerReferences"] = []any{map[string]any{"uid": ownerUID}}
+	}
+	if len(labels) > 0 {
+		metadata["labels"] = labels[0]
+	}
+
+	obj := map[string]any{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   metadata,
+	}
+	if spec != nil {
+		obj["spec"] = spec
+	}
+
+	return &unstructured.Unstructured{Object: obj}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/kyma-project/cli.v3/internal/kube"
 	"github.com/kyma-project/cli.v3/internal/kube/kyma"
 	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/kyma-project/cli.v3/internal/kube/snapshot"
+	"github.com/kyma-project/cli.v3/internal/modules/integrity"
 	"github.com/kyma-project/cli.v3/internal/modules/repo"
 	"github.com/kyma-project/cli.v3/internal/out"
 	"github.com/pkg/errors"
@@ -51,13 +53,22 @@ type ModuleVersion struct {
 	Repository string
 	Version    string
 	Channels   []string
+	// Verification is the result of the integrity check of community module resources
+	Verification integrity.Status
 }
 
 type ModulesList []Module
 
+// listWorkers limits the number of modules processed concurrently to not overload the API server
+const listWorkers = 8
+
 // ListInstalled returns list of installed module on a cluster
 // collects info about modules based on the KymaCR
+// ModuleTemplates, ModuleReleaseMetas, and module CRs are read once and shared by all modules,
+// pass the repo created with the snapshot.NewClient client to share them with the repo too
 func ListInstalled(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, showErrors bool) (ModulesList, error) {
+	client = snapshot.NewClient(client)
+
 	installedCoreModules, err := listCoreInstalled(ctx, client, repo, showErrors)
 	if err != nil {
 		return nil, err
@@ -99,40 +110,33 @@ func collectModulesFromKymaCR(ctx context.Context, client kube.Client, defaultKy
 	modulesList := ModulesList{}
 	modulesLen := len(defaultKyma.Status.Modules)
 	results := make(chan Module, modulesLen)
-	var wg sync.WaitGroup
-
-	for _, moduleStatus := range defaultKyma.Status.Modules {
-		wg.Add(1)
-		go func(ms kyma.ModuleStatus) {
-			defer wg.Done()
-			moduleSpec := getKymaModuleSpec(defaultKyma, ms.Name)
 
-			installationState, err := getModuleInstallationState(ctx, client, ms, moduleSpec)
-			if err != nil {
-				out.Debugfln("error occured during %s module installation status check: %v", ms.Name, err)
-			}
-
-			moduleCRState, err := getModuleCustomResourceStatus(ctx, client, ms, moduleSpec)
-			if err != nil {
-				out.Debugfln("error occured during %s custom resource status check: %v", ms.Name, err)
-			}
+	forEachConcurrently(defaultKyma.Status.Modules, func(ms kyma.ModuleStatus) {
+		moduleSpec := getKymaModuleSpec(defaultKyma, ms.Name)
 
-			results <- Module{
-				Name: ms.Name,
-				InstallDetails: ModuleInstallDetails{
-					Channel:              ms.Channel,
-					Managed:              getManaged(moduleSpec),
-					CustomResourcePolicy: getCustomResourcePolicy(moduleSpec),
-					Version:              ms.Version,
-					ModuleState:          moduleCRState,
-					InstallationState:    installationState,
-				},
-				Origin: OriginKyma,
-			}
-		}(moduleStatus)
-	}
+		installationState, err := getModuleInstallationState(ctx, client, ms, moduleSpec)
+		if err != nil {
+			out.Debugfln("error occured during %s module installation status check: %v", ms.Name, err)
+		}
 
-	wg.Wait()
+		moduleCRState, err := getModuleCustomResourceStatus(ctx, client, ms, moduleSpec)
+		if err != nil {
+			out.Debugfln("error occured during %s custom resource status check: %v", ms.Name, err)
+		}
+
+		results <- Module{
+			Name: ms.Name,
+			InstallDetails: ModuleInstallDetails{
+				Channel:              ms.Channel,
+				Managed:              getManaged(moduleSpec),
+				CustomResourcePolicy: getCustomResourcePolicy(moduleSpec),
+				Version:              ms.Version,
+				ModuleState:          moduleCRState,
+				InstallationState:    installationState,
+			},
+			Origin: OriginKyma,
+		}
+	})
 	close(results)
 
 	for m := range results {
@@ -153,53 +157,46 @@ func collectUnmanagedCoreModules(ctx context.Context, client kube.Client, repo r
 
 	modulesLen := len(coreModuleTemplates)
 	results := make(chan Module, modulesLen)
-	var wg sync.WaitGroup
 
-	for _, coreModuleTemplate := range coreModuleTemplates {
-		wg.Add(1)
-		go func(mt kyma.ModuleTemplate) {
-			defer wg.Done()
-			if mt.Spec.Version == "" || moduleExistsInKymaCR(mt, defaultKyma.Status.Modules) {
-				return
-			}
-
-			installedManager, err := repo.InstalledManager(ctx, mt)
-			if err != nil {
-				if showErrors {
-					out.Errfln("failed to get installed manager: %v", err)
-				}
-				return
-			}
-			if installedManager == nil {
-				return
-			}
+	forEachConcurrently(coreModuleTemplates, func(mt kyma.ModuleTemplate) {
+		if mt.Spec.Version == "" || moduleExistsInKymaCR(mt, defaultKyma.Status.Modules) {
+			return
+		}
 
-			moduleStatus := getModuleStatus(ctx, client, mt.Spec.Data)
-			version, err := getManagerVersion(installedManager)
-			if err != nil {
-				if showErrors {
-					out.Errfln("failed to get managers version: %v", err)
-				}
-				return
+		installedManager, err := repo.InstalledManager(ctx, mt)
+		if err != nil {
+			if showErrors {
+				out.Errfln("failed to get installed manager: %v", err)
 			}
+			return
+		}
+		if installedManager == nil {
+			return
+		}
 
-			results <- Module{
-				Name: mt.Spec.ModuleName,
-				InstallDetails: ModuleInstallDetails{
-					Channel:              "",
-					Managed:              ManagedFalse,
-					CustomResourcePolicy: "N/A",
-					Version:              version,
-					ModuleState:          moduleStatus,
-					InstallationState:    "Unmanaged",
-				},
-				Origin:          OriginKyma,
-				CommunityModule: true,
+		moduleStatus := getModuleStatus(ctx, client, mt.Spec.Data)
+		version, err := getManagerVersion(installedManager)
+		if err != nil {
+			if showErrors {
+				out.Errfln("failed to get managers version: %v", err)
 			}
-		}(coreModuleTemplate)
-	}
-
-	wg.Wait()
+			return
+		}
+
+		results <- Module{
+			Name: mt.Spec.ModuleName,
+			InstallDetails: ModuleInstallDetails{
+				Channel:              "",
+				Managed:              ManagedFalse,
+				CustomResourcePolicy: "N/A",
+				Version:              version,
+				ModuleState:          moduleStatus,
+				InstallationState:    "Unmanaged",
+			},
+			Origin:          OriginKyma,
+			CommunityModule: true,
+		}
+	})
 	close(results)
 
 	modulesList := ModulesList{}
@@ -220,6 +217,29 @@ func collectUnmanagedCoreModules(ctx context.Context, client kube.Client, repo r
 	return modulesList
 }
 
+// forEachConcurrently calls fn for every item with at most listWorkers goroutines
+func forEachConcurrently[T any](items []T, fn func(T)) {
+	jobs := make(chan T)
+	var wg sync.WaitGroup
+
+	for range min(listWorkers, len(items)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range jobs {
+				fn(item)
+			}
+		}()
+	}
+
+	for _, item := range items {
+		jobs <- item
+	}
+	close(jobs)
+
+	wg.Wait()
+}
+
 func moduleExistsInKymaCR(coreModuleTemplate kyma.ModuleTemplate, moduleStatuses []kyma.ModuleStatus) bool {
 	for _, moduleStatus := range moduleStatuses {
 		if moduleStatus.Name == coreModuleTemplate.Spec.ModuleName {
@@ -233,6 +253,16 @@ func moduleExistsInKymaCR(coreModuleTemplate kyma.ModuleTemplate, moduleStatuses
 // ListCatalog returns list of module catalog on a cluster
 // collects info about modules based on ModuleTemplates and ModuleReleaseMetas
 func ListCatalog(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository) (ModulesList, error) {
+	return listCatalog(ctx, client, repo, nil)
+}
+
+// ListVerifiedCatalog returns list of module catalog on a cluster
+// with results of the integrity check of every community module version
+func ListVerifiedCatalog(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, verifier *integrity.Verifier) (ModulesList, error) {
+	return listCatalog(ctx, client, repo, verifier)
+}
+
+func listCatalog(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, verifier *integrity.Verifier) (ModulesList, error) {
 	var coreModulesList ModulesList
 	var communityModulesList ModulesList
 	var externalModulesList ModulesList
@@ -246,13 +276,13 @@ func ListCatalog(ctx context.Context, client kube.Client, repo repo.ModuleTempla
 	}
 
 	if isModuleTemplateCRDInstalled(ctx, client) {
-		communityModulesList, err = listCommunityModulesCatalog(ctx, repo)
+		communityModulesList, err = listCommunityModulesCatalog(ctx, repo, verifier)
 		if err != nil {
 			return nil, fmt.Errorf("failed to list modules catalog: %v", err)
 		}
 	}
 
-	externalModulesList, err = listExternalModulesCatalog(ctx, repo)
+	externalModulesList, err = listExternalModulesCatalog(ctx, repo, verifier)
 	if err != nil {
 		out.Errfln("failed to list external modules catalog: %v", err)
 	}
@@ -313,7 +343,7 @@ func listCoreModulesCatalog(ctx context.Context, client kube.Client) (ModulesLis
 	return modulesList, nil
 }
 
-func listCommunityModulesCatalog(ctx context.Context, repo repo.ModuleTemplatesRepository) (ModulesList, error) {
+func listCommunityModulesCatalog(ctx context.Context, repo repo.ModuleTemplatesRepository, verifier *integrity.Verifier) (ModulesList, error) {
 	communityModules, err := repo.Community(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query community modules: %v", err)
@@ -324,8 +354,9 @@ func listCommunityModulesCatalog(ctx context.Context, repo repo.ModuleTemplatesR
 	for _, communityModule := range communityModules {
 		moduleName := communityModule.Spec.ModuleName
 		version := ModuleVersion{
-			Version:    communityModule.Spec.Version,
-			Repository: communityModule.Spec.Info.Repository,
+			Version:      communityModule.Spec.Version,
+			Repository:   communityModule.Spec.Info.Repository,
+			Verification: checkIntegrity(verifier, &communityModule),
 		}
 
 		if i := getModuleIndexWithOrigin(modulesList, moduleName, getModulesOrigin(&communityModule), true); i != -1 {
@@ -349,19 +380,25 @@ func getModulesOrigin(module *kyma.ModuleTemplate) string {
 	return module.Namespace + "/" + module.Name
 }
 
-func listExternalModulesCatalog(ctx context.Context, repo repo.ModuleTemplatesRepository) (ModulesList, error) {
+func listExternalModulesCatalog(ctx context.Context, repo repo.ModuleTemplatesRepository, verifier *integrity.Verifier) (ModulesList, error) {
 	externalModules, err := repo.ExternalCommunity(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query external modules catalog: %v", err)
 	}
 
+	if verifier != nil {
+		// remote catalogs can't link local files, so local links come only from local catalogs or mirrors of the user
+		verifier = verifier.WithLocalLinks()
+	}
+
 	modulesList := ModulesList{}
 
 	for _, communityModule := range externalModules {
 		moduleName := communityModule.Spec.ModuleName
 		version := ModuleVersion{
-			Version:    communityModule.Spec.Version,
-			Repository: communityModule.Spec.Info.Repository,
+			Version:      communityModule.Spec.Version,
+			Repository:   communityModule.Spec.Info.Repository,
+			Verification: checkIntegrity(verifier, &communityModule),
 		}
 
 		if i := getModuleIndex(modulesList, moduleName, true); i != -1 {
@@ -381,6 +418,15 @@ func listExternalModulesCatalog(ctx context.Context, repo repo.ModuleTemplatesRe
 	return modulesList, nil
 }
 
+// checkIntegrity returns empty status if verifier is not set
+func checkIntegrity(verifier *integrity.Verifier, moduleTemplate *kyma.ModuleTemplate) integrity.Status {
+	if verifier == nil {
+		return ""
+	}
+
+	return verifier.Check(moduleTemplate)
+}
+
 func isClusterManagedByKLM(ctx context.Context, client kube.Client) bool {
 	_, err := client.Kyma().GetDefaultKyma(ctx)
 	return err == nil
@@ -402,7 +448,7 @@ func ListAvailableVersions(ctx context.Context, client kube.Client, repo repo.Mo
 	if !isCommunity {
 		catalog, err = listCoreModulesCatalog(ctx, client)
 	} else {
-		catalog, err = listCommunityModulesCatalog(ctx, repo)
+		catalog, err = listCommunityModulesCatalog(ctx, repo, nil)
 	}
 
 	if err != nil {
@@ -428,50 +474,43 @@ func listCommunityInstalled(ctx context.Context, client kube.Client, repo repo.M
 
 	modulesLen := len(communityModuleTemplates)
 	results := make(chan Module, modulesLen)
-	var wg sync.WaitGroup
 
-	for _, moduleTemplate := range communityModuleTemplates {
-		wg.Add(1)
-		go func(mt kyma.ModuleTemplate) {
-			defer wg.Done()
-			if moduleAlreadyInstalledAsCoreModule(installedCoreModules, mt) {
-				return
-			}
-			installedManager, err := repo.InstalledManager(ctx, mt)
-			if err != nil {
-				out.Errfln("failed to get installed manager: %v", err)
-				return
-			}
-			if installedManager == nil {
-				// skip modules which moduletemplates exist but are not installed
-				return
-			}
-
-			moduleStatus := getModuleStatus(ctx, client, mt.Spec.Data)
-			installationStatus := getManagerStatus(installedManager)
-			version, err := getManagerVersion(installedManager)
-			if err != nil {
-				out.Errfln("failed to get managers version: %v", err)
-				return
-			}
-
-			results <- Module{
-				Name: mt.Spec.ModuleName,
-				InstallDetails: ModuleInstallDetails{
-					Channel:              "",
-					Managed:              ManagedFalse,
-					CustomResourcePolicy: "N/A",
-					Version:              version,
-					ModuleState:          moduleStatus,
-					InstallationState:    installationStatus,
-				},
-				Origin:          getModulesOrigin(&mt),
-				CommunityModule: true,
-			}
-		}(moduleTemplate)
-	}
+	forEachConcurrently(communityModuleTemplates, func(mt kyma.ModuleTemplate) {
+		if moduleAlreadyInstalledAsCoreModule(installedCoreModules, mt) {
+			return
+		}
+		installedManager, err := repo.InstalledManager(ctx, mt)
+		if err != nil {
+			out.Errfln("failed to get installed manager: %v", err)
+			return
+		}
+		if installedManager == nil {
+			// skip modules which moduletemplates exist but are not installed
+			return
+		}
 
-	wg.Wait()
+		moduleStatus := getModuleStatus(ctx, client, mt.Spec.Data)
+		installationStatus := getManagerStatus(installedManager)
+		version, err := getManagerVersion(installedManager)
+		if err != nil {
+			out.Errfln("failed to get managers version: %v", err)
+			return
+		}
+
+		results <- Module{
+			Name: mt.Spec.ModuleName,
+			InstallDetails: ModuleInstallDetails{
+				Channel:              "",
+				Managed:              ManagedFalse,
+				CustomResourcePolicy: "N/A",
+				Version:              version,
+				ModuleState:          moduleStatus,
+				InstallationState:    installationStatus,
+			},
+			Origin:          getModulesOrigin(&mt),
+			CommunityModule: true,
+		}
+	})
 	close(results)
 
 	communityModules := ModulesList{}
@@ -3,18 +3,22 @@ package modules
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/kyma-project/cli.v3/internal/kube"
 	"github.com/kyma-project/cli.v3/internal/kube/fake"
 	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
 	modulesfake "github.com/kyma-project/cli.v3/internal/modules/fake"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgo_fake "k8s.io/client-go/discovery/fake"
 	dynamic_fake "k8s.io/client-go/dynamic/fake"
+	clientgo_testing "k8s.io/client-go/testing"
 	"k8s.io/utils/ptr"
 )
 
@@ -805,3 +809,131 @@ func TestGetHighestState(t *testing.T) {
 		})
 	}
 }
+
+// BenchmarkListInstalled reports requests sent to the API server to list installed modules
+// the baseline collects modules from the Kyma CR without the snapshot client
+func BenchmarkListInstalled(b *testing.B) {
+	for _, modulesCount := range []int{10, 50, 100} {
+		b.Run(fmt.Sprintf("snapshot/modules=%d", modulesCount), func(b *testing.B) {
+			dynamicClient, client := fixBenchmarkClient(modulesCount)
+			b.ResetTimer()
+
+			for range b.N {
+				dynamicClient.ClearActions()
+				modules, err := ListInstalled(context.Background(), client, &modulesfake.ModuleTemplatesRepo{}, false)
+				require.NoError(b, err)
+				require.Len(b, modules, modulesCount)
+			}
+
+			b.ReportMetric(float64(len(dynamicClient.Actions())), "requests/op")
+		})
+
+		b.Run(fmt.Sprintf("baseline/modules=%d", modulesCount), func(b *testing.B) {
+			dynamicClient, client := fixBenchmarkClient(modulesCount)
+			defaultKyma, err := client.Kyma().GetDefaultKyma(context.Background())
+			require.NoError(b, err)
+			b.ResetTimer()
+
+			for range b.N {
+				dynamicClient.ClearActions()
+				modules := collectModulesFromKymaCR(context.Background(), client, defaultKyma)
+				require.Len(b, modules, modulesCount)
+			}
+
+			b.ReportMetric(float64(len(dynamicClient.Actions())), "requests/op")
+		})
+	}
+}
+
+// fixBenchmarkClient returns the client for the cluster with installed modules
+// every module has its own custom resource kind and the manager deployment
+func fixBenchmarkClient(modulesCount int) (*dynamic_fake.FakeDynamicClient, kube.Client) {
+	listKinds := map[schema.GroupVersionResource]string{
+		kyma.GVRModuleTemplate:    "ModuleTemplateList",
+		kyma.GVRModuleReleaseMeta: "ModuleReleaseMetaList",
+	}
+	apiResources := []metav1.APIResource{}
+	objs := []runtime.Object{}
+	kymaModules := []interface{}{}
+	kymaStatuses := []interface{}{}
+
+	for i := range modulesCount {
+		name := fmt.Sprintf("module%d", i)
+		kind := fmt.Sprintf("Module%d", i)
+		listKinds[schema.GroupVersionResource{Group: "operator.kyma-project.io", Version: "v1alpha1", Resource: name + "s"}] = kind + "List"
+		apiResources = append(apiResources, metav1.APIResource{Kind: kind, Name: name + "s", Namespaced: true})
+
+		objs = append(objs,
+			&unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "operator.kyma-project.io/v1beta2",
+				"kind":       "ModuleTemplate",
+				"metadata":   map[string]interface{}{"name": name + "-1.0.0", "namespace": "kyma-system"},
+				"spec": map[string]interface{}{
+					"moduleName": name,
+					"version":    "1.0.0",
+					"data": map[string]interface{}{
+						"apiVersion": "operator.kyma-project.io/v1alpha1",
+						"kind":       kind,
+					},
+					"manager": map[string]interface{}{
+						"group":     "apps",
+						"version":   "v1",
+						"kind":      "Deployment",
+						"name":      name + "-manager",
+						"namespace": "kyma-system",
+					},
+				},
+			}},
+			&unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "operator.kyma-project.io/v1alpha1",
+				"kind":       kind,
+				"metadata":   map[string]interface{}{"name": "default", "namespace": "kyma-system"},
+				"status":     map[string]interface{}{"state": "Ready"},
+			}},
+			&unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": name + "-manager", "namespace": "kyma-system"},
+				"status":     map[string]interface{}{"conditions": []interface{}{map[string]interface{}{"type": "Available", "status": "True"}}},
+			}},
+		)
+
+		kymaModules = append(kymaModules, map[string]interface{}{
+			"name":                 name,
+			"customResourcePolicy": "Ignore",
+		})
+		kymaStatuses = append(kymaStatuses, map[string]interface{}{
+			"name":    name,
+			"version": "1.0.0",
+			"state":   "Ready",
+			"template": map[string]interface{}{
+				"apiVersion": "operator.kyma-project.io/v1beta2",
+				"kind":       "ModuleTemplate",
+				"metadata":   map[string]interface{}{"name": name + "-1.0.0", "namespace": "kyma-system"},
+			},
+		})
+	}
+
+	objs = append(objs, &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "operator.kyma-project.io/v1beta2",
+		"kind":       "Kyma",
+		"metadata":   map[string]interface{}{"name": kyma.DefaultKymaName, "namespace": kyma.DefaultKymaNamespace},
+		"spec":       map[string]interface{}{"modules": kymaModules},
+		"status":     map[string]interface{}{"modules": kymaStatuses},
+	}})
+
+	dynamicClient := dynamic_fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objs...)
+	discovery := &clientgo_fake.FakeDiscovery{
+		Fake: &clientgo_testing.Fake{
+			Resources: []*metav1.APIResourceList{
+				{GroupVersion: "operator.kyma-project.io/v1alpha1", APIResources: apiResources},
+				{GroupVersion: "apps/v1", APIResources: []metav1.APIResource{{Kind: "Deployment", Name: "deployments", Namespaced: true}}},
+			},
+		},
+	}
+
+	return dynamicClient, &fake.KubeClient{
+		TestKymaInterface:            kyma.NewClient(dynamicClient),
+		TestRootlessDynamicInterface: rootlessdynamic.NewClient(dynamicClient, discovery),
+	}
+}
@@ -43,33 +43,43 @@ func ManageModuleInKymaCR(ctx context.Context, client kube.Client, moduleName, p
 }
 
 func ManageModuleMissingInKyma(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleName, policy string) error {
-	installedModuleTemplate, err := findInstalledModuleTemplate(ctx, client, repo, moduleName)
+	expectedChannel, err := getManagedModuleChannel(ctx, client, repo, moduleName)
 	if err != nil {
 		return err
 	}
 
+	clierr := Enable(ctx, client, repo, moduleName, expectedChannel, enableDefaultCr(policy), DefaultModuleCRTimeout, []unstructured.Unstructured{}...)
+	if clierr != nil {
+		return fmt.Errorf("failed to manage module: %v", clierr)
+	}
+
+	return nil
+}
+
+// getManagedModuleChannel returns the default Kyma CR channel if it contains version of the installed module
+func getManagedModuleChannel(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleName string) (string, error) {
+	installedModuleTemplate, err := findInstalledModuleTemplate(ctx, client, repo, moduleName)
+	if err != nil {
+		return "", err
+	}
+
 	moduleReleaseMetas, err := client.Kyma().ListModuleReleaseMeta(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get module release metas: %v", err)
+		return "", fmt.Errorf("failed to get module release metas: %v", err)
 	}
 
 	channelsAssignedToModuleVersion := getAssignedChannels(*moduleReleaseMetas, moduleName, installedModuleTemplate.Spec.Version)
 	kymaCR, err := client.Kyma().GetDefaultKyma(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to get kyma cr")
+		return "", fmt.Errorf("failed to get kyma cr")
 	}
 	expectedChannel := kymaCR.Spec.Channel
 
 	if !slices.Contains(channelsAssignedToModuleVersion, expectedChannel) {
-		return ErrModuleInstalledVersionNotInKymaChannel
-	}
-
-	clierr := Enable(ctx, client, repo, moduleName, expectedChannel, enableDefaultCr(policy), []unstructured.Unstructured{}...)
-	if clierr != nil {
-		return fmt.Errorf("failed to manage module: %v", clierr)
+		return "", ErrModuleInstalledVersionNotInKymaChannel
 	}
 
-	return nil
+	return expectedChannel, nil
 }
 
 func enableDefaultCr(policy string) bool {
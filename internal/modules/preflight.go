@@ -0,0 +1,426 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/diagnostics"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/out"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// annotations are read only by the CLI, see docs/contributor/module-template-annotations.md
+const (
+	// MinKubernetesVersionAnnotation contains the minimal version of the Kubernetes server the module works with
+	MinKubernetesVersionAnnotation = "cli.kyma-project.io/min-kubernetes-version"
+	// RequiredCRDsAnnotation contains comma-separated names of CRDs the module needs but doesn't install
+	RequiredCRDsAnnotation = "cli.kyma-project.io/required-crds"
+)
+
+const (
+	PreflightPass = "Pass"
+	PreflightWarn = "Warn"
+	PreflightFail = "Fail"
+)
+
+var crdGVR = schema.GroupVersionResource{
+	Group:    "apiextensions.k8s.io",
+	Version:  "v1",
+	Resource: "customresourcedefinitions",
+}
+
+// PreflightReport contains results of checks run before the module is added
+type PreflightReport struct {
+	Module string
+	Checks []PreflightCheck
+}
+
+type PreflightCheck struct {
+	Name    string
+	Status  string
+	Message string
+}
+
+// Status returns the worst status of all checks
+func (r *PreflightReport) Status() string {
+	status := PreflightPass
+	for _, check := range r.Checks {
+		if check.Status == PreflightFail {
+			return PreflightFail
+		}
+		if check.Status == PreflightWarn {
+			status = PreflightWarn
+		}
+	}
+
+	return status
+}
+
+// CoreModulePreflight runs preflight checks for the core module from the channel and prints the report
+// dependencies are names of modules that are added together with the module
+func CoreModulePreflight(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, channel string, dependencies ...string) clierror.Error {
+	moduleTemplate, err := findCoreModuleTemplate(ctx, client, module, channel)
+	if err != nil {
+		// skip because availability of the module is validated when it's enabled
+		out.Debugfln("skipping preflight checks: %v", err)
+		return nil
+	}
+
+	return runPreflight(out.Default, ctx, client, repo, moduleTemplate, dependencies...)
+}
+
+// CommunityModulePreflight runs preflight checks for the community module and prints the report
+func CommunityModulePreflight(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleTemplate *kyma.ModuleTemplate, dependencies ...string) clierror.Error {
+	return runPreflight(out.Default, ctx, client, repo, moduleTemplate, dependencies...)
+}
+
+func runPreflight(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleTemplate *kyma.ModuleTemplate, dependencies ...string) clierror.Error {
+	report := Preflight(ctx, client, repo, moduleTemplate, dependencies...)
+
+	printer.Msgfln("Preflight checks of the %s module:", report.Module)
+	for _, check := range report.Checks {
+		printer.Msgfln("  %-4s  %s: %s", strings.ToUpper(check.Status), check.Name, check.Message)
+	}
+
+	if report.Status() == PreflightFail {
+		return clierror.New(
+			fmt.Sprintf("the cluster doesn't meet requirements of the %s module", report.Module),
+			"free cluster resources or install missing APIs and try again",
+			"to skip preflight checks, use the --skip-preflight flag",
+		)
+	}
+
+	return nil
+}
+
+// Preflight estimates if the cluster can run the module described by the ModuleTemplate
+// it checks the Kubernetes server version, free capacity of nodes, and APIs used by the module resources
+// missing APIs are reported as warnings if there are dependencies that can provide them
+// the capacity check passes for modules already added to the Kyma CR because their workloads already run in the cluster
+func Preflight(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, moduleTemplate *kyma.ModuleTemplate, dependencies ...string) *PreflightReport {
+	report := &PreflightReport{
+		Module: moduleTemplate.Spec.ModuleName,
+	}
+
+	report.Checks = append(report.Checks, checkKubernetesVersion(client, moduleTemplate))
+
+	resources, err := repo.Resources(ctx, *moduleTemplate)
+	if err != nil {
+		out.Debugfln("failed to read resources of the %s module: %v", moduleTemplate.Spec.ModuleName, err)
+		report.Checks = append(report.Checks,
+			PreflightCheck{Name: "Capacity", Status: PreflightWarn, Message: "can't read module resources to estimate required capacity"},
+			PreflightCheck{Name: "APIs", Status: PreflightWarn, Message: "can't read module resources to check required APIs"},
+		)
+		return report
+	}
+
+	capacityCheck := PreflightCheck{
+		Name:    "Capacity",
+		Status:  PreflightPass,
+		Message: "the module is already added to the Kyma CR and its workloads already use the cluster capacity",
+	}
+	if !isModuleInKymaCR(ctx, client, moduleTemplate.Spec.ModuleName) {
+		nodes := diagnostics.NewNodeResourceInfoCollector(client).Run(ctx)
+		capacityCheck = checkCapacity(resources, nodes)
+	}
+
+	report.Checks = append(report.Checks,
+		capacityCheck,
+		checkAPIs(ctx, client, moduleTemplate, resources, dependencies),
+	)
+
+	return report
+}
+
+func findCoreModuleTemplate(ctx context.Context, client kube.Client, module, channel string) (*kyma.ModuleTemplate, error) {
+	if channel == "" {
+		defaultKyma, err := client.Kyma().GetDefaultKyma(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Kyma CR: %w", err)
+		}
+		channel = defaultKyma.Spec.Channel
+	}
+
+	return client.Kyma().GetModuleTemplateForModule(ctx, module, channel)
+}
+
+func checkKubernetesVersion(client kube.Client, moduleTemplate *kyma.ModuleTemplate) PreflightCheck {
+	check := PreflightCheck{Name: "Kubernetes version"}
+
+	minVersion := strings.TrimSpace(moduleTemplate.Annotations[MinKubernetesVersionAnnotation])
+	if minVersion == "" {
+		check.Status = PreflightPass
+		check.Message = "the module doesn't declare the minimal version"
+		return check
+	}
+
+	required, err := semver.NewVersion(minVersion)
+	if err != nil {
+		check.Status = PreflightWarn
+		check.Message = fmt.Sprintf("the module declares the invalid minimal version %s", minVersion)
+		return check
+	}
+
+	serverVersion, err := client.Static().Discovery().ServerVersion()
+	if err != nil {
+		check.Status = PreflightWarn
+		check.Message = fmt.Sprintf("can't get the server version: %v", err)
+		return check
+	}
+
+	current, err := semver.NewVersion(serverVersion.GitVersion)
+	if err != nil {
+		check.Status = PreflightWarn
+		check.Message = fmt.Sprintf("can't parse the server version %s", serverVersion.GitVersion)
+		return check
+	}
+
+	// ignore provider suffixes like -gke.100 that would make the version a pre-release
+	current = semver.New(current.Major(), current.Minor(), current.Patch(), "", "")
+	if current.LessThan(required) {
+		check.Status = PreflightFail
+		check.Message = fmt.Sprintf("the server version %s is lower than the required %s", serverVersion.GitVersion, minVersion)
+		return check
+	}
+
+	check.Status = PreflightPass
+	check.Message = fmt.Sprintf("the server version %s meets the required %s", serverVersion.GitVersion, minVersion)
+	return check
+}
+
+type podRequests struct {
+	cpu    resource.Quantity
+	memory resource.Quantity
+}
+
+// isModuleInKymaCR returns true if the module is already added to the default Kyma CR
+// free capacity of nodes already includes workloads of such modules so they can't be counted twice
+func isModuleInKymaCR(ctx context.Context, client kube.Client, module string) bool {
+	defaultKyma, err := client.Kyma().GetDefaultKyma(ctx)
+	if err != nil {
+		out.Debugfln("failed to get the default Kyma CR: %v", err)
+		return false
+	}
+
+	return slices.ContainsFunc(defaultKyma.Spec.Modules, func(m kyma.Module) bool {
+		return m.Name == module
+	})
+}
+
+func checkCapacity(resources []map[string]any, nodes []diagnostics.NodeResourceInfo) PreflightCheck {
+	check := PreflightCheck{Name: "Capacity"}
+
+	if len(nodes) == 0 {
+		check.Status = PreflightWarn
+		check.Message = "can't read free capacity of nodes"
+		return check
+	}
+
+	var requiredCPU, requiredMemory resource.Quantity
+	var largestPod podRequests
+	for _, res := range resources {
+		requests, replicas := getWorkloadRequests(res, len(nodes))
+		for range replicas {
+			requiredCPU.Add(requests.cpu)
+			requiredMemory.Add(requests.memory)
+		}
+		if requests.cpu.Cmp(largestPod.cpu) > 0 {
+			largestPod.cpu = requests.cpu
+		}
+		if requests.memory.Cmp(largestPod.memory) > 0 {
+			largestPod.memory = requests.memory
+		}
+	}
+
+	var availableCPU, availableMemory resource.Quantity
+	podFits := false
+	for _, node := range nodes {
+		nodeCPU := parseQuantity(node.Usage.CPUAvailable)
+		nodeMemory := parseQuantity(node.Usage.MemoryAvailable)
+		availableCPU.Add(nodeCPU)
+		availableMemory.Add(nodeMemory)
+
+		if nodeCPU.Cmp(largestPod.cpu) >= 0 && nodeMemory.Cmp(largestPod.memory) >= 0 {
+			podFits = true
+		}
+	}
+
+	summary := fmt.Sprintf("the module requests %s CPU and %s memory, nodes have %s CPU and %s memory available",
+		requiredCPU.String(), requiredMemory.String(), availableCPU.String(), availableMemory.String())
+
+	switch {
+	case requiredCPU.Cmp(availableCPU) > 0 || requiredMemory.Cmp(availableMemory) > 0:
+		check.Status = PreflightFail
+		check.Message = summary
+	case !podFits:
+		check.Status = PreflightWarn
+		check.Message = summary + ", but no node can fit the largest pod"
+	default:
+		check.Status = PreflightPass
+		check.Message = summary
+	}
+
+	return check
+}
+
+// workload contains fields of Pods and of resources with pod templates like Deployments or Jobs
+type workload struct {
+	Kind     string `json:"kind"`
+	Metadata struct {
+		Name string `json:"name"`
+	} `json:"metadata"`
+	Spec struct {
+		corev1.PodSpec `json:",inline"`
+		Replicas       *int64 `json:"replicas"`
+		Template       struct {
+			Spec corev1.PodSpec `json:"spec"`
+		} `json:"template"`
+	} `json:"spec"`
+}
+
+// getWorkloadRequests returns requests of one pod of the workload and the number of its pods
+func getWorkloadRequests(res map[string]any, nodesCount int) (podRequests, int64) {
+	// resources are parsed from yaml so they are converted using json to typed structs
+	data, err := json.Marshal(res)
+	if err != nil {
+		return podRequests{}, 0
+	}
+
+	w := workload{}
+	if err := json.Unmarshal(data, &w); err != nil {
+		out.Debugfln("failed to read the %s %s: %v", w.Kind, w.Metadata.Name, err)
+		return podRequests{}, 0
+	}
+
+	podSpec := w.Spec.Template.Spec
+	replicas := int64(1)
+	switch w.Kind {
+	case "Deployment", "StatefulSet", "ReplicaSet":
+		if w.Spec.Replicas != nil {
+			replicas = *w.Spec.Replicas
+		}
+	case "DaemonSet":
+		replicas = int64(nodesCount)
+	case "Job":
+	case "Pod":
+		podSpec = w.Spec.PodSpec
+	default:
+		return podRequests{}, 0
+	}
+
+	requests := podRequests{}
+	for _, container := range podSpec.Containers {
+		requests.cpu.Add(container.Resources.Requests[corev1.ResourceCPU])
+		requests.memory.Add(container.Resources.Requests[corev1.ResourceMemory])
+	}
+
+	return requests, replicas
+}
+
+func parseQuantity(value string) resource.Quantity {
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return resource.Quantity{}
+	}
+	return quantity
+}
+
+func checkAPIs(ctx context.Context, client kube.Client, moduleTemplate *kyma.ModuleTemplate, resources []map[string]any, dependencies []string) PreflightCheck {
+	check := PreflightCheck{Name: "APIs"}
+
+	// kinds defined by CRDs from the module resources are installed with the module
+	providedKinds := map[schema.GroupKind]bool{}
+	for _, res := range resources {
+		obj := unstructured.Unstructured{Object: res}
+		if obj.GetKind() != "CustomResourceDefinition" {
+			continue
+		}
+		group, _, _ := unstructured.NestedString(res, "spec", "group")
+		kind, _, _ := unstructured.NestedString(res, "spec", "names", "kind")
+		providedKinds[schema.GroupKind{Group: group, Kind: kind}] = true
+	}
+
+	missing := []string{}
+	for _, res := range resources {
+		obj := unstructured.Unstructured{Object: res}
+		gvk := obj.GroupVersionKind()
+		if gvk.Kind == "" || providedKinds[gvk.GroupKind()] {
+			continue
+		}
+
+		name := gvk.GroupVersion().String() + "/" + gvk.Kind
+		if slices.Contains(missing, name) {
+			continue
+		}
+
+		served, err := isKindServed(client, gvk)
+		if err != nil {
+			check.Status = PreflightWarn
+			check.Message = fmt.Sprintf("can't discover the %s API: %v", gvk.GroupVersion().String(), err)
+			return check
+		}
+		if !served {
+			missing = append(missing, name)
+		}
+	}
+
+	for _, crd := range parseModuleNames(moduleTemplate.Annotations[RequiredCRDsAnnotation]) {
+		_, err := client.Dynamic().Resource(crdGVR).Get(ctx, crd, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			missing = append(missing, crd)
+			continue
+		}
+		if err != nil {
+			check.Status = PreflightWarn
+			check.Message = fmt.Sprintf("can't get the %s CRD: %v", crd, err)
+			return check
+		}
+	}
+
+	if len(missing) > 0 && len(dependencies) > 0 {
+		check.Status = PreflightWarn
+		check.Message = fmt.Sprintf("missing APIs or CRDs: %s (they can be installed by the %s modules)", strings.Join(missing, ", "), strings.Join(dependencies, ", "))
+		return check
+	}
+
+	if len(missing) > 0 {
+		check.Status = PreflightFail
+		check.Message = fmt.Sprintf("missing APIs or CRDs: %s", strings.Join(missing, ", "))
+		return check
+	}
+
+	check.Status = PreflightPass
+	check.Message = "all APIs used by the module are available"
+	return check
+}
+
+func isKindServed(client kube.Client, gvk schema.GroupVersionKind) (bool, error) {
+	resourceList, err := client.Static().Discovery().ServerResourcesForGroupVersion(gvk.GroupVersion().String())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, apiResource := range resourceList.APIResources {
+		if apiResource.Kind == gvk.Kind {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,287 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/diagnostics"
+	"github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	modulesfake "github.com/kyma-project/cli.v3/internal/modules/fake"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	clientgo_fake "k8s.io/client-go/discovery/fake"
+	dynamic_fake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clientgo_testing "k8s.io/client-go/testing"
+)
+
+func TestPreflight(t *testing.T) {
+	t.Run("all checks pass", func(t *testing.T) {
+		client := fixPreflightClient("v1.30.2-gke.100", fixPreflightNode("node-1", "4", "8Gi"))
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnResources: []map[string]any{
+				fixPreflightDeployment(2, "500m", "1Gi"),
+				fixPreflightCRD(),
+				{"apiVersion": "operator.kyma-project.io/v1alpha1", "kind": "Sample", "metadata": map[string]any{"name": "default"}},
+			},
+		}
+		moduleTemplate := fixPreflightModuleTemplate("1.28.0")
+
+		report := Preflight(context.Background(), client, repo, &moduleTemplate)
+
+		require.Equal(t, PreflightPass, report.Status())
+		require.Equal(t, []PreflightCheck{
+			{Name: "Kubernetes version", Status: PreflightPass, Message: "the server version v1.30.2-gke.100 meets the required 1.28.0"},
+			{Name: "Capacity", Status: PreflightPass, Message: "the module requests 1 CPU and 2Gi memory, nodes have 4 CPU and 8Gi memory available"},
+			{Name: "APIs", Status: PreflightPass, Message: "all APIs used by the module are available"},
+		}, report.Checks)
+	})
+
+	t.Run("checks fail", func(t *testing.T) {
+		client := fixPreflightClient("v1.27.0", fixPreflightNode("node-1", "1", "1Gi"))
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnResources: []map[string]any{
+				fixPreflightDeployment(3, "500m", "1Gi"),
+				{"apiVersion": "networking.istio.io/v1", "kind": "VirtualService", "metadata": map[string]any{"name": "sample"}},
+			},
+		}
+		moduleTemplate := fixPreflightModuleTemplate("1.28.0")
+
+		report := Preflight(context.Background(), client, repo, &moduleTemplate)
+
+		require.Equal(t, PreflightFail, report.Status())
+		require.Equal(t, []PreflightCheck{
+			{Name: "Kubernetes version", Status: PreflightFail, Message: "the server version v1.27.0 is lower than the required 1.28.0"},
+			{Name: "Capacity", Status: PreflightFail, Message: "the module requests 1500m CPU and 3Gi memory, nodes have 1 CPU and 1Gi memory available"},
+			{Name: "APIs", Status: PreflightFail, Message: "missing APIs or CRDs: networking.istio.io/v1/VirtualService"},
+		}, report.Checks)
+	})
+
+	t.Run("warns about missing APIs that dependencies can install and pods that don't fit on nodes", func(t *testing.T) {
+		client := fixPreflightClient("v1.30.0", fixPreflightNode("node-1", "1", "2Gi"), fixPreflightNode("node-2", "1", "2Gi"))
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnResources: []map[string]any{
+				fixPreflightDeployment(1, "1500m", "1Gi"),
+				{"apiVersion": "networking.istio.io/v1", "kind": "VirtualService", "metadata": map[string]any{"name": "sample"}},
+			},
+		}
+		moduleTemplate := fixPreflightModuleTemplate("")
+
+		report := Preflight(context.Background(), client, repo, &moduleTemplate, "istio")
+
+		require.Equal(t, PreflightWarn, report.Status())
+		require.Equal(t, []PreflightCheck{
+			{Name: "Kubernetes version", Status: PreflightPass, Message: "the module doesn't declare the minimal version"},
+			{Name: "Capacity", Status: PreflightWarn, Message: "the module requests 1500m CPU and 1Gi memory, nodes have 2 CPU and 4Gi memory available, but no node can fit the largest pod"},
+			{Name: "APIs", Status: PreflightWarn, Message: "missing APIs or CRDs: networking.istio.io/v1/VirtualService (they can be installed by the istio modules)"},
+		}, report.Checks)
+	})
+
+	t.Run("warns when module resources can't be read", func(t *testing.T) {
+		client := fixPreflightClient("v1.30.0")
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ResourcesErr: errors.New("test error"),
+		}
+		moduleTemplate := fixPreflightModuleTemplate("")
+
+		report := Preflight(context.Background(), client, repo, &moduleTemplate)
+
+		require.Equal(t, PreflightWarn, report.Status())
+		require.Len(t, report.Checks, 3)
+		require.Equal(t, PreflightWarn, report.Checks[1].Status)
+		require.Equal(t, PreflightWarn, report.Checks[2].Status)
+	})
+}
+
+func TestPreflight_ModuleInKymaCR(t *testing.T) {
+	client := fixPreflightClient("v1.30.0", fixPreflightNode("node-1", "1", "1Gi"))
+	client.TestKymaInterface = &fake.KymaClient{
+		ReturnDefaultKyma: kyma.Kyma{
+			Spec: kyma.KymaSpec{
+				Modules: []kyma.Module{{Name: "sample"}},
+			},
+		},
+	}
+	repo := &modulesfake.ModuleTemplatesRepo{
+		ReturnResources: []map[string]any{
+			fixPreflightDeployment(3, "500m", "1Gi"),
+		},
+	}
+	moduleTemplate := fixPreflightModuleTemplate("")
+
+	report := Preflight(context.Background(), client, repo, &moduleTemplate)
+
+	require.Equal(t, PreflightPass, report.Status())
+	require.Equal(t, PreflightCheck{
+		Name:    "Capacity",
+		Status:  PreflightPass,
+		Message: "the module is already added to the Kyma CR and its workloads already use the cluster capacity",
+	}, report.Checks[1])
+}
+
+func TestCheckAPIs_RequiredCRDs(t *testing.T) {
+	moduleTemplate := fixPreflightModuleTemplate("")
+	moduleTemplate.Annotations[RequiredCRDsAnnotation] = "apirules.gateway.kyma-project.io"
+
+	t.Run("fails when required CRD is missing", func(t *testing.T) {
+		client := fixPreflightClient("v1.30.0")
+		client.TestDynamicInterface = dynamic_fake.NewSimpleDynamicClient(runtime.NewScheme())
+
+		check := checkAPIs(context.Background(), client, &moduleTemplate, nil, nil)
+
+		require.Equal(t, PreflightCheck{
+			Name:    "APIs",
+			Status:  PreflightFail,
+			Message: "missing APIs or CRDs: apirules.gateway.kyma-project.io",
+		}, check)
+	})
+
+	t.Run("warns when required CRD can't be read", func(t *testing.T) {
+		client := fixPreflightClient("v1.30.0")
+		dynamicClient := dynamic_fake.NewSimpleDynamicClient(runtime.NewScheme())
+		dynamicClient.PrependReactor("get", "customresourcedefinitions", func(_ clientgo_testing.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewForbidden(crdGVR.GroupResource(), "apirules.gateway.kyma-project.io", errors.New("test error"))
+		})
+		client.TestDynamicInterface = dynamicClient
+
+		check := checkAPIs(context.Background(), client, &moduleTemplate, nil, nil)
+
+		require.Equal(t, PreflightWarn, check.Status)
+		require.Contains(t, check.Message, "can't get the apirules.gateway.kyma-project.io CRD")
+	})
+}
+
+func TestCheckCapacity(t *testing.T) {
+	t.Run("counts daemon set pods on every node", func(t *testing.T) {
+		daemonSet := fixPreflightDeployment(1, "100m", "100Mi")
+		daemonSet["kind"] = "DaemonSet"
+		nodes := []diagnostics.NodeResourceInfo{
+			{Usage: diagnostics.Usage{CPUAvailable: "1", MemoryAvailable: "1Gi"}},
+			{Usage: diagnostics.Usage{CPUAvailable: "1", MemoryAvailable: "1Gi"}},
+			{Usage: diagnostics.Usage{CPUAvailable: "1", MemoryAvailable: "1Gi"}},
+		}
+
+		check := checkCapacity([]map[string]any{daemonSet}, nodes)
+
+		require.Equal(t, PreflightPass, check.Status)
+		require.Equal(t, "the module requests 300m CPU and 300Mi memory, nodes have 3 CPU and 3Gi memory available", check.Message)
+	})
+
+	t.Run("warns without nodes", func(t *testing.T) {
+		check := checkCapacity(nil, nil)
+
+		require.Equal(t, PreflightWarn, check.Status)
+	})
+}
+
+func Test_runPreflight(t *testing.T) {
+	t.Run("prints report and fails", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := fixPreflightClient("v1.27.0", fixPreflightNode("node-1", "4", "8Gi"))
+		repo := &modulesfake.ModuleTemplatesRepo{}
+		moduleTemplate := fixPreflightModuleTemplate("1.28.0")
+
+		clierr := runPreflight(out.NewToWriter(buffer), context.Background(), client, repo, &moduleTemplate)
+
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the cluster doesn't meet requirements of the sample module")
+		require.Contains(t, buffer.String(), "Preflight checks of the sample module:\n")
+		require.Contains(t, buffer.String(), "  FAIL  Kubernetes version: the server version v1.27.0 is lower than the required 1.28.0\n")
+	})
+}
+
+func fixPreflightClient(serverVersion string, nodes ...corev1.Node) *fake.KubeClient {
+	objs := []runtime.Object{}
+	for i := range nodes {
+		objs = append(objs, &nodes[i])
+	}
+
+	static := kubefake.NewClientset(objs...)
+	discovery := static.Discovery().(*clientgo_fake.FakeDiscovery)
+	discovery.FakedServerVersion = &version.Info{GitVersion: serverVersion}
+	discovery.Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment"}},
+		},
+		{
+			GroupVersion: "apiextensions.k8s.io/v1",
+			APIResources: []metav1.APIResource{{Name: "customresourcedefinitions", Kind: "CustomResourceDefinition"}},
+		},
+	}
+
+	return &fake.KubeClient{
+		TestKubernetesInterface: static,
+		TestKymaInterface:       &fake.KymaClient{},
+	}
+}
+
+func fixPreflightNode(name, cpu, memory string) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+		},
+	}
+}
+
+func fixPreflightModuleTemplate(minKubernetesVersion string) kyma.ModuleTemplate {
+	return kyma.ModuleTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				MinKubernetesVersionAnnotation: minKubernetesVersion,
+			},
+		},
+		Spec: kyma.ModuleTemplateSpec{
+			ModuleName: "sample",
+		},
+	}
+}
+
+// resources are parsed from yaml so numbers are ints
+func fixPreflightDeployment(replicas int, cpu, memory string) map[string]any {
+	return map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "sample-manager"},
+		"spec": map[string]any{
+			"replicas": replicas,
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{
+						map[string]any{
+							"name": "manager",
+							"resources": map[string]any{
+								"requests": map[string]any{"cpu": cpu, "memory": memory},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func fixPreflightCRD() map[string]any {
+	return map[string]any{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]any{"name": "samples.operator.kyma-project.io"},
+		"spec": map[string]any{
+			"group": "operator.kyma-project.io",
+			"names": map[string]any{"kind": "Sample"},
+		},
+	}
+}
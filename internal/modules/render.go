@@ -44,6 +44,18 @@ var (
 			}
 		},
 	}
+
+	VerifiedCatalogTableInfo = TableInfo{
+		Headers: []interface{}{"NAME", "AVAILABLE VERSIONS", "ORIGIN", "VERIFICATION"},
+		RowConverter: func(m Module) []interface{} {
+			return []interface{}{
+				m.Name,
+				convertVersions(m.Versions),
+				m.Origin,
+				convertVerification(m),
+			}
+		},
+	}
 )
 
 // Render uses standard output to print ModuleList in table view
@@ -58,6 +70,19 @@ func Render(modulesList ModulesList, tableInfo TableInfo, format types.Format) e
 	}
 }
 
+// RenderFleet uses standard output to print modules installed in multiple clusters
+// the table view is a matrix with modules as rows and kubeconfig contexts as columns
+func RenderFleet(fleet []ClusterModules, format types.Format) error {
+	switch format {
+	case types.JSONFormat:
+		return renderFleetJSON(out.Default, fleet)
+	case types.YAMLFormat:
+		return renderFleetYAML(out.Default, fleet)
+	default:
+		return renderFleetTable(out.Default, fleet)
+	}
+}
+
 func renderJSON(printer *out.Printer, modulesList ModulesList, tableInfo TableInfo) error {
 	obj, err := json.MarshalIndent(convertToOutputParameters(modulesList, tableInfo), "", "  ")
 	if err != nil {
@@ -87,6 +112,96 @@ func renderTable(printer *out.Printer, modulesList ModulesList, tableInfo TableI
 	return nil
 }
 
+func renderFleetJSON(printer *out.Printer, fleet []ClusterModules) error {
+	obj, err := json.MarshalIndent(convertFleetToOutputParameters(fleet), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	printer.Msgln(string(obj))
+	return nil
+}
+
+func renderFleetYAML(printer *out.Printer, fleet []ClusterModules) error {
+	obj, err := yaml.Marshal(convertFleetToOutputParameters(fleet))
+	if err != nil {
+		return err
+	}
+
+	printer.Msgln(string(obj))
+	return nil
+}
+
+func renderFleetTable(printer *out.Printer, fleet []ClusterModules) error {
+	headers := []interface{}{"NAME"}
+	for _, cluster := range fleet {
+		headers = append(headers, cluster.Context)
+	}
+	headers = append(headers, "VERSION DRIFT")
+
+	rows := [][]interface{}{}
+	for _, fleetModule := range FleetModules(fleet) {
+		row := []interface{}{fleetModule.Name}
+		for _, cluster := range fleet {
+			row = append(row, convertFleetCell(cluster, fleetModule))
+		}
+		rows = append(rows, append(row, fleetModule.HasVersionDrift()))
+	}
+
+	render.Table(printer, headers, rows)
+	return nil
+}
+
+// convert installation details into field in format 'version(channel) state', '-' if the module is not installed
+// or 'error' if modules can't be listed in the cluster
+func convertFleetCell(cluster ClusterModules, fleetModule FleetModule) string {
+	if cluster.Err != nil {
+		return "error"
+	}
+
+	details, ok := fleetModule.InstallDetails[cluster.Context]
+	if !ok {
+		return "-"
+	}
+
+	return fmt.Sprintf("%s %s", convertInstall(details), details.ModuleState)
+}
+
+func convertFleetToOutputParameters(fleet []ClusterModules) map[string]interface{} {
+	contexts := make([]map[string]interface{}, len(fleet))
+	for i, cluster := range fleet {
+		contexts[i] = map[string]interface{}{"name": cluster.Context}
+		if cluster.Err != nil {
+			contexts[i]["error"] = cluster.Err.Error()
+		}
+	}
+
+	fleetModules := FleetModules(fleet)
+	modules := make([]map[string]interface{}, len(fleetModules))
+	for i, fleetModule := range fleetModules {
+		clusters := map[string]interface{}{}
+		for kubeContext, details := range fleetModule.InstallDetails {
+			clusters[kubeContext] = map[string]interface{}{
+				"version":            details.Version,
+				"channel":            details.Channel,
+				"moduleStatus":       details.ModuleState,
+				"installationStatus": details.InstallationState,
+			}
+		}
+
+		modules[i] = map[string]interface{}{
+			"name":         fleetModule.Name,
+			"versionDrift": fleetModule.HasVersionDrift(),
+			"clusters":     clusters,
+		}
+	}
+
+	return map[string]interface{}{
+		"contexts": contexts,
+		"modules":  modules,
+	}
+}
+
 func convertToOutputParameters(modulesList ModulesList, tableInfo TableInfo) []map[string]interface{} {
 	result := make([]map[string]interface{}, len(modulesList))
 	for i, resource := range modulesList {
@@ -162,6 +277,20 @@ func convertVersions(versions []ModuleVersion) string {
 	return strings.Join(values, ", ")
 }
 
+func convertVerification(module Module) string {
+	if !module.CommunityModule {
+		// core modules are delivered by the lifecycle manager
+		return "-"
+	}
+
+	values := make([]string, 0, len(module.Versions))
+	for _, version := range module.Versions {
+		values = append(values, fmt.Sprintf("%s(%s)", version.Version, version.Verification))
+	}
+
+	return strings.Join(values, ", ")
+}
+
 func toCamelCase(s string) string {
 	words := strings.Fields(strings.ToLower(s))
 	if len(words) == 0 {
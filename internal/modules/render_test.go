@@ -5,6 +5,7 @@ import (
 	"io"
 	"testing"
 
+	"github.com/kyma-project/cli.v3/internal/modules/integrity"
 	"github.com/kyma-project/cli.v3/internal/out"
 	"github.com/stretchr/testify/require"
 )
@@ -137,6 +138,32 @@ func TestRender_renderJSON(t *testing.T) {
 	})
 }
 
+func TestRender_renderVerifiedCatalog(t *testing.T) {
+	buffer := bytes.NewBuffer([]byte{})
+	verifiedModules := []Module{
+		{
+			Name:     "keda",
+			Versions: []ModuleVersion{{Version: "0.1", Channels: []string{"regular"}}},
+			Origin:   "kyma",
+		},
+		{
+			Name: "cluster-ip",
+			Versions: []ModuleVersion{
+				{Version: "0.1.1", Verification: integrity.StatusVerified},
+				{Version: "0.1.2", Verification: integrity.StatusFailed},
+			},
+			CommunityModule: true,
+			Origin:          "community",
+		},
+	}
+
+	err := renderTable(out.NewToWriter(buffer), verifiedModules, VerifiedCatalogTableInfo)
+	require.NoError(t, err)
+	require.Equal(t, "NAME         AVAILABLE VERSIONS   ORIGIN      VERIFICATION                     \n"+
+		"keda         0.1(regular)         kyma        -                                \n"+
+		"cluster-ip   0.1.1, 0.1.2         community   0.1.1(verified), 0.1.2(failed)   \n", buffer.String())
+}
+
 func TestRender_renderYAML(t *testing.T) {
 	t.Run("render table from modules catalog", func(t *testing.T) {
 		buffer := bytes.NewBuffer([]byte{})
@@ -149,3 +176,52 @@ func TestRender_renderYAML(t *testing.T) {
 		require.Equal(t, testCatalogYAMLView, string(yamlViewBytes))
 	})
 }
+
+func TestRender_renderFleet(t *testing.T) {
+	t.Run("render fleet table", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+
+		err := renderFleetTable(out.NewToWriter(buffer), testFleet)
+		require.NoError(t, err)
+		require.Equal(t, testFleetTableView, buffer.String())
+	})
+
+	t.Run("render fleet json", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+
+		err := renderFleetJSON(out.NewToWriter(buffer), testFleet)
+		require.NoError(t, err)
+		require.JSONEq(t, testFleetJSONView, buffer.String())
+	})
+}
+
+const (
+	testFleetTableView = "NAME         DEV                    PROD                     STAGE   VERSION DRIFT   \n" +
+		"keda         1.1.0(fast) Ready      1.0.0(regular) Warning   error   true            \n" +
+		"serverless   1.0.0(regular) Ready   1.0.0(regular) Ready     error   false           \n"
+	testFleetJSONView = `{
+  "contexts": [
+    {"name": "dev"},
+    {"name": "prod"},
+    {"name": "stage", "error": "connection refused"}
+  ],
+  "modules": [
+    {
+      "name": "keda",
+      "versionDrift": true,
+      "clusters": {
+        "dev": {"version": "1.1.0", "channel": "fast", "moduleStatus": "Ready", "installationStatus": "Ready"},
+        "prod": {"version": "1.0.0", "channel": "regular", "moduleStatus": "Warning", "installationStatus": "Ready"}
+      }
+    },
+    {
+      "name": "serverless",
+      "versionDrift": false,
+      "clusters": {
+        "dev": {"version": "1.0.0", "channel": "regular", "moduleStatus": "Ready", "installationStatus": "Ready"},
+        "prod": {"version": "1.0.0", "channel": "regular", "moduleStatus": "Ready", "installationStatus": "Ready"}
+      }
+    }
+  ]
+}`
+)
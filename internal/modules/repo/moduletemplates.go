@@ -3,14 +3,13 @@ package repo
 import (
 	"context"
 	"fmt"
-	"io"
-	"net/http"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/kyma-project/cli.v3/internal/kube"
 	"github.com/kyma-project/cli.v3/internal/kube/kyma"
 	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/kyma-project/cli.v3/internal/modules/integrity"
 	"github.com/kyma-project/cli.v3/internal/out"
 	"gopkg.in/yaml.v3"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -35,19 +34,39 @@ type ModuleTemplatesRepository interface {
 type moduleTemplatesRepo struct {
 	client            kube.Client
 	remoteModulesRepo ModuleTemplatesRemoteRepository
+	// verifier reads module resources and checks their integrity before they are returned
+	verifier *integrity.Verifier
 }
 
 func NewModuleTemplatesRepoForTests(client kube.Client, remoteRepo ModuleTemplatesRemoteRepository) *moduleTemplatesRepo {
 	return &moduleTemplatesRepo{
 		client:            client,
 		remoteModulesRepo: remoteRepo,
+		verifier:          integrity.NewDigestVerifier(),
 	}
 }
 
+// NewModuleTemplatesRepo returns repo checking only digests of module resources
 func NewModuleTemplatesRepo(client kube.Client) *moduleTemplatesRepo {
+	return NewModuleTemplatesRepoWithVerifier(client, integrity.NewDigestVerifier())
+}
+
+// NewModuleTemplatesRepoWithVerifier returns repo checking module resources with the given verifier
+func NewModuleTemplatesRepoWithVerifier(client kube.Client, verifier *integrity.Verifier) *moduleTemplatesRepo {
 	return &moduleTemplatesRepo{
 		client:            client,
 		remoteModulesRepo: newModuleTemplatesRemoteRepo(),
+		verifier:          verifier,
+	}
+}
+
+// NewModuleTemplatesRepoWithSources returns repo reading external community modules from given catalog sources
+// and rewriting their resource links with mirrors
+func NewModuleTemplatesRepoWithSources(client kube.Client, urls []string, mirrors map[string]string) *moduleTemplatesRepo {
+	return &moduleTemplatesRepo{
+		client:            client,
+		remoteModulesRepo: newModuleTemplatesRemoteRepoWithSources(urls, mirrors),
+		verifier:          integrity.NewDigestVerifier(),
 	}
 }
 
@@ -182,12 +201,18 @@ func (r *moduleTemplatesRepo) Resources(ctx context.Context, moduleTemplate kyma
 		}
 	}
 
-	resourceYamls, err := getFileFromURL(resources.Link)
-	resourceYamlsArr := strings.Split(string(resourceYamls), "---")
+	resourceYamls, err := r.verifier.ReadResource(resources.Link)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch resource YAMLs from %s: %w", resources.Link, err)
 	}
 
+	// resources are verified before they can be applied by any caller
+	if err := r.verifier.Verify(resources, resourceYamls); err != nil {
+		return nil, fmt.Errorf("failed to verify resources of %s:%s - %w", moduleTemplate.Spec.ModuleName, moduleTemplate.Spec.Version, err)
+	}
+
+	resourceYamlsArr := strings.Split(string(resourceYamls), "---")
+
 	for _, yamlStr := range resourceYamlsArr {
 		if strings.TrimSpace(yamlStr) == "" {
 			continue
@@ -386,21 +411,6 @@ func getManagerFromResources(moduleTemplate kyma.ModuleTemplate, moduleResources
 	return nil, fmt.Errorf("manager not found in resources")
 }
 
-func getFileFromURL(url string) ([]byte, error) {
-	resp, err := http.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("failed to download resource from %s: %w", url, err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read resource body: %w", err)
-	}
-
-	return body, nil
-}
-
 func generateUnstruct(apiVersion, kind, name, namespace string) unstructured.Unstructured {
 	return unstructured.Unstructured{
 		Object: map[string]any{
@@ -2,15 +2,21 @@ package repo
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/kyma-project/cli.v3/internal/kube/fake"
 	"github.com/kyma-project/cli.v3/internal/kube/kyma"
 	modulesfake "github.com/kyma-project/cli.v3/internal/modules/fake"
+	"github.com/kyma-project/cli.v3/internal/modules/integrity"
+	"github.com/kyma-project/cli.v3/internal/modules/source"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -585,6 +591,61 @@ func TestModuleTemplatesRepo_RunningUserDefinedResourcesOfModule(t *testing.T) {
 	})
 }
 
+func TestModuleTemplatesRepo_Resources(t *testing.T) {
+	manifest := []byte("apiVersion: v1\nkind: Namespace\nmetadata:\n  name: test\n")
+	sum := sha256.Sum256(manifest)
+	manifestPath := filepath.Join(t.TempDir(), "manifest.yaml")
+	require.NoError(t, os.WriteFile(manifestPath, manifest, 0600))
+
+	fixModuleTemplate := func(resource kyma.Resource) kyma.ModuleTemplate {
+		resource.Name = "rawManifest"
+		resource.Link = manifestPath
+		return kyma.ModuleTemplate{
+			Spec: kyma.ModuleTemplateSpec{
+				ModuleName: "test-module",
+				Version:    "0.1.0",
+				Resources:  []kyma.Resource{resource},
+			},
+		}
+	}
+
+	t.Run("returns resources with matching digest", func(t *testing.T) {
+		repo := NewModuleTemplatesRepoWithVerifier(&fake.KubeClient{}, integrity.NewDigestVerifier().WithLocalLinks())
+
+		resources, err := repo.Resources(context.Background(), fixModuleTemplate(kyma.Resource{Digest: "sha256:" + hex.EncodeToString(sum[:])}))
+
+		require.NoError(t, err)
+		require.Len(t, resources, 1)
+	})
+
+	t.Run("rejects resources with wrong digest", func(t *testing.T) {
+		repo := NewModuleTemplatesRepoWithVerifier(&fake.KubeClient{}, integrity.NewDigestVerifier().WithLocalLinks())
+
+		resources, err := repo.Resources(context.Background(), fixModuleTemplate(kyma.Resource{Digest: "sha256:1234"}))
+
+		require.Nil(t, resources)
+		require.ErrorContains(t, err, "failed to verify resources of test-module:0.1.0")
+	})
+
+	t.Run("rejects unverified resources when verification is required", func(t *testing.T) {
+		repo := NewModuleTemplatesRepoWithVerifier(&fake.KubeClient{}, (&integrity.Verifier{RequireVerified: true}).WithLocalLinks())
+
+		resources, err := repo.Resources(context.Background(), fixModuleTemplate(kyma.Resource{}))
+
+		require.Nil(t, resources)
+		require.ErrorContains(t, err, "has neither digest nor signature")
+	})
+
+	t.Run("rejects local links of module templates from the cluster", func(t *testing.T) {
+		repo := NewModuleTemplatesRepo(&fake.KubeClient{})
+
+		resources, err := repo.Resources(context.Background(), fixModuleTemplate(kyma.Resource{Digest: "sha256:" + hex.EncodeToString(sum[:])}))
+
+		require.Nil(t, resources)
+		require.ErrorIs(t, err, source.ErrLocalLink)
+	})
+}
+
 func TestModuleTemplatesRepo_DeleteResourceReturnWatcher(t *testing.T) {
 	t.Run("fails to watch resource", func(t *testing.T) {
 		fakeRootlessDynamicClient := fake.RootlessDynamicClient{
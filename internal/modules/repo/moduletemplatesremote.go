@@ -1,10 +1,10 @@
 package repo
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modules/source"
 )
 
 const (
@@ -16,18 +16,23 @@ type ModuleTemplatesRemoteRepository interface {
 }
 
 type moduleTemplateRemoteRepo struct {
-	url string
+	urls    []string
+	mirrors map[string]string
 }
 
 func (m *moduleTemplateRemoteRepo) Community() ([]kyma.ModuleTemplate, error) {
-	moduleTemplatesDefinitions, err := getFileFromURL(m.url)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get community modules definitions: %v", err)
+	result := []kyma.ModuleTemplate{}
+	for _, url := range m.urls {
+		moduleTemplates, err := source.ReadModuleTemplates(url)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get community modules definitions: %v", err)
+		}
+
+		result = append(result, moduleTemplates...)
 	}
 
-	var result []kyma.ModuleTemplate
-	if err := json.Unmarshal(moduleTemplatesDefinitions, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal module template: %w", err)
+	for i := range result {
+		source.RewriteResourceLinks(&result[i], m.mirrors)
 	}
 
 	return result, nil
@@ -35,12 +40,23 @@ func (m *moduleTemplateRemoteRepo) Community() ([]kyma.ModuleTemplate, error) {
 
 func newModuleTemplatesRemoteRepo() *moduleTemplateRemoteRepo {
 	return &moduleTemplateRemoteRepo{
-		url: ALL_COMMUNITY_MODULES_URL,
+		urls: []string{ALL_COMMUNITY_MODULES_URL},
 	}
 }
 
 func newModuleTemplatesRemoteRepoWithURL(url string) *moduleTemplateRemoteRepo {
 	return &moduleTemplateRemoteRepo{
-		url: url,
+		urls: []string{url},
+	}
+}
+
+func newModuleTemplatesRemoteRepoWithSources(urls []string, mirrors map[string]string) *moduleTemplateRemoteRepo {
+	if len(urls) == 0 {
+		urls = []string{ALL_COMMUNITY_MODULES_URL}
+	}
+
+	return &moduleTemplateRemoteRepo{
+		urls:    urls,
+		mirrors: mirrors,
 	}
 }
@@ -3,6 +3,8 @@ package repo
 import (
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/kyma-project/cli.v3/internal/kube/kyma"
@@ -110,3 +112,30 @@ func TestModuleTemplateRemoteRepo_Community(t *testing.T) {
 		})
 	}
 }
+
+func TestModuleTemplateRemoteRepo_CommunityWithSources(t *testing.T) {
+	catalog := `apiVersion: operator.kyma-project.io/v1beta2
+kind: ModuleTemplate
+metadata:
+  name: test-module-0.0.3
+spec:
+  moduleName: test-module
+  version: 0.0.3
+  resources:
+  - name: rawManifest
+    link: https://github.com/test/test-module/releases/download/0.0.3/test-module.yaml
+`
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "test-module.yaml"), []byte(catalog), 0600))
+
+	repo := newModuleTemplatesRemoteRepoWithSources(
+		[]string{"file://" + dir},
+		map[string]string{"https://github.com/test/": "file:///mirror/test/"},
+	)
+	result, err := repo.Community()
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "test-module", result[0].Spec.ModuleName)
+	require.Equal(t, "file:///mirror/test/test-module/releases/download/0.0.3/test-module.yaml", result[0].Spec.Resources[0].Link)
+}
@@ -0,0 +1,226 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// getCRSchema returns the openAPIV3Schema of the CRD version that defines the given CR
+func getCRSchema(ctx context.Context, client kube.Client, cr *unstructured.Unstructured) (map[string]any, error) {
+	crdList, err := client.RootlessDynamic().List(ctx, &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+		},
+	}, &rootlessdynamic.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list CRDs")
+	}
+
+	gvk := cr.GroupVersionKind()
+	for _, crd := range crdList.Items {
+		group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "kind")
+		if group != gvk.Group || kind != gvk.Kind {
+			continue
+		}
+
+		return getCRDVersionSchema(crd, gvk)
+	}
+
+	return nil, errors.Errorf("CRD for %s not found", gvk.String())
+}
+
+func getCRDVersionSchema(crd unstructured.Unstructured, gvk schema.GroupVersionKind) (map[string]any, error) {
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, version := range versions {
+		versionMap, ok := version.(map[string]any)
+		if !ok || versionMap["name"] != gvk.Version {
+			continue
+		}
+
+		openAPISchema, found, err := unstructured.NestedMap(versionMap, "schema", "openAPIV3Schema")
+		if err != nil || !found {
+			return nil, errors.Errorf("CRD %s does not contain schema for version %s", crd.GetName(), gvk.Version)
+		}
+
+		return openAPISchema, nil
+	}
+
+	return nil, errors.Errorf("CRD %s does not serve version %s", crd.GetName(), gvk.Version)
+}
+
+// validateCRSpec validates the spec of the CR against the openAPIV3Schema from its CRD
+// returns list of all found violations
+func validateCRSpec(crSchema map[string]any, cr *unstructured.Unstructured) []string {
+	spec, ok := cr.Object["spec"]
+	if !ok {
+		return nil
+	}
+
+	specSchema, found, _ := unstructured.NestedMap(crSchema, "properties", "spec")
+	if !found {
+		return nil
+	}
+
+	return validateValue(specSchema, spec, ".spec")
+}
+
+func validateValue(valueSchema map[string]any, value any, path string) []string {
+	if value == nil {
+		return nil
+	}
+
+	if intOrString, _ := valueSchema["x-kubernetes-int-or-string"].(bool); intOrString {
+		if _, ok := value.(string); ok || isInteger(value) {
+			return nil
+		}
+		return []string{fmt.Sprintf("%s: expected integer or string, got %T", path, value)}
+	}
+
+	violations := []string{}
+	switch valueSchema["type"] {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected object, got %T", path, value)}
+		}
+		violations = append(violations, validateObject(valueSchema, obj, path)...)
+	case "array":
+		arr, ok := value.([]any)
+		if !ok {
+			return []string{fmt.Sprintf("%s: expected array, got %T", path, value)}
+		}
+		itemsSchema, _ := valueSchema["items"].(map[string]any)
+		for i, item := range arr {
+			if itemsSchema != nil {
+				violations = append(violations, validateValue(itemsSchema, item, fmt.Sprintf("%s[%d]", path, i))...)
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return []string{fmt.Sprintf("%s: expected string, got %T", path, value)}
+		}
+	case "integer":
+		if !isInteger(value) {
+			return []string{fmt.Sprintf("%s: expected integer, got %T", path, value)}
+		}
+	case "number":
+		if !isNumber(value) {
+			return []string{fmt.Sprintf("%s: expected number, got %T", path, value)}
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return []string{fmt.Sprintf("%s: expected boolean, got %T", path, value)}
+		}
+	}
+
+	if enum, ok := valueSchema["enum"].([]any); ok && !slices.ContainsFunc(enum, func(allowed any) bool {
+		return fmt.Sprint(allowed) == fmt.Sprint(value)
+	}) {
+		violations = append(violations, fmt.Sprintf("%s: unsupported value %v, allowed values: %v", path, value, enum))
+	}
+
+	return violations
+}
+
+func validateObject(objectSchema map[string]any, obj map[string]any, path string) []string {
+	violations := []string{}
+
+	required, _ := objectSchema["required"].([]any)
+	for _, field := range required {
+		if _, ok := obj[fmt.Sprint(field)]; !ok {
+			violations = append(violations, fmt.Sprintf("%s.%v: required field is missing", path, field))
+		}
+	}
+
+	properties, _ := objectSchema["properties"].(map[string]any)
+	additionalProperties, _ := objectSchema["additionalProperties"].(map[string]any)
+	preserveUnknownFields, _ := objectSchema["x-kubernetes-preserve-unknown-fields"].(bool)
+
+	for _, key := range sortedKeys(obj) {
+		fieldPath := fmt.Sprintf("%s.%s", path, key)
+		if propertySchema, ok := properties[key].(map[string]any); ok {
+			violations = append(violations, validateValue(propertySchema, obj[key], fieldPath)...)
+			continue
+		}
+		if additionalProperties != nil {
+			violations = append(violations, validateValue(additionalProperties, obj[key], fieldPath)...)
+			continue
+		}
+		if !preserveUnknownFields && properties != nil {
+			violations = append(violations, fmt.Sprintf("%s: unknown field", fieldPath))
+		}
+	}
+
+	return violations
+}
+
+// getPathSchema returns the schema of the field under the given path in format .spec.field[0].subfield
+func getPathSchema(crSchema map[string]any, path string) map[string]any {
+	current := crSchema
+	for _, field := range strings.Split(strings.TrimPrefix(path, "."), ".") {
+		name, isSliceElem := field, false
+		if index := strings.Index(field, "["); index >= 0 && strings.HasSuffix(field, "]") {
+			name, isSliceElem = field[:index], true
+		}
+
+		current = getPropertySchema(current, name)
+		if current != nil && isSliceElem {
+			current, _ = current["items"].(map[string]any)
+		}
+		if current == nil {
+			return nil
+		}
+	}
+
+	return current
+}
+
+func getPropertySchema(objectSchema map[string]any, name string) map[string]any {
+	properties, _ := objectSchema["properties"].(map[string]any)
+	if propertySchema, ok := properties[name].(map[string]any); ok {
+		return propertySchema
+	}
+
+	additionalProperties, _ := objectSchema["additionalProperties"].(map[string]any)
+	return additionalProperties
+}
+
+func isInteger(value any) bool {
+	switch v := value.(type) {
+	case int, int32, int64:
+		return true
+	case float64:
+		return v == math.Trunc(v)
+	default:
+		return false
+	}
+}
+
+func isNumber(value any) bool {
+	switch value.(type) {
+	case int, int32, int64, float32, float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func sortedKeys(obj map[string]any) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
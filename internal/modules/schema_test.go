@@ -0,0 +1,176 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var (
+	testKedaSpecSchema = map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"logging": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"level": map[string]any{
+						"type": "string",
+						"enum": []any{"debug", "info", "error"},
+					},
+				},
+			},
+			"replicas": map[string]any{"type": "integer"},
+			"ratio":    map[string]any{"type": "number"},
+			"enabled":  map[string]any{"type": "boolean"},
+			"cpu":      map[string]any{"x-kubernetes-int-or-string": true},
+			"tolerations": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type":     "object",
+					"required": []any{"key"},
+					"properties": map[string]any{
+						"key": map[string]any{"type": "string"},
+					},
+				},
+			},
+			"labels": map[string]any{
+				"type":                 "object",
+				"additionalProperties": map[string]any{"type": "string"},
+			},
+			"extra": map[string]any{
+				"type":                                 "object",
+				"x-kubernetes-preserve-unknown-fields": true,
+			},
+		},
+	}
+	testKedaCRSchema = map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"spec": testKedaSpecSchema,
+		},
+	}
+	testKedaCRD = unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]any{"name": "kedas.test"},
+		"spec": map[string]any{
+			"group": "test",
+			"names": map[string]any{"kind": "Keda"},
+			"versions": []any{
+				map[string]any{
+					"name":   "v1",
+					"schema": map[string]any{"openAPIV3Schema": testKedaCRSchema},
+				},
+			},
+		},
+	}}
+)
+
+func TestGetCRSchema(t *testing.T) {
+	t.Run("get schema", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnListObjs: &unstructured.UnstructuredList{
+					Items: []unstructured.Unstructured{testKedaCRD},
+				},
+			},
+		}
+
+		crSchema, err := getCRSchema(context.Background(), &client, &testKedaCR)
+		require.NoError(t, err)
+		require.Equal(t, testKedaCRSchema, crSchema)
+	})
+
+	t.Run("CRD not found", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnListObjs: &unstructured.UnstructuredList{},
+			},
+		}
+
+		crSchema, err := getCRSchema(context.Background(), &client, &testKedaCR)
+		require.ErrorContains(t, err, "CRD for test/v1, Kind=Keda not found")
+		require.Nil(t, crSchema)
+	})
+
+	t.Run("version not served", func(t *testing.T) {
+		cr := testKedaCR.DeepCopy()
+		cr.SetAPIVersion("test/v2")
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnListObjs: &unstructured.UnstructuredList{
+					Items: []unstructured.Unstructured{testKedaCRD},
+				},
+			},
+		}
+
+		crSchema, err := getCRSchema(context.Background(), &client, cr)
+		require.ErrorContains(t, err, "CRD kedas.test does not serve version v2")
+		require.Nil(t, crSchema)
+	})
+
+	t.Run("list error", func(t *testing.T) {
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnErr: errors.New("test error"),
+			},
+		}
+
+		crSchema, err := getCRSchema(context.Background(), &client, &testKedaCR)
+		require.ErrorContains(t, err, "failed to list CRDs: test error")
+		require.Nil(t, crSchema)
+	})
+}
+
+func TestValidateCRSpec(t *testing.T) {
+	t.Run("valid spec", func(t *testing.T) {
+		cr := &unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{
+				"logging":     map[string]any{"level": "debug"},
+				"replicas":    int64(2),
+				"enabled":     true,
+				"cpu":         "500m",
+				"tolerations": []any{map[string]any{"key": "test"}},
+				"labels":      map[string]any{"app": "keda"},
+				"extra":       map[string]any{"anything": 1},
+			},
+		}}
+
+		require.Empty(t, validateCRSpec(testKedaCRSchema, cr))
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		cr := &unstructured.Unstructured{Object: map[string]any{
+			"spec": map[string]any{
+				"logging":     map[string]any{"level": "trace"},
+				"replicas":    "two",
+				"enabled":     "yes",
+				"cpu":         true,
+				"tolerations": []any{map[string]any{}},
+				"labels":      map[string]any{"app": 1},
+				"unknown":     "value",
+			},
+		}}
+
+		require.Equal(t, []string{
+			".spec.cpu: expected integer or string, got bool",
+			".spec.enabled: expected boolean, got string",
+			".spec.labels.app: expected string, got int",
+			".spec.logging.level: unsupported value trace, allowed values: [debug info error]",
+			".spec.replicas: expected integer, got string",
+			".spec.tolerations[0].key: required field is missing",
+			".spec.unknown: unknown field",
+		}, validateCRSpec(testKedaCRSchema, cr))
+	})
+}
+
+func TestGetPathSchema(t *testing.T) {
+	require.Equal(t, map[string]any{"type": "integer"}, getPathSchema(testKedaCRSchema, ".spec.replicas"))
+	require.Equal(t, map[string]any{"type": "string"}, getPathSchema(testKedaCRSchema, "spec.tolerations[0].key"))
+	require.Equal(t, map[string]any{"type": "string"}, getPathSchema(testKedaCRSchema, ".spec.labels.app"))
+	require.Nil(t, getPathSchema(testKedaCRSchema, ".spec.unknown.field"))
+}
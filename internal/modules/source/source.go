@@ -0,0 +1,321 @@
+package source
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	filePrefix = "file://"
+	ociPrefix  = "oci://"
+)
+
+// ErrLocalLink is returned when a resource link points to a local file that is not allowed to be read
+var ErrLocalLink = errors.New("local file links are allowed only in module templates from local catalogs")
+
+var (
+	moduleTemplatesFileExtensions = []string{".json", ".yaml", ".yml"}
+	// manifestMediaTypes are media types of OCI artifact layers containing module manifests
+	manifestMediaTypes = []types.MediaType{"application/yaml", "application/x-yaml", "text/yaml"}
+)
+
+// ReadFile returns content of the file from the http(s) URL, the file:// URL, the local path,
+// or the oci://registry/repository:tag reference to an artifact
+// the artifact must contain exactly one layer with the YAML manifest, other layers are ignored
+func ReadFile(location string) ([]byte, error) {
+	if isHTTP(location) {
+		return readHTTP(location)
+	}
+
+	if strings.HasPrefix(location, ociPrefix) {
+		reference := strings.TrimPrefix(location, ociPrefix)
+		layers, err := readOCIArtifact(reference, isManifestMediaType)
+		if err != nil {
+			return nil, err
+		}
+		if len(layers) != 1 {
+			return nil, fmt.Errorf("expected one manifest layer with media type %v in OCI artifact %s, found %d", manifestMediaTypes, reference, len(layers))
+		}
+		return layers[0], nil
+	}
+
+	data, err := os.ReadFile(localPath(location))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", location, err)
+	}
+
+	return data, nil
+}
+
+// ReadResource returns content of the file linked in resources of a module template
+// local files are read only if allowLocal is true, that is, when the module template comes from a local catalog
+// module templates from the cluster can be created by anyone with access to it and must not read files of the user
+func ReadResource(link string, allowLocal bool) ([]byte, error) {
+	if !allowLocal && !isRemote(link) {
+		return nil, fmt.Errorf("failed to read %s: %w", link, ErrLocalLink)
+	}
+
+	return ReadFile(link)
+}
+
+// ReadModuleTemplates returns module templates from the catalog source
+// the source can be the http(s) URL, the file:// URL or the local path to a file or a directory,
+// or the oci://registry/repository:tag reference to an artifact with catalog files as layers
+// every file can contain a JSON list of module templates, a single module template, or multiple YAML documents
+// module templates from remote catalogs can't link local files
+func ReadModuleTemplates(location string) ([]kyma.ModuleTemplate, error) {
+	files, err := readCatalogFiles(location)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleTemplates := []kyma.ModuleTemplate{}
+	for _, file := range files {
+		decoded, err := decodeModuleTemplates(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode module templates from %s: %w", location, err)
+		}
+
+		moduleTemplates = append(moduleTemplates, decoded...)
+	}
+
+	if isRemote(location) {
+		err = checkRemoteLinks(moduleTemplates)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read module templates from %s: %w", location, err)
+		}
+	}
+
+	return moduleTemplates, nil
+}
+
+// checkRemoteLinks returns error if any resource of module templates links a local file
+// otherwise, a remote catalog could make the CLI read any file of the user and apply it to the cluster
+// links rewritten to local mirrors are not affected because mirrors are applied after the catalog is read
+func checkRemoteLinks(moduleTemplates []kyma.ModuleTemplate) error {
+	for _, moduleTemplate := range moduleTemplates {
+		for _, resource := range moduleTemplate.Spec.Resources {
+			for _, link := range []string{resource.Link, resource.Signature} {
+				if link != "" && !isRemote(link) {
+					return fmt.Errorf("module template %s links the local file %s, only http(s) and oci:// links are allowed in remote catalogs", moduleTemplate.GetName(), link)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// RewriteResourceLinks replaces prefixes of resource links with mirrors
+// mirrors map original URL prefixes to prefixes of local mirrors, the longest matching prefix wins
+func RewriteResourceLinks(moduleTemplate *kyma.ModuleTemplate, mirrors map[string]string) {
+	if len(mirrors) == 0 {
+		return
+	}
+
+	prefixes := make([]string, 0, len(mirrors))
+	for prefix := range mirrors {
+		prefixes = append(prefixes, prefix)
+	}
+	// longer prefixes are more specific
+	slices.SortFunc(prefixes, func(a, b string) int {
+		return len(b) - len(a)
+	})
+
+	for i, resource := range moduleTemplate.Spec.Resources {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(resource.Link, prefix) {
+				moduleTemplate.Spec.Resources[i].Link = mirrors[prefix] + strings.TrimPrefix(resource.Link, prefix)
+				break
+			}
+		}
+	}
+}
+
+func readCatalogFiles(location string) ([][]byte, error) {
+	if strings.HasPrefix(location, ociPrefix) {
+		// catalog files are stored in all layers of the artifact
+		return readOCIArtifact(strings.TrimPrefix(location, ociPrefix), func(types.MediaType) bool { return true })
+	}
+
+	if isHTTP(location) {
+		data, err := readHTTP(location)
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{data}, nil
+	}
+
+	path := localPath(location)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog %s: %w", location, err)
+	}
+
+	if !info.IsDir() {
+		data, err := ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{data}, nil
+	}
+
+	return readCatalogDir(path)
+}
+
+func readCatalogDir(dir string) ([][]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog directory %s: %w", dir, err)
+	}
+
+	files := [][]byte{}
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains(moduleTemplatesFileExtensions, strings.ToLower(filepath.Ext(entry.Name()))) {
+			continue
+		}
+
+		data, err := ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, data)
+	}
+
+	return files, nil
+}
+
+// readOCIArtifact returns content of artifact layers with media types accepted by the given func
+func readOCIArtifact(reference string, accept func(types.MediaType) bool) ([][]byte, error) {
+	ref, err := name.ParseReference(reference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OCI reference %s: %w", reference, err)
+	}
+
+	image, err := remote.Image(ref, remote.WithAuthFromKeychain(authn.DefaultKeychain))
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull OCI artifact %s: %w", reference, err)
+	}
+
+	layers, err := image.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get layers of OCI artifact %s: %w", reference, err)
+	}
+
+	files := [][]byte{}
+	for _, layer := range layers {
+		mediaType, err := layer.MediaType()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get media type of layer of OCI artifact %s: %w", reference, err)
+		}
+		if !accept(mediaType) {
+			continue
+		}
+
+		reader, err := layer.Uncompressed()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read layer of OCI artifact %s: %w", reference, err)
+		}
+
+		data, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read layer of OCI artifact %s: %w", reference, err)
+		}
+		files = append(files, data)
+	}
+
+	return files, nil
+}
+
+func isManifestMediaType(mediaType types.MediaType) bool {
+	return slices.Contains(manifestMediaTypes, mediaType)
+}
+
+func decodeModuleTemplates(data []byte) ([]kyma.ModuleTemplate, error) {
+	moduleTemplates := []kyma.ModuleTemplate{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var document any
+		err := decoder.Decode(&document)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// module template types contain only json tags
+		jsonDocument, err := json.Marshal(document)
+		if err != nil {
+			return nil, err
+		}
+
+		switch document.(type) {
+		case []any:
+			list := []kyma.ModuleTemplate{}
+			if err := json.Unmarshal(jsonDocument, &list); err != nil {
+				return nil, err
+			}
+			moduleTemplates = append(moduleTemplates, list...)
+		case map[string]any:
+			moduleTemplate := kyma.ModuleTemplate{}
+			if err := json.Unmarshal(jsonDocument, &moduleTemplate); err != nil {
+				return nil, err
+			}
+			moduleTemplates = append(moduleTemplates, moduleTemplate)
+		case nil:
+			// skip empty documents
+		default:
+			return nil, fmt.Errorf("expected a module template or a list of module templates")
+		}
+	}
+
+	return moduleTemplates, nil
+}
+
+func readHTTP(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download resource from %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("failed to download resource from %s: unexpected status %s", url, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read resource body: %w", err)
+	}
+
+	return body, nil
+}
+
+func isHTTP(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+func isRemote(location string) bool {
+	return isHTTP(location) || strings.HasPrefix(location, ociPrefix)
+}
+
+func localPath(location string) string {
+	return strings.TrimPrefix(location, filePrefix)
+}
@@ -0,0 +1,243 @@
+package source
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testJSONCatalog = `[
+  {"metadata": {"name": "module-1-0.1.0"}, "spec": {"moduleName": "module-1", "version": "0.1.0"}},
+  {"metadata": {"name": "module-2-0.2.0"}, "spec": {"moduleName": "module-2", "version": "0.2.0"}}
+]`
+	testYAMLCatalog = `apiVersion: operator.kyma-project.io/v1beta2
+kind: ModuleTemplate
+metadata:
+  name: module-3-0.3.0
+spec:
+  moduleName: module-3
+  version: 0.3.0
+  resources:
+  - name: rawManifest
+    link: https://github.com/kyma-project/module-3/releases/download/0.3.0/module-3.yaml
+---
+apiVersion: operator.kyma-project.io/v1beta2
+kind: ModuleTemplate
+metadata:
+  name: module-4-0.4.0
+spec:
+  moduleName: module-4
+  version: 0.4.0
+`
+	testLocalLinkCatalog = `[
+  {"metadata": {"name": "module-5-0.5.0"}, "spec": {"moduleName": "module-5", "version": "0.5.0", "resources": [{"name": "rawManifest", "link": "/etc/passwd"}]}}
+]`
+)
+
+func TestReadModuleTemplates(t *testing.T) {
+	t.Run("read from http URL", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, testJSONCatalog)
+		}))
+		defer server.Close()
+
+		moduleTemplates, err := ReadModuleTemplates(server.URL)
+		require.NoError(t, err)
+		require.Equal(t, []string{"module-1", "module-2"}, moduleNames(moduleTemplates))
+	})
+
+	t.Run("read from file URL", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "all-modules.json")
+		require.NoError(t, os.WriteFile(path, []byte(testJSONCatalog), 0600))
+
+		moduleTemplates, err := ReadModuleTemplates("file://" + path)
+		require.NoError(t, err)
+		require.Equal(t, []string{"module-1", "module-2"}, moduleNames(moduleTemplates))
+	})
+
+	t.Run("read from directory", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.json"), []byte(testJSONCatalog), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.yaml"), []byte(testYAMLCatalog), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# catalog"), 0600))
+
+		moduleTemplates, err := ReadModuleTemplates(dir)
+		require.NoError(t, err)
+		require.Equal(t, []string{"module-1", "module-2", "module-3", "module-4"}, moduleNames(moduleTemplates))
+		require.Equal(t, "0.3.0", moduleTemplates[2].Spec.Version)
+		require.Equal(t, "rawManifest", moduleTemplates[2].Spec.Resources[0].Name)
+	})
+
+	t.Run("read from OCI artifact", func(t *testing.T) {
+		reference := pushTestArtifact(t, "catalog:v1",
+			static.NewLayer([]byte(testJSONCatalog), types.MediaType("application/json")),
+			static.NewLayer([]byte(testYAMLCatalog), types.MediaType("application/yaml")),
+		)
+
+		moduleTemplates, err := ReadModuleTemplates("oci://" + reference)
+		require.NoError(t, err)
+		require.Equal(t, []string{"module-1", "module-2", "module-3", "module-4"}, moduleNames(moduleTemplates))
+	})
+
+	t.Run("reject local links in remote catalog", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			fmt.Fprint(w, testLocalLinkCatalog)
+		}))
+		defer server.Close()
+
+		moduleTemplates, err := ReadModuleTemplates(server.URL)
+		require.ErrorContains(t, err, "module template module-5-0.5.0 links the local file /etc/passwd")
+		require.Nil(t, moduleTemplates)
+	})
+
+	t.Run("allow local links in local catalog", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "all-modules.json")
+		require.NoError(t, os.WriteFile(path, []byte(testLocalLinkCatalog), 0600))
+
+		moduleTemplates, err := ReadModuleTemplates(path)
+		require.NoError(t, err)
+		require.Equal(t, []string{"module-5"}, moduleNames(moduleTemplates))
+	})
+
+	t.Run("http error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		moduleTemplates, err := ReadModuleTemplates(server.URL)
+		require.ErrorContains(t, err, "unexpected status 404 Not Found")
+		require.Nil(t, moduleTemplates)
+	})
+
+	t.Run("missing local catalog", func(t *testing.T) {
+		moduleTemplates, err := ReadModuleTemplates(filepath.Join(t.TempDir(), "missing.json"))
+		require.ErrorContains(t, err, "failed to read catalog")
+		require.Nil(t, moduleTemplates)
+	})
+
+	t.Run("invalid catalog file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "all-modules.json")
+		require.NoError(t, os.WriteFile(path, []byte("[{"), 0600))
+
+		moduleTemplates, err := ReadModuleTemplates(path)
+		require.ErrorContains(t, err, "failed to decode module templates")
+		require.Nil(t, moduleTemplates)
+	})
+}
+
+func TestReadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "module.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("kind: Deployment"), 0600))
+
+	data, err := ReadFile("file://" + path)
+	require.NoError(t, err)
+	require.Equal(t, "kind: Deployment", string(data))
+
+	data, err = ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "kind: Deployment", string(data))
+
+	t.Run("read from OCI artifact", func(t *testing.T) {
+		reference := pushTestArtifact(t, "module-3:0.3.0",
+			static.NewLayer([]byte("kind: Deployment\n"), types.MediaType("application/yaml")),
+		)
+
+		data, err := ReadFile("oci://" + reference)
+		require.NoError(t, err)
+		require.Equal(t, "kind: Deployment\n", string(data))
+	})
+
+	t.Run("select manifest layer of OCI artifact", func(t *testing.T) {
+		reference := pushTestArtifact(t, "module-3:0.3.0",
+			static.NewLayer([]byte("kind: Secret"), types.MediaType("application/octet-stream")),
+			static.NewLayer([]byte("kind: Deployment\n"), types.MediaType("application/x-yaml")),
+		)
+
+		data, err := ReadFile("oci://" + reference)
+		require.NoError(t, err)
+		require.Equal(t, "kind: Deployment\n", string(data))
+	})
+
+	t.Run("multiple manifest layers of OCI artifact", func(t *testing.T) {
+		reference := pushTestArtifact(t, "module-3:0.3.0",
+			static.NewLayer([]byte("kind: CustomResourceDefinition\n"), types.MediaType("application/yaml")),
+			static.NewLayer([]byte("kind: Deployment"), types.MediaType("application/yaml")),
+		)
+
+		data, err := ReadFile("oci://" + reference)
+		require.ErrorContains(t, err, "expected one manifest layer")
+		require.Nil(t, data)
+	})
+
+	t.Run("missing OCI artifact", func(t *testing.T) {
+		server := httptest.NewServer(registry.New())
+		defer server.Close()
+		serverURL, err := url.Parse(server.URL)
+		require.NoError(t, err)
+
+		data, err := ReadFile(fmt.Sprintf("oci://%s/module-3:0.3.0", serverURL.Host))
+		require.ErrorContains(t, err, "failed to pull OCI artifact")
+		require.Nil(t, data)
+	})
+}
+
+// pushTestArtifact pushes the artifact with layers to the local registry and returns its reference
+func pushTestArtifact(t *testing.T, repository string, layers ...v1.Layer) string {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	reference := fmt.Sprintf("%s/%s", serverURL.Host, repository)
+	image, err := mutate.AppendLayers(empty.Image, layers...)
+	require.NoError(t, err)
+	ref, err := name.ParseReference(reference)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(ref, image))
+
+	return reference
+}
+
+func TestRewriteResourceLinks(t *testing.T) {
+	moduleTemplate := kyma.ModuleTemplate{
+		Spec: kyma.ModuleTemplateSpec{
+			Resources: []kyma.Resource{
+				{Name: "rawManifest", Link: "https://github.com/kyma-project/module/releases/download/1.0.0/module.yaml"},
+				{Name: "other", Link: "https://example.com/other.yaml"},
+			},
+		},
+	}
+
+	RewriteResourceLinks(&moduleTemplate, map[string]string{
+		"https://github.com/":                     "https://mirror.local/github/",
+		"https://github.com/kyma-project/module/": "file:///mirror/module/",
+	})
+
+	require.Equal(t, "file:///mirror/module/releases/download/1.0.0/module.yaml", moduleTemplate.Spec.Resources[0].Link)
+	require.Equal(t, "https://example.com/other.yaml", moduleTemplate.Spec.Resources[1].Link)
+}
+
+func moduleNames(moduleTemplates []kyma.ModuleTemplate) []string {
+	names := []string{}
+	for _, moduleTemplate := range moduleTemplates {
+		names = append(names, moduleTemplate.Spec.ModuleName)
+	}
+	return names
+}
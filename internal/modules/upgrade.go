@@ -0,0 +1,194 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modules/repo"
+	"github.com/kyma-project/cli.v3/internal/out"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// UpgradeChannel switches the core module to the given channel by updating its entry in the Kyma CR
+// the module CR and the rest of the module configuration stay untouched
+func UpgradeChannel(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, channel string) clierror.Error {
+	return upgradeChannel(out.Default, ctx, client, repo, module, channel)
+}
+
+func upgradeChannel(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, module, channel string) clierror.Error {
+	exists, err := ModuleExistsInKymaCR(ctx, client, module)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to check if the module exists in the Kyma CR"))
+	}
+	if !exists {
+		return clierror.New(
+			fmt.Sprintf("the %s module is not added to the Kyma CR", module),
+			"to add the module, call the `kyma module add` command",
+		)
+	}
+
+	if err := validateModuleAvailability(ctx, client, repo, module, channel); err != nil {
+		hints := []string{
+			"ensure you provide a valid channel",
+			"to list available modules and channels, call the `kyma module catalog` command",
+		}
+		return clierror.Wrap(err, clierror.New("unknown module channel", hints...))
+	}
+
+	printer.Debugfln("updating the %s module channel in the Kyma CR", module)
+	err = client.Kyma().SetModuleChannel(ctx, module, channel)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New("failed to update the module channel"))
+	}
+
+	printer.Msgfln("%s module upgraded to the %s channel", module, channel)
+	return nil
+}
+
+// UpgradeCommunityModule replaces resources of the installed community module with resources from the new module template
+// 1. resources from the new module template are applied
+// 2. if applying fails, resources created by the new version are removed and resources of the previous version are applied back
+// 3. resources that are not part of the new version are removed
+// module CRs (config) are not modified
+func UpgradeCommunityModule(ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, installedModuleTemplate, newModuleTemplate *kyma.ModuleTemplate) clierror.Error {
+	return upgradeCommunityModule(out.Default, ctx, client, repo, installedModuleTemplate, newModuleTemplate)
+}
+
+func upgradeCommunityModule(printer *out.Printer, ctx context.Context, client kube.Client, repo repo.ModuleTemplatesRepository, installedModuleTemplate, newModuleTemplate *kyma.ModuleTemplate) clierror.Error {
+	moduleName := installedModuleTemplate.Spec.ModuleName
+	if newModuleTemplate.Spec.ModuleName != moduleName {
+		return clierror.New(fmt.Sprintf("module template %s/%s does not contain the %s module", newModuleTemplate.GetNamespace(), newModuleTemplate.GetName(), moduleName))
+	}
+
+	installedResources, err := repo.Resources(ctx, *installedModuleTemplate)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to get resources of the installed %s module", moduleName), localLinkHints(err)...))
+	}
+
+	newResources, err := repo.Resources(ctx, *newModuleTemplate)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(fmt.Sprintf("failed to get resources of the %s module in version %s", moduleName, newModuleTemplate.Spec.Version), localLinkHints(err)...))
+	}
+
+	printer.Msgfln("upgrading the %s community module from %s to %s", moduleName, installedModuleTemplate.Spec.Version, newModuleTemplate.Spec.Version)
+	err = applyResourcesWithUpgradeRollback(printer, ctx, client, installedResources, newResources)
+	if err != nil {
+		return clierror.Wrap(err, clierror.New(
+			"failed to upgrade the community module",
+			fmt.Sprintf("resources of the %s module were restored to version %s", moduleName, installedModuleTemplate.Spec.Version),
+		))
+	}
+
+	removeObsoleteResources(printer, ctx, client, installedResources, newResources)
+
+	printer.Msgfln("%s community module upgraded to version %s", moduleName, newModuleTemplate.Spec.Version)
+	return nil
+}
+
+// FindInstalledCommunityModuleTemplate returns the module template of the community module version installed in the cluster
+// the installed version is read from the module manager the same way as for the installed modules list
+// returns nil if the module is not installed
+func FindInstalledCommunityModuleTemplate(ctx context.Context, repo repo.ModuleTemplatesRepository, moduleName string) (*kyma.ModuleTemplate, error) {
+	moduleTemplates, err := repo.CommunityByName(ctx, moduleName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list community module templates: %w", err)
+	}
+
+	installedVersion := ""
+	for _, moduleTemplate := range moduleTemplates {
+		installedManager, err := repo.InstalledManager(ctx, moduleTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get installed manager: %w", err)
+		}
+		if installedManager == nil {
+			continue
+		}
+
+		installedVersion, err = getManagerVersion(installedManager)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get managers version: %w", err)
+		}
+
+		if moduleTemplate.Spec.Version == installedVersion {
+			return &moduleTemplate, nil
+		}
+	}
+
+	if installedVersion != "" {
+		return nil, fmt.Errorf("module template for the installed version %s of the %s module not found", installedVersion, moduleName)
+	}
+
+	return nil, nil
+}
+
+func applyResourcesWithUpgradeRollback(printer *out.Printer, ctx context.Context, client kube.Client, installedResources, newResources []map[string]any) error {
+	var appliedResources []map[string]any
+
+	for _, resource := range newResources {
+		obj := &unstructured.Unstructured{Object: resource}
+		printer.Debugfln("applying %s (%s)", obj.GetName(), obj.GetKind())
+		if err := client.RootlessDynamic().Apply(ctx, obj, false); err != nil {
+			rollbackUpgrade(printer, ctx, client, installedResources, appliedResources)
+			return fmt.Errorf("failed to apply resource %s (%s): %w", obj.GetName(), obj.GetKind(), err)
+		}
+		appliedResources = append(appliedResources, resource)
+	}
+
+	return nil
+}
+
+// rollbackUpgrade removes resources created by the new version and applies back resources of the previous one
+func rollbackUpgrade(printer *out.Printer, ctx context.Context, client kube.Client, installedResources, appliedResources []map[string]any) {
+	createdResources := subtractResources(appliedResources, installedResources)
+	slices.Reverse(createdResources)
+	rollback(ctx, client, createdResources)
+
+	for _, resource := range installedResources {
+		err := client.RootlessDynamic().Apply(ctx, &unstructured.Unstructured{Object: resource}, false)
+		if err != nil {
+			printer.Errfln("err: %v\nfailed to restore resource: %v", err, resource)
+		}
+	}
+}
+
+// removeObsoleteResources removes resources of the previous version that are not part of the new one
+func removeObsoleteResources(printer *out.Printer, ctx context.Context, client kube.Client, installedResources, newResources []map[string]any) {
+	obsoleteResources := subtractResources(installedResources, newResources)
+	slices.Reverse(obsoleteResources)
+
+	for _, resource := range obsoleteResources {
+		obj := &unstructured.Unstructured{Object: resource}
+		printer.Debugfln("removing obsolete resource %s (%s)", obj.GetName(), obj.GetKind())
+		err := client.RootlessDynamic().Remove(ctx, obj, false)
+		if err != nil && !apierrors.IsNotFound(err) {
+			printer.Errfln("failed to remove obsolete resource %s (%s): %v", obj.GetName(), obj.GetKind(), err)
+		}
+	}
+}
+
+// subtractResources returns resources from the first list that are not present in the second one
+func subtractResources(resources, toSubtract []map[string]any) []map[string]any {
+	keys := map[string]bool{}
+	for _, resource := range toSubtract {
+		keys[resourceKey(resource)] = true
+	}
+
+	result := []map[string]any{}
+	for _, resource := range resources {
+		if !keys[resourceKey(resource)] {
+			result = append(result, resource)
+		}
+	}
+
+	return result
+}
+
+func resourceKey(resource map[string]any) string {
+	obj := unstructured.Unstructured{Object: resource}
+	return fmt.Sprintf("%s/%s/%s/%s", obj.GetAPIVersion(), obj.GetKind(), obj.GetNamespace(), obj.GetName())
+}
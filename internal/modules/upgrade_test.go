@@ -0,0 +1,236 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	modulesfake "github.com/kyma-project/cli.v3/internal/modules/fake"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestUpgradeChannel(t *testing.T) {
+	t.Run("upgrade module channel", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		kymaClient := fake.KymaClient{
+			ReturnDefaultKyma: kyma.Kyma{
+				Spec: kyma.KymaSpec{
+					Modules: []kyma.Module{{Name: "keda", Channel: "regular"}},
+				},
+			},
+			ReturnModuleTemplateList: kyma.ModuleTemplateList{
+				Items: []kyma.ModuleTemplate{testKedaModuleTemplate},
+			},
+			ReturnModuleReleaseMetaList: kyma.ModuleReleaseMetaList{
+				Items: []kyma.ModuleReleaseMeta{testKedaModuleReleaseMeta},
+			},
+		}
+		client := fake.KubeClient{
+			TestKymaInterface: &kymaClient,
+		}
+
+		clierr := upgradeChannel(out.NewToWriter(buffer), context.Background(), &client, &modulesfake.ModuleTemplatesRepo{}, "keda", "fast")
+		require.Nil(t, clierr)
+		require.Equal(t, []fake.FakeModuleChannel{{Name: "keda", Channel: "fast"}}, kymaClient.ModuleChannels)
+		require.Equal(t, "keda module upgraded to the fast channel\n", buffer.String())
+	})
+
+	t.Run("module not added to the Kyma CR", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		kymaClient := fake.KymaClient{}
+		client := fake.KubeClient{
+			TestKymaInterface: &kymaClient,
+		}
+
+		clierr := upgradeChannel(out.NewToWriter(buffer), context.Background(), &client, &modulesfake.ModuleTemplatesRepo{}, "keda", "fast")
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the keda module is not added to the Kyma CR")
+		require.Empty(t, kymaClient.ModuleChannels)
+	})
+
+	t.Run("channel not available", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		kymaClient := fake.KymaClient{
+			ReturnDefaultKyma: kyma.Kyma{
+				Spec: kyma.KymaSpec{
+					Modules: []kyma.Module{{Name: "keda", Channel: "fast"}},
+				},
+			},
+			ReturnModuleTemplateList: kyma.ModuleTemplateList{
+				Items: []kyma.ModuleTemplate{testKedaModuleTemplate},
+			},
+			ReturnModuleReleaseMetaList: kyma.ModuleReleaseMetaList{
+				Items: []kyma.ModuleReleaseMeta{testKedaModuleReleaseMeta},
+			},
+		}
+		client := fake.KubeClient{
+			TestKymaInterface: &kymaClient,
+		}
+
+		clierr := upgradeChannel(out.NewToWriter(buffer), context.Background(), &client, &modulesfake.ModuleTemplatesRepo{}, "keda", "experimental")
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the keda module is not available in the experimental channel")
+		require.Empty(t, kymaClient.ModuleChannels)
+	})
+}
+
+func TestUpgradeCommunityModule(t *testing.T) {
+	installedModuleTemplate := &kyma.ModuleTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-module-1.0.0", Namespace: "default"},
+		Spec:       kyma.ModuleTemplateSpec{ModuleName: "my-module", Version: "1.0.0"},
+	}
+	newModuleTemplate := &kyma.ModuleTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-module-1.1.0", Namespace: "default"},
+		Spec:       kyma.ModuleTemplateSpec{ModuleName: "my-module", Version: "1.1.0"},
+	}
+	deployment := map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "my-module-manager", "namespace": "kyma-system"},
+	}
+
+	t.Run("upgrade community module", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		rootlessDynamic := fake.RootlessDynamicClient{}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &rootlessDynamic,
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnResources: []map[string]any{deployment},
+		}
+
+		clierr := upgradeCommunityModule(out.NewToWriter(buffer), context.Background(), &client, repo, installedModuleTemplate, newModuleTemplate)
+		require.Nil(t, clierr)
+		require.Len(t, rootlessDynamic.ApplyObjs, 1)
+		require.Equal(t, "my-module-manager", rootlessDynamic.ApplyObjs[0].GetName())
+		require.Empty(t, rootlessDynamic.RemovedObjs)
+		require.Contains(t, buffer.String(), "my-module community module upgraded to version 1.1.0")
+	})
+
+	t.Run("restore previous resources when apply fails", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		rootlessDynamic := fake.RootlessDynamicClient{
+			ReturnErr: errors.New("test error"),
+		}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &rootlessDynamic,
+		}
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnResources: []map[string]any{deployment},
+		}
+
+		clierr := upgradeCommunityModule(out.NewToWriter(buffer), context.Background(), &client, repo, installedModuleTemplate, newModuleTemplate)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to upgrade the community module")
+		// first apply of the new version and second one to restore the previous version
+		require.Len(t, rootlessDynamic.ApplyObjs, 2)
+		require.Empty(t, rootlessDynamic.RemovedObjs)
+	})
+
+	t.Run("different module in the new module template", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		otherModuleTemplate := &kyma.ModuleTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-module-1.1.0", Namespace: "default"},
+			Spec:       kyma.ModuleTemplateSpec{ModuleName: "other-module", Version: "1.1.0"},
+		}
+
+		clierr := upgradeCommunityModule(out.NewToWriter(buffer), context.Background(), &fake.KubeClient{}, &modulesfake.ModuleTemplatesRepo{}, installedModuleTemplate, otherModuleTemplate)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "module template default/other-module-1.1.0 does not contain the my-module module")
+	})
+}
+
+func TestSubtractResources(t *testing.T) {
+	deployment := map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "manager", "namespace": "kyma-system"},
+	}
+	service := map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]any{"name": "manager", "namespace": "kyma-system"},
+	}
+	configMap := map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "config", "namespace": "kyma-system"},
+	}
+
+	result := subtractResources(
+		[]map[string]any{deployment, service, configMap},
+		[]map[string]any{service},
+	)
+	require.Equal(t, []map[string]any{deployment, configMap}, result)
+}
+
+func TestFindInstalledCommunityModuleTemplate(t *testing.T) {
+	moduleTemplates := []kyma.ModuleTemplate{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-module-1.1.0", Namespace: "default"},
+			Spec:       kyma.ModuleTemplateSpec{ModuleName: "my-module", Version: "1.1.0"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-module-1.0.0", Namespace: "default"},
+			Spec:       kyma.ModuleTemplateSpec{ModuleName: "my-module", Version: "1.0.0"},
+		},
+	}
+	installedManager := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":      "my-module-manager",
+			"namespace": "kyma-system",
+			"labels":    map[string]any{"app.kubernetes.io/version": "1.0.0"},
+		},
+	}}
+
+	t.Run("find module template of the installed version", func(t *testing.T) {
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnCommunityByName:  moduleTemplates,
+			ReturnInstalledManager: installedManager,
+		}
+
+		moduleTemplate, err := FindInstalledCommunityModuleTemplate(context.Background(), repo, "my-module")
+		require.NoError(t, err)
+		require.Equal(t, &moduleTemplates[1], moduleTemplate)
+	})
+
+	t.Run("module not installed", func(t *testing.T) {
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnCommunityByName: moduleTemplates,
+		}
+
+		moduleTemplate, err := FindInstalledCommunityModuleTemplate(context.Background(), repo, "my-module")
+		require.NoError(t, err)
+		require.Nil(t, moduleTemplate)
+	})
+
+	t.Run("module template of the installed version not found", func(t *testing.T) {
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnCommunityByName:  moduleTemplates[:1],
+			ReturnInstalledManager: installedManager,
+		}
+
+		moduleTemplate, err := FindInstalledCommunityModuleTemplate(context.Background(), repo, "my-module")
+		require.EqualError(t, err, "module template for the installed version 1.0.0 of the my-module module not found")
+		require.Nil(t, moduleTemplate)
+	})
+
+	t.Run("failed to get installed manager", func(t *testing.T) {
+		repo := &modulesfake.ModuleTemplatesRepo{
+			ReturnCommunityByName: moduleTemplates,
+			InstalledManagerErr:   errors.New("test error"),
+		}
+
+		moduleTemplate, err := FindInstalledCommunityModuleTemplate(context.Background(), repo, "my-module")
+		require.EqualError(t, err, "failed to get installed manager: test error")
+		require.Nil(t, moduleTemplate)
+	})
+}
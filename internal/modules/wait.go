@@ -0,0 +1,442 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/clierror"
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/kyma-project/cli.v3/internal/out"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	DefaultWaitTimeout = 5 * time.Minute
+	// DefaultModuleCRTimeout bounds waits for module CRs when the user doesn't wait for the module
+	DefaultModuleCRTimeout = 100 * time.Second
+	stateCheckInterval     = 2 * time.Second
+)
+
+// ModuleCRTimeout returns the timeout of module CR waits for commands with the --wait and --timeout flags
+// the --timeout flag bounds module CR waits only if the command waits for the module
+func ModuleCRTimeout(wait bool, timeout time.Duration) time.Duration {
+	if wait {
+		return timeout
+	}
+	return DefaultModuleCRTimeout
+}
+
+// observedModuleState contains all states of the module printed while waiting
+type observedModuleState struct {
+	InstallationState string
+	ModuleCRState     string
+	ModuleCRs         int
+	ManagerFound      bool
+	ReadyReplicas     int64
+	WantedReplicas    int64
+	Conditions        []string
+}
+
+func (s *observedModuleState) String() string {
+	elems := []string{}
+	if s.InstallationState != "" {
+		elems = append(elems, fmt.Sprintf("installation state: %s", s.InstallationState))
+	}
+	if s.ModuleCRState != "" {
+		elems = append(elems, fmt.Sprintf("module CR state: %s", s.ModuleCRState))
+	}
+	if s.ManagerFound {
+		elems = append(elems, fmt.Sprintf("manager replicas: %d/%d", s.ReadyReplicas, s.WantedReplicas))
+	}
+	if len(elems) == 0 {
+		return "waiting for the module state"
+	}
+	return strings.Join(elems, ", ")
+}
+
+func (s *observedModuleState) equal(other *observedModuleState) bool {
+	return other != nil &&
+		s.InstallationState == other.InstallationState &&
+		s.ModuleCRState == other.ModuleCRState &&
+		s.ManagerFound == other.ManagerFound &&
+		s.ReadyReplicas == other.ReadyReplicas &&
+		s.WantedReplicas == other.WantedReplicas
+}
+
+// conditionsHints returns module CR conditions in form that can be attached to the clierror
+func (s *observedModuleState) conditionsHints() []string {
+	hints := []string{}
+	for _, condition := range s.Conditions {
+		hints = append(hints, fmt.Sprintf("module CR condition %s", condition))
+	}
+	return append(hints, "to check the module state, call the `kyma module list` command")
+}
+
+// moduleStateObserver gets current state of the module and prints it when it changes
+// the state is refreshed every time one of watched resources (Kyma CR, module manager, module CRs) changes
+type moduleStateObserver struct {
+	printer        *out.Printer
+	client         kube.Client
+	module         string
+	moduleTemplate *kyma.ModuleTemplate
+	community      bool
+	last           *observedModuleState
+
+	events                 chan watch.Event
+	watchers               []watch.Interface
+	kymaWatched            bool
+	moduleResourcesWatched bool
+}
+
+func newModuleStateObserver(printer *out.Printer, client kube.Client, module string) *moduleStateObserver {
+	return &moduleStateObserver{
+		printer: printer,
+		client:  client,
+		module:  module,
+		events:  make(chan watch.Event),
+	}
+}
+
+func newCommunityModuleStateObserver(printer *out.Printer, client kube.Client, moduleTemplate *kyma.ModuleTemplate) *moduleStateObserver {
+	return &moduleStateObserver{
+		printer:        printer,
+		client:         client,
+		module:         moduleTemplate.Spec.ModuleName,
+		moduleTemplate: moduleTemplate,
+		community:      true,
+		events:         make(chan watch.Event),
+	}
+}
+
+// newKymaObserver returns the observer that watches only the Kyma CR
+// it's used to wait for changes of many modules whose states are read from the Kyma CR status
+func newKymaObserver(printer *out.Printer, client kube.Client) *moduleStateObserver {
+	return &moduleStateObserver{
+		printer: printer,
+		client:  client,
+		events:  make(chan watch.Event),
+	}
+}
+
+// start observes the module state on every change until the returned stop function is called
+// onChange is called with the previous and the current state for every observation except the first one
+func (o *moduleStateObserver) start(ctx context.Context, onChange func(previous, current *observedModuleState)) func() {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		for {
+			previous := o.last
+			current := o.observe(ctx)
+			if previous != nil && ctx.Err() == nil {
+				onChange(previous, current)
+			}
+
+			if !o.next(ctx) {
+				return
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+		o.stop()
+	}
+}
+
+// next waits for the next change of watched resources and returns false when the context is done
+func (o *moduleStateObserver) next(ctx context.Context) bool {
+	o.watchModuleResources(ctx)
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-o.events:
+		return true
+	}
+}
+
+// watchModuleResources starts watchers for the Kyma CR and, once the module template is known, for the module manager and module CRs
+func (o *moduleStateObserver) watchModuleResources(ctx context.Context) {
+	if !o.community && !o.kymaWatched {
+		o.kymaWatched = true
+		kymaCR := generateUnstruct(kyma.GVRKyma.GroupVersion().String(), "Kyma", kyma.DefaultKymaName, kyma.DefaultKymaNamespace)
+		o.watch(ctx, kymaCR, o.client.RootlessDynamic().WatchSingleResource)
+	}
+
+	if o.moduleTemplate == nil || o.moduleResourcesWatched {
+		return
+	}
+	o.moduleResourcesWatched = true
+
+	if o.moduleTemplate.Spec.Manager != nil {
+		o.watch(ctx, managerUnstruct(o.moduleTemplate.Spec.Manager), o.client.RootlessDynamic().WatchSingleResource)
+	}
+
+	data := o.moduleTemplate.Spec.Data
+	if len(data.Object) != 0 {
+		moduleCRs := generateUnstruct(data.GetAPIVersion(), data.GetKind(), "", data.GetNamespace())
+		o.watch(ctx, moduleCRs, func(ctx context.Context, resource *unstructured.Unstructured) (watch.Interface, error) {
+			return o.client.RootlessDynamic().Watch(ctx, resource, &rootlessdynamic.ListOptions{AllNamespaces: true})
+		})
+	}
+}
+
+// watch forwards events of the watched resource to the observer until the context is done or the watcher is stopped
+func (o *moduleStateObserver) watch(ctx context.Context, resource unstructured.Unstructured, watchFunc func(context.Context, *unstructured.Unstructured) (watch.Interface, error)) {
+	watcher, err := watchFunc(ctx, &resource)
+	if err != nil || watcher == nil {
+		o.printer.Debugfln("failed to watch %s %s/%s: %v", resource.GetKind(), resource.GetNamespace(), resource.GetName(), err)
+		return
+	}
+	o.watchers = append(o.watchers, watcher)
+
+	go func() {
+		for event := range watcher.ResultChan() {
+			select {
+			case <-ctx.Done():
+				return
+			case o.events <- event:
+			}
+		}
+	}()
+}
+
+func (o *moduleStateObserver) stop() {
+	for _, watcher := range o.watchers {
+		watcher.Stop()
+	}
+	o.watchers = nil
+}
+
+func (o *moduleStateObserver) observe(ctx context.Context) *observedModuleState {
+	state := o.getState(ctx)
+	if ctx.Err() != nil {
+		// skip states collected while the context was being cancelled
+		return state
+	}
+
+	if !state.equal(o.last) {
+		o.printer.Msgfln("%s module: %s", o.module, state.String())
+	}
+	o.last = state
+
+	return state
+}
+
+// enteredErrorState returns true if the state changed to Error since the previous observation
+// stale Error state observed before any transition is not treated as a failure
+func enteredErrorState(previous, current string) bool {
+	return previous != "Error" && current == "Error"
+}
+
+func (o *moduleStateObserver) getState(ctx context.Context) *observedModuleState {
+	state := &observedModuleState{}
+
+	if !o.community {
+		info, err := o.client.Kyma().GetModuleInfo(ctx, o.module)
+		if err != nil {
+			o.printer.Debugfln("failed to get the %s module info: %v", o.module, err)
+			return state
+		}
+		state.InstallationState = info.Status.State
+
+		if o.moduleTemplate == nil && info.Status.Version != "" {
+			o.moduleTemplate, err = findMatchingModuleTemplate(ctx, o.client, info.Status)
+			if err != nil {
+				o.printer.Debugfln("failed to get the %s module template: %v", o.module, err)
+			}
+		}
+	}
+
+	if o.moduleTemplate == nil {
+		return state
+	}
+
+	manager, err := getManager(ctx, o.client, o.moduleTemplate.Spec.Manager)
+	if err != nil {
+		o.printer.Debugfln("failed to get the %s module manager: %v", o.module, err)
+	}
+	if manager != nil {
+		state.ManagerFound = true
+		state.ReadyReplicas, _, _ = unstructured.NestedInt64(manager.Object, "status", "readyReplicas")
+		state.WantedReplicas, _, _ = unstructured.NestedInt64(manager.Object, "spec", "replicas")
+	}
+
+	moduleCRs, err := listModuleCRs(ctx, o.client, o.moduleTemplate.Spec.Data)
+	if err != nil {
+		o.printer.Debugfln("failed to list the %s module CRs: %v", o.module, err)
+	}
+	state.ModuleCRs = len(moduleCRs)
+	for _, moduleCR := range moduleCRs {
+		crState, _, _ := unstructured.NestedString(moduleCR.Object, "status", "state")
+		if crState != "" {
+			state.ModuleCRState = getHighestState(state.ModuleCRState, crState)
+		}
+		state.Conditions = append(state.Conditions, getConditionsDescription(moduleCR)...)
+	}
+
+	return state
+}
+
+// getManager returns the manager resource or nil if it does not exist
+func getManager(ctx context.Context, client kube.Client, manager *kyma.Manager) (*unstructured.Unstructured, error) {
+	if manager == nil {
+		return nil, nil
+	}
+
+	unstruct := managerUnstruct(manager)
+	result, err := client.RootlessDynamic().Get(ctx, &unstruct)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func managerUnstruct(manager *kyma.Manager) unstructured.Unstructured {
+	namespace := "kyma-system"
+	if manager.Namespace != "" {
+		namespace = manager.Namespace
+	}
+
+	apiVersion := fmt.Sprintf("%s/%s", manager.Group, manager.Version)
+	return generateUnstruct(apiVersion, manager.Kind, manager.Name, namespace)
+}
+
+func listModuleCRs(ctx context.Context, client kube.Client, data unstructured.Unstructured) ([]unstructured.Unstructured, error) {
+	if len(data.Object) == 0 {
+		return nil, nil
+	}
+
+	unstruct := generateUnstruct(data.GetAPIVersion(), data.GetKind(), "", data.GetNamespace())
+	list, err := client.RootlessDynamic().List(ctx, &unstruct, &rootlessdynamic.ListOptions{AllNamespaces: true})
+	if err != nil || list == nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
+// getConditionsDescription returns conditions of the module CR in format <namespace>/<name> <type>=<status>: <reason> <message>
+func getConditionsDescription(moduleCR unstructured.Unstructured) []string {
+	conditions, _, _ := unstructured.NestedSlice(moduleCR.Object, "status", "conditions")
+
+	descriptions := []string{}
+	for _, condition := range conditions {
+		conditionMap, ok := condition.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		description := fmt.Sprintf("%s/%s %v=%v", moduleCR.GetNamespace(), moduleCR.GetName(), conditionMap["type"], conditionMap["status"])
+		if reason, ok := conditionMap["reason"]; ok {
+			description += fmt.Sprintf(": %v", reason)
+		}
+		if message, ok := conditionMap["message"]; ok && message != "" {
+			description += fmt.Sprintf(" %v", message)
+		}
+		descriptions = append(descriptions, description)
+	}
+
+	return descriptions
+}
+
+// WaitForModuleState waits until the module from the Kyma CR reaches one of expected states and prints its state changes
+// returns error with module CR conditions when the module goes to the Error state or the timeout is reached
+func WaitForModuleState(ctx context.Context, client kube.Client, module string, timeout time.Duration, expectedStates ...string) clierror.Error {
+	return waitForModuleState(out.Default, ctx, client, module, timeout, expectedStates...)
+}
+
+// WaitForModuleRemoval waits until the module disappears from the Kyma CR status and prints its state changes
+func WaitForModuleRemoval(ctx context.Context, client kube.Client, module string, timeout time.Duration) clierror.Error {
+	// module without status in the Kyma CR has empty state
+	return waitForModuleState(out.Default, ctx, client, module, timeout, "")
+}
+
+func waitForModuleState(printer *out.Printer, ctx context.Context, client kube.Client, module string, timeout time.Duration, expectedStates ...string) clierror.Error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	waitCtx, stopWaiting := context.WithCancel(timeoutCtx)
+	defer stopWaiting()
+
+	failed := false
+	observer := newModuleStateObserver(printer, client, module)
+	stop := observer.start(timeoutCtx, func(previous, current *observedModuleState) {
+		if !slices.Contains(expectedStates, "Error") && enteredErrorState(previous.InstallationState, current.InstallationState) {
+			failed = true
+			stopWaiting()
+		}
+	})
+
+	waitErr := client.Kyma().WaitForModuleState(waitCtx, module, expectedStates...)
+	stop()
+
+	state := observer.observe(ctx)
+	if failed {
+		return clierror.New(fmt.Sprintf("the %s module is in the Error state", module), state.conditionsHints()...)
+	}
+
+	if waitErr != nil {
+		return clierror.Wrap(waitErr, clierror.New(fmt.Sprintf("failed to wait for the %s module", module), state.conditionsHints()...))
+	}
+
+	return nil
+}
+
+// WaitForCommunityModuleReadiness waits until the manager and module CRs of the community module are ready and prints their state changes
+func WaitForCommunityModuleReadiness(ctx context.Context, client kube.Client, moduleTemplate *kyma.ModuleTemplate, timeout time.Duration) clierror.Error {
+	return waitForCommunityModule(out.Default, ctx, client, moduleTemplate, timeout, communityModuleReady)
+}
+
+// WaitForCommunityModuleRemoval waits until the manager and module CRs of the community module are removed
+func WaitForCommunityModuleRemoval(ctx context.Context, client kube.Client, moduleTemplate *kyma.ModuleTemplate, timeout time.Duration) clierror.Error {
+	return waitForCommunityModule(out.Default, ctx, client, moduleTemplate, timeout, communityModuleRemoved)
+}
+
+func communityModuleReady(state *observedModuleState) bool {
+	managerReady := state.ManagerFound && state.WantedReplicas > 0 && resolveStateFromReplicas(state.ReadyReplicas, state.WantedReplicas) == "Ready"
+	moduleCRReady := state.ModuleCRs == 0 || state.ModuleCRState == "Ready" || state.ModuleCRState == "Warning"
+	return managerReady && moduleCRReady
+}
+
+func communityModuleRemoved(state *observedModuleState) bool {
+	return !state.ManagerFound && state.ModuleCRs == 0
+}
+
+func waitForCommunityModule(printer *out.Printer, ctx context.Context, client kube.Client, moduleTemplate *kyma.ModuleTemplate, timeout time.Duration, isDone func(*observedModuleState) bool) clierror.Error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	observer := newCommunityModuleStateObserver(printer, client, moduleTemplate)
+	defer observer.stop()
+
+	for {
+		previous := observer.last
+		state := observer.observe(ctx)
+		if isDone(state) {
+			return nil
+		}
+
+		if previous != nil && enteredErrorState(previous.ModuleCRState, state.ModuleCRState) {
+			return clierror.New(fmt.Sprintf("the %s module CR is in the Error state", observer.module), state.conditionsHints()...)
+		}
+
+		if !observer.next(timeoutCtx) {
+			return clierror.Wrap(timeoutCtx.Err(), clierror.New(fmt.Sprintf("failed to wait for the %s module", observer.module), state.conditionsHints()...))
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+var (
+	testWaitModuleTemplate = kyma.ModuleTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "keda-1.0.0", Namespace: "kyma-system"},
+		Spec: kyma.ModuleTemplateSpec{
+			ModuleName: "keda",
+			Version:    "1.0.0",
+			Data:       testKedaCR,
+			Manager: &kyma.Manager{
+				GroupVersionKind: metav1.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+				Name:             "keda-manager",
+				Namespace:        "kyma-system",
+			},
+		},
+	}
+	testReadyManager = unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "keda-manager", "namespace": "kyma-system"},
+		"spec":       map[string]any{"replicas": int64(1)},
+		"status":     map[string]any{"readyReplicas": int64(1)},
+	}}
+)
+
+func TestWaitForModuleState(t *testing.T) {
+	t.Run("module ready", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnModuleInfo: kyma.KymaModuleInfo{
+					Status: kyma.ModuleStatus{Name: "keda", Version: "1.0.0", State: "Ready"},
+				},
+				ReturnModuleTemplateList: kyma.ModuleTemplateList{
+					Items: []kyma.ModuleTemplate{testWaitModuleTemplate},
+				},
+			},
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnGetObj:   testReadyManager,
+				ReturnListObjs: &unstructured.UnstructuredList{},
+			},
+		}
+
+		clierr := waitForModuleState(out.NewToWriter(buffer), context.Background(), &client, "keda", time.Minute, "Ready", "Warning")
+		require.Nil(t, clierr)
+		require.Contains(t, buffer.String(), "keda module: installation state: Ready, manager replicas: 1/1\n")
+	})
+
+	t.Run("module goes to the Error state", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		moduleCR := testKedaCR.DeepCopy()
+		moduleCR.Object["status"] = map[string]any{
+			"state": "Error",
+			"conditions": []any{
+				map[string]any{
+					"type":    "Installation",
+					"status":  "False",
+					"reason":  "InstallationFailed",
+					"message": "failed to install keda",
+				},
+			},
+		}
+		client := fake.KubeClient{
+			TestKymaInterface: &sequenceKymaClient{
+				KymaClient: fake.KymaClient{
+					ReturnModuleTemplateList: kyma.ModuleTemplateList{
+						Items: []kyma.ModuleTemplate{testWaitModuleTemplate},
+					},
+				},
+				moduleInfos: []kyma.KymaModuleInfo{
+					{Status: kyma.ModuleStatus{Name: "keda", Version: "1.0.0", State: "Processing"}},
+					{Status: kyma.ModuleStatus{Name: "keda", Version: "1.0.0", State: "Error"}},
+				},
+			},
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnGetObj: testReadyManager,
+				ReturnListObjs: &unstructured.UnstructuredList{
+					Items: []unstructured.Unstructured{*moduleCR},
+				},
+				ReturnWatcher: newTestWatcher(1),
+			},
+		}
+
+		clierr := waitForModuleState(out.NewToWriter(buffer), context.Background(), &client, "keda", time.Minute, "Ready", "Warning")
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the keda module is in the Error state")
+		require.Contains(t, clierr.String(), "module CR condition kyma-system/default Installation=False: InstallationFailed failed to install keda")
+		require.Contains(t, buffer.String(), "keda module: installation state: Processing, module CR state: Error, manager replicas: 1/1\n")
+		require.Contains(t, buffer.String(), "keda module: installation state: Error, module CR state: Error, manager replicas: 1/1\n")
+	})
+
+	t.Run("ignore stale Error state", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := fake.KubeClient{
+			TestKymaInterface: &sequenceKymaClient{
+				moduleInfos: []kyma.KymaModuleInfo{
+					{Status: kyma.ModuleStatus{Name: "keda", State: "Error"}},
+				},
+			},
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnWatcher: newTestWatcher(2),
+			},
+		}
+
+		clierr := waitForModuleState(out.NewToWriter(buffer), context.Background(), &client, "keda", 100*time.Millisecond, "Ready", "Warning")
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to wait for the keda module")
+		require.NotContains(t, clierr.String(), "the keda module is in the Error state")
+		require.Equal(t, "keda module: installation state: Error\n", buffer.String())
+	})
+
+	t.Run("wait failed", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnWaitForModuleErr: errors.New("context deadline exceeded"),
+				ReturnModuleInfo: kyma.KymaModuleInfo{
+					Status: kyma.ModuleStatus{Name: "keda", State: "Processing"},
+				},
+			},
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{},
+		}
+
+		clierr := waitForModuleState(out.NewToWriter(buffer), context.Background(), &client, "keda", time.Minute, "Ready", "Warning")
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to wait for the keda module")
+		require.Contains(t, clierr.String(), "context deadline exceeded")
+		require.Contains(t, buffer.String(), "keda module: installation state: Processing\n")
+	})
+}
+
+func TestWaitForCommunityModule(t *testing.T) {
+	t.Run("community module ready", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		moduleCR := testKedaCR.DeepCopy()
+		moduleCR.Object["status"] = map[string]any{"state": "Ready"}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnGetObj: testReadyManager,
+				ReturnListObjs: &unstructured.UnstructuredList{
+					Items: []unstructured.Unstructured{*moduleCR},
+				},
+			},
+		}
+
+		clierr := waitForCommunityModule(out.NewToWriter(buffer), context.Background(), &client, &testWaitModuleTemplate, time.Minute, communityModuleReady)
+		require.Nil(t, clierr)
+		require.Equal(t, "keda module: module CR state: Ready, manager replicas: 1/1\n", buffer.String())
+	})
+
+	t.Run("community module CR goes to the Error state", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		processingCR := testKedaCR.DeepCopy()
+		processingCR.Object["status"] = map[string]any{"state": "Processing"}
+		errorCR := testKedaCR.DeepCopy()
+		errorCR.Object["status"] = map[string]any{"state": "Error"}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &sequenceRootlessDynamicClient{
+				RootlessDynamicClient: fake.RootlessDynamicClient{
+					ReturnGetObj:  testReadyManager,
+					ReturnWatcher: newTestWatcher(1),
+				},
+				lists: []unstructured.UnstructuredList{
+					{Items: []unstructured.Unstructured{*processingCR}},
+					{Items: []unstructured.Unstructured{*errorCR}},
+				},
+			},
+		}
+
+		clierr := waitForCommunityModule(out.NewToWriter(buffer), context.Background(), &client, &testWaitModuleTemplate, time.Minute, communityModuleReady)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "the keda module CR is in the Error state")
+		require.Equal(t, "keda module: module CR state: Processing, manager replicas: 1/1\nkeda module: module CR state: Error, manager replicas: 1/1\n", buffer.String())
+	})
+
+	t.Run("ignore stale community module CR Error state", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		moduleCR := testKedaCR.DeepCopy()
+		moduleCR.Object["status"] = map[string]any{"state": "Error"}
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnGetObj: testReadyManager,
+				ReturnListObjs: &unstructured.UnstructuredList{
+					Items: []unstructured.Unstructured{*moduleCR},
+				},
+			},
+		}
+
+		clierr := waitForCommunityModule(out.NewToWriter(buffer), context.Background(), &client, &testWaitModuleTemplate, 100*time.Millisecond, communityModuleReady)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to wait for the keda module")
+	})
+
+	t.Run("community module removed", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnGetErr:   apierrors.NewNotFound(schema.GroupResource{Group: "apps", Resource: "deployments"}, "keda-manager"),
+				ReturnListObjs: &unstructured.UnstructuredList{},
+			},
+		}
+
+		clierr := waitForCommunityModule(out.NewToWriter(buffer), context.Background(), &client, &testWaitModuleTemplate, time.Minute, communityModuleRemoved)
+		require.Nil(t, clierr)
+		require.Equal(t, "keda module: waiting for the module state\n", buffer.String())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+		client := fake.KubeClient{
+			TestRootlessDynamicInterface: &fake.RootlessDynamicClient{
+				ReturnGetObj:   testReadyManager,
+				ReturnListObjs: &unstructured.UnstructuredList{},
+			},
+		}
+
+		clierr := waitForCommunityModule(out.NewToWriter(buffer), context.Background(), &client, &testWaitModuleTemplate, time.Millisecond, communityModuleRemoved)
+		require.NotNil(t, clierr)
+		require.Contains(t, clierr.String(), "failed to wait for the keda module")
+	})
+}
+
+// sequenceKymaClient returns the next module info or default Kyma CR on every call and waits for the module state until the context is done
+type sequenceKymaClient struct {
+	fake.KymaClient
+	moduleInfos  []kyma.KymaModuleInfo
+	defaultKymas []kyma.Kyma
+}
+
+func (c *sequenceKymaClient) GetDefaultKyma(_ context.Context) (*kyma.Kyma, error) {
+	defaultKyma := c.defaultKymas[0]
+	if len(c.defaultKymas) > 1 {
+		c.defaultKymas = c.defaultKymas[1:]
+	}
+	return &defaultKyma, nil
+}
+
+func (c *sequenceKymaClient) GetModuleInfo(_ context.Context, _ string) (*kyma.KymaModuleInfo, error) {
+	info := c.moduleInfos[0]
+	if len(c.moduleInfos) > 1 {
+		c.moduleInfos = c.moduleInfos[1:]
+	}
+	return &info, nil
+}
+
+func (c *sequenceKymaClient) WaitForModuleState(ctx context.Context, _ string, _ ...string) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// sequenceRootlessDynamicClient returns the next list on every call
+type sequenceRootlessDynamicClient struct {
+	fake.RootlessDynamicClient
+	lists []unstructured.UnstructuredList
+}
+
+func (c *sequenceRootlessDynamicClient) List(_ context.Context, _ *unstructured.Unstructured, _ *rootlessdynamic.ListOptions) (*unstructured.UnstructuredList, error) {
+	list := c.lists[0]
+	if len(c.lists) > 1 {
+		c.lists = c.lists[1:]
+	}
+	return &list, nil
+}
+
+// newTestWatcher returns watcher with the given number of modification events
+func newTestWatcher(events int) *watch.FakeWatcher {
+	watcher := watch.NewFakeWithChanSize(events, false)
+	for range events {
+		watcher.Modify(&unstructured.Unstructured{})
+	}
+	return watcher
+}
+
+func TestModuleCRTimeout(t *testing.T) {
+	require.Equal(t, 10*time.Minute, ModuleCRTimeout(true, 10*time.Minute))
+	require.Equal(t, DefaultModuleCRTimeout, ModuleCRTimeout(false, 10*time.Minute))
+}
@@ -13,6 +13,10 @@ type ModuleOperations interface {
 	Catalog() (*CatalogService, error)
 	Pull() (*PullService, error)
 	List() (*ListService, error)
+	Index() (*IndexService, error)
+	Init() (*InitService, error)
+	Lint() (*LintService, error)
+	Watch() (*WatchService, error)
 }
 
 type moduleOperations struct {
@@ -56,6 +60,50 @@ func (m *moduleOperations) Pull() (*PullService, error) {
 	return pullService, nil
 }
 
+func (m *moduleOperations) Index() (*IndexService, error) {
+	c := setupDIContainer(m.kymaConfig)
+
+	indexService, err := di.GetTyped[*IndexService](c)
+	if err != nil {
+		return nil, errors.New("failed to execute the index command")
+	}
+
+	return indexService, nil
+}
+
+func (m *moduleOperations) Init() (*InitService, error) {
+	c := setupDIContainer(m.kymaConfig)
+
+	initService, err := di.GetTyped[*InitService](c)
+	if err != nil {
+		return nil, errors.New("failed to execute the init command")
+	}
+
+	return initService, nil
+}
+
+func (m *moduleOperations) Lint() (*LintService, error) {
+	c := setupDIContainer(m.kymaConfig)
+
+	lintService, err := di.GetTyped[*LintService](c)
+	if err != nil {
+		return nil, errors.New("failed to execute the lint command")
+	}
+
+	return lintService, nil
+}
+
+func (m *moduleOperations) Watch() (*WatchService, error) {
+	c := setupDIContainer(m.kymaConfig)
+
+	watchService, err := di.GetTyped[*WatchService](c)
+	if err != nil {
+		return nil, errors.New("failed to execute the watch command")
+	}
+
+	return watchService, nil
+}
+
 func setupDIContainer(kymaConfig *cmdcommon.KymaConfig) *di.Container {
 	container := di.NewContainer()
 
@@ -99,6 +147,15 @@ func setupDIContainer(kymaConfig *cmdcommon.KymaConfig) *di.Container {
 		return repository.NewModuleInstallationsRepository(kubeClient), nil
 	})
 
+	di.RegisterTyped(container, func(c *di.Container) (repository.ModuleEventsRepository, error) {
+		kubeClient, err := di.GetTyped[kube.Client](c)
+		if err != nil {
+			return nil, err
+		}
+
+		return repository.NewModuleEventsRepository(kubeClient), nil
+	})
+
 	// Services:
 
 	di.RegisterTyped(container, func(c *di.Container) (*CatalogService, error) {
@@ -130,7 +187,52 @@ func setupDIContainer(kymaConfig *cmdcommon.KymaConfig) *di.Container {
 			return nil, err
 		}
 
-		return NewListService(installedModulesRepo), nil
+		return NewListServiceWithContexts(installedModulesRepo, func(kubeContext string) (repository.ModuleInstallationsRepository, error) {
+			kubeClient, err := cmdcommon.NewKubeClientForContext(kubeContext)
+			if err != nil {
+				return nil, err
+			}
+
+			return repository.NewModuleInstallationsRepository(kubeClient), nil
+		}), nil
+	})
+
+	di.RegisterTyped(container, func(c *di.Container) (*IndexService, error) {
+		externalRepo, err := di.GetTyped[repository.ExternalModuleTemplateRepository](c)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewIndexService(externalRepo), nil
+	})
+
+	di.RegisterTyped(container, func(c *di.Container) (*InitService, error) {
+		return NewInitService(), nil
+	})
+
+	di.RegisterTyped(container, func(c *di.Container) (*LintService, error) {
+		externalRepo, err := di.GetTyped[repository.ExternalModuleTemplateRepository](c)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewLintService(externalRepo, func() (repository.ModuleTemplatesRepository, error) {
+			return di.GetTyped[repository.ModuleTemplatesRepository](c)
+		}), nil
+	})
+
+	di.RegisterTyped(container, func(c *di.Container) (*WatchService, error) {
+		installedModulesRepo, err := di.GetTyped[repository.ModuleInstallationsRepository](c)
+		if err != nil {
+			return nil, err
+		}
+
+		moduleEventsRepo, err := di.GetTyped[repository.ModuleEventsRepository](c)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewWatchService(installedModulesRepo, moduleEventsRepo), nil
 	})
 
 	return container
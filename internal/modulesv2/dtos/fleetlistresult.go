@@ -0,0 +1,81 @@
+package dtos
+
+import "sort"
+
+type FleetListResult struct {
+	Contexts []ContextListResult
+}
+
+// ContextListResult contains modules installed in the cluster of the kubeconfig context
+type ContextListResult struct {
+	Context          string
+	Modules          []ListResult
+	CommunityModules []CommunityListResult
+	Err              error
+}
+
+// FleetModuleResult contains the module installed in clusters of kubeconfig contexts
+type FleetModuleResult struct {
+	Name          string
+	Installations map[string]ListResult
+}
+
+// HasVersionDrift returns true if the module is installed in different versions across clusters
+func (r *FleetModuleResult) HasVersionDrift() bool {
+	version := ""
+	for _, installation := range r.Installations {
+		if version != "" && installation.Version != version {
+			return true
+		}
+		version = installation.Version
+	}
+
+	return false
+}
+
+// Modules groups core modules from all clusters by name
+func (r *FleetListResult) Modules() []FleetModuleResult {
+	return groupFleetModules(r.Contexts, func(result ContextListResult) []ListResult {
+		return result.Modules
+	})
+}
+
+// CommunityModules groups community modules from all clusters by namespaced name
+func (r *FleetListResult) CommunityModules() []FleetModuleResult {
+	return groupFleetModules(r.Contexts, func(result ContextListResult) []ListResult {
+		modules := make([]ListResult, len(result.CommunityModules))
+		for i, m := range result.CommunityModules {
+			modules[i] = ListResult{
+				Name:              m.Name,
+				Version:           m.Version,
+				ModuleState:       m.ModuleState,
+				InstallationState: m.InstallationState,
+			}
+		}
+		return modules
+	})
+}
+
+func groupFleetModules(results []ContextListResult, modulesOf func(ContextListResult) []ListResult) []FleetModuleResult {
+	byName := map[string]*FleetModuleResult{}
+	for _, result := range results {
+		for _, module := range modulesOf(result) {
+			fleetModule, ok := byName[module.Name]
+			if !ok {
+				fleetModule = &FleetModuleResult{Name: module.Name, Installations: map[string]ListResult{}}
+				byName[module.Name] = fleetModule
+			}
+			fleetModule.Installations[result.Context] = module
+		}
+	}
+
+	fleetModules := make([]FleetModuleResult, 0, len(byName))
+	for _, fleetModule := range byName {
+		fleetModules = append(fleetModules, *fleetModule)
+	}
+	sort.Slice(fleetModules, func(i, j int) bool {
+		return fleetModules[i].Name < fleetModules[j].Name
+	})
+
+	return fleetModules
+}
@@ -0,0 +1,17 @@
+package dtos
+
+type IndexConfig struct {
+	ManifestsDir  string
+	IndexPath     string
+	Merge         bool
+	SkipLinkCheck bool
+}
+
+func NewIndexConfig(manifestsDir, indexPath string, merge, skipLinkCheck bool) *IndexConfig {
+	return &IndexConfig{
+		ManifestsDir:  manifestsDir,
+		IndexPath:     indexPath,
+		Merge:         merge,
+		SkipLinkCheck: skipLinkCheck,
+	}
+}
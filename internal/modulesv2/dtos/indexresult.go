@@ -0,0 +1,10 @@
+package dtos
+
+type IndexResult struct {
+	IndexPath string
+	// Added and Updated contain module versions in format <module-name>:<version>
+	Added            []string
+	Updated          []string
+	Total            int
+	ValidationErrors []string
+}
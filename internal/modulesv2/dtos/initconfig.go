@@ -0,0 +1,16 @@
+package dtos
+
+import "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+type InitConfig struct {
+	ModuleName    string
+	Version       string
+	ResourceLink  string
+	ManagerName   string
+	Repository    string
+	Documentation string
+	// Resources of the module bundle with the manager and CRDs
+	Resources []unstructured.Unstructured
+	// SampleCR is used as the default module configuration
+	SampleCR *unstructured.Unstructured
+}
@@ -0,0 +1,25 @@
+package dtos
+
+type LintConfig struct {
+	// Path to the module templates file or directory, used when Name is empty
+	Path string
+	// Namespace and Name of the module template in the cluster
+	Namespace     string
+	Name          string
+	SkipLinkCheck bool
+}
+
+func NewLintConfig(path string, skipLinkCheck bool) *LintConfig {
+	return &LintConfig{
+		Path:          path,
+		SkipLinkCheck: skipLinkCheck,
+	}
+}
+
+func NewClusterLintConfig(namespace, name string, skipLinkCheck bool) *LintConfig {
+	return &LintConfig{
+		Namespace:     namespace,
+		Name:          name,
+		SkipLinkCheck: skipLinkCheck,
+	}
+}
@@ -0,0 +1,32 @@
+package dtos
+
+const (
+	LintLevelError   = "error"
+	LintLevelWarning = "warning"
+)
+
+type LintResult struct {
+	// Source is the path or the namespace/name of the linted module templates
+	Source          string
+	ModuleTemplates int
+	Findings        []LintFinding
+}
+
+type LintFinding struct {
+	RuleID         string
+	Level          string
+	ModuleTemplate string
+	Message        string
+}
+
+// Errors returns the number of findings that fail the lint
+func (r *LintResult) Errors() int {
+	errors := 0
+	for _, finding := range r.Findings {
+		if finding.Level == LintLevelError {
+			errors++
+		}
+	}
+
+	return errors
+}
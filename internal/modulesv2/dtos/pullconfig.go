@@ -5,9 +5,11 @@ type PullConfig struct {
 	ModuleName          string
 	Version             string
 	RemoteRepositoryUrl string
+	// ResourceMirrors maps original prefixes of resource links to prefixes of local mirrors
+	ResourceMirrors map[string]string
 }
 
-func NewPullConfig(moduleName, namespace, remote, version string) *PullConfig {
+func NewPullConfig(moduleName, namespace, remote, version string, resourceMirrors map[string]string) *PullConfig {
 	var remoteRepo string
 
 	if remote == "" {
@@ -21,5 +23,6 @@ func NewPullConfig(moduleName, namespace, remote, version string) *PullConfig {
 		Namespace:           namespace,
 		Version:             version,
 		RemoteRepositoryUrl: remoteRepo,
+		ResourceMirrors:     resourceMirrors,
 	}
 }
@@ -0,0 +1,91 @@
+package dtos
+
+const (
+	WatchEventAdded    = "Added"
+	WatchEventModified = "Modified"
+	WatchEventDeleted  = "Deleted"
+)
+
+// WatchResult contains installed modules and changes since the previous result
+type WatchResult struct {
+	Modules          []ListResult
+	CommunityModules []CommunityListResult
+	Events           []WatchEvent
+}
+
+type WatchEvent struct {
+	Type              string
+	Name              string
+	Version           string
+	Channel           string
+	ModuleState       string
+	InstallationState string
+	Community         bool
+}
+
+// NewWatchResult compares installed modules with the previous result
+// all modules are reported as added if there is no previous result
+func NewWatchResult(previous *WatchResult, modules []ListResult, communityModules []CommunityListResult) *WatchResult {
+	if previous == nil {
+		previous = &WatchResult{}
+	}
+
+	events := diffModules(previous.Modules, modules, func(m ListResult) string { return m.Name }, moduleEvent)
+	events = append(events, diffModules(previous.CommunityModules, communityModules, func(m CommunityListResult) string { return m.Name }, communityModuleEvent)...)
+
+	return &WatchResult{
+		Modules:          modules,
+		CommunityModules: communityModules,
+		Events:           events,
+	}
+}
+
+func diffModules[T comparable](previous, current []T, name func(T) string, event func(string, T) WatchEvent) []WatchEvent {
+	previousByName := make(map[string]T, len(previous))
+	for _, module := range previous {
+		previousByName[name(module)] = module
+	}
+
+	events := []WatchEvent{}
+	currentNames := make(map[string]bool, len(current))
+	for _, module := range current {
+		currentNames[name(module)] = true
+
+		previousModule, found := previousByName[name(module)]
+		if !found {
+			events = append(events, event(WatchEventAdded, module))
+		} else if previousModule != module {
+			events = append(events, event(WatchEventModified, module))
+		}
+	}
+
+	for _, module := range previous {
+		if !currentNames[name(module)] {
+			events = append(events, event(WatchEventDeleted, module))
+		}
+	}
+
+	return events
+}
+
+func moduleEvent(eventType string, module ListResult) WatchEvent {
+	return WatchEvent{
+		Type:              eventType,
+		Name:              module.Name,
+		Version:           module.Version,
+		Channel:           module.Channel,
+		ModuleState:       module.ModuleState,
+		InstallationState: module.InstallationState,
+	}
+}
+
+func communityModuleEvent(eventType string, module CommunityListResult) WatchEvent {
+	return WatchEvent{
+		Type:              eventType,
+		Name:              module.Name,
+		Version:           module.Version,
+		ModuleState:       module.ModuleState,
+		InstallationState: module.InstallationState,
+		Community:         true,
+	}
+}
@@ -0,0 +1,57 @@
+package dtos_test
+
+import (
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWatchResult(t *testing.T) {
+	t.Run("reports all modules as added without the previous result", func(t *testing.T) {
+		result := dtos.NewWatchResult(nil,
+			[]dtos.ListResult{{Name: "keda", Version: "1.0.0", Channel: "regular", ModuleState: "Ready", InstallationState: "Ready"}},
+			[]dtos.CommunityListResult{{Name: "default/cap", Version: "0.1.0", ModuleState: "Processing", InstallationState: "Ready"}},
+		)
+
+		require.Equal(t, []dtos.WatchEvent{
+			{Type: dtos.WatchEventAdded, Name: "keda", Version: "1.0.0", Channel: "regular", ModuleState: "Ready", InstallationState: "Ready"},
+			{Type: dtos.WatchEventAdded, Name: "default/cap", Version: "0.1.0", ModuleState: "Processing", InstallationState: "Ready", Community: true},
+		}, result.Events)
+	})
+
+	t.Run("reports changed, added, and deleted modules", func(t *testing.T) {
+		previous := dtos.NewWatchResult(nil,
+			[]dtos.ListResult{
+				{Name: "keda", Version: "1.0.0", ModuleState: "Processing", InstallationState: "Ready"},
+				{Name: "serverless", Version: "1.2.0", ModuleState: "Ready", InstallationState: "Ready"},
+				{Name: "api-gateway", Version: "3.0.0", ModuleState: "Ready", InstallationState: "Ready"},
+			},
+			[]dtos.CommunityListResult{{Name: "default/cap", Version: "0.1.0", ModuleState: "Ready", InstallationState: "Ready"}},
+		)
+
+		result := dtos.NewWatchResult(previous,
+			[]dtos.ListResult{
+				{Name: "keda", Version: "1.0.0", ModuleState: "Ready", InstallationState: "Ready"},
+				{Name: "serverless", Version: "1.2.0", ModuleState: "Ready", InstallationState: "Ready"},
+				{Name: "istio", Version: "1.5.0", ModuleState: "", InstallationState: "Processing"},
+			},
+			[]dtos.CommunityListResult{{Name: "default/cap", Version: "0.1.0", ModuleState: "Ready", InstallationState: "Ready"}},
+		)
+
+		require.Equal(t, []dtos.WatchEvent{
+			{Type: dtos.WatchEventModified, Name: "keda", Version: "1.0.0", ModuleState: "Ready", InstallationState: "Ready"},
+			{Type: dtos.WatchEventAdded, Name: "istio", Version: "1.5.0", InstallationState: "Processing"},
+			{Type: dtos.WatchEventDeleted, Name: "api-gateway", Version: "3.0.0", ModuleState: "Ready", InstallationState: "Ready"},
+		}, result.Events)
+	})
+
+	t.Run("no events without changes", func(t *testing.T) {
+		modules := []dtos.ListResult{{Name: "keda", Version: "1.0.0", ModuleState: "Ready", InstallationState: "Ready"}}
+		previous := dtos.NewWatchResult(nil, modules, nil)
+
+		result := dtos.NewWatchResult(previous, modules, nil)
+
+		require.Empty(t, result.Events)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modules/source"
 )
 
 var prohibitedNamespaces = []string{"kyma-system"}
@@ -31,11 +32,43 @@ func NewExternalModuleTemplateFromRaw(rawModuleTemplate *kyma.ModuleTemplate) *E
 
 // SetNamespace saves information about a namespace in which the external module is going to be stored.
 func (mt *ExternalModuleTemplate) SetNamespace(namespace string) error {
+	if err := ValidateNamespace(namespace); err != nil {
+		return err
+	}
+
+	mt.Namespace = namespace
+
+	return nil
+}
+
+// ValidateNamespace returns an error if the namespace can't be used by community modules
+func ValidateNamespace(namespace string) error {
 	if slices.Contains(prohibitedNamespaces, namespace) {
 		return fmt.Errorf("'%s' namespace is not allowed", namespace)
 	}
 
-	mt.Namespace = namespace
+	return nil
+}
+
+// RewriteResourceLinks replaces prefixes of resource links in the stored definition with mirrors
+func (mt *ExternalModuleTemplate) RewriteResourceLinks(mirrors map[string]string) error {
+	if len(mirrors) == 0 {
+		return nil
+	}
+
+	var rawModuleTemplate kyma.ModuleTemplate
+	if err := json.Unmarshal([]byte(mt.JsonDefinition), &rawModuleTemplate); err != nil {
+		return fmt.Errorf("failed to parse module template definition: %v", err)
+	}
+
+	source.RewriteResourceLinks(&rawModuleTemplate, mirrors)
+
+	serializedTemplate, err := json.Marshal(&rawModuleTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to serialize module template definition: %v", err)
+	}
+
+	mt.JsonDefinition = string(serializedTemplate)
 
 	return nil
 }
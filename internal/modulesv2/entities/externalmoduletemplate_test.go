@@ -0,0 +1,39 @@
+package entities
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestExternalModuleTemplate_RewriteResourceLinks(t *testing.T) {
+	externalModule := NewExternalModuleTemplateFromRaw(&kyma.ModuleTemplate{
+		Spec: kyma.ModuleTemplateSpec{
+			ModuleName: "sample-module",
+			Data: unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "operator.kyma-project.io/v1alpha1",
+				"kind":       "Sample",
+			}},
+			Resources: []kyma.Resource{
+				{Name: "rawManifest", Link: "https://github.com/kyma-project/sample/releases/download/1.0.0/sample.yaml"},
+			},
+		},
+	})
+
+	err := externalModule.RewriteResourceLinks(map[string]string{"https://github.com/": "file:///mirror/"})
+	require.NoError(t, err)
+
+	var rawModuleTemplate kyma.ModuleTemplate
+	require.NoError(t, json.Unmarshal([]byte(externalModule.JsonDefinition), &rawModuleTemplate))
+	require.Equal(t, "file:///mirror/kyma-project/sample/releases/download/1.0.0/sample.yaml", rawModuleTemplate.Spec.Resources[0].Link)
+}
+
+func TestExternalModuleTemplate_RewriteResourceLinks_NoMirrors(t *testing.T) {
+	externalModule := &ExternalModuleTemplate{JsonDefinition: "not json"}
+
+	require.NoError(t, externalModule.RewriteResourceLinks(nil))
+	require.Equal(t, "not json", externalModule.JsonDefinition)
+}
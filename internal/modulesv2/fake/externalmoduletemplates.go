@@ -1,12 +1,51 @@
 package fake
 
-import "github.com/kyma-project/cli.v3/internal/kube/kyma"
+import (
+	"fmt"
+
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+)
 
 type ExternalModuleTemplatesRepository struct {
 	Modules []kyma.ModuleTemplate
 	Err     error
+
+	// Modules returned for specific urls, Modules are returned for others
+	ModulesByUrl map[string][]kyma.ModuleTemplate
+
+	Resources   map[string][]byte
+	ResourceErr error
+
+	SavedPath    string
+	SavedModules []kyma.ModuleTemplate
+	SaveErr      error
 }
 
-func (r *ExternalModuleTemplatesRepository) Get(_ []string) ([]kyma.ModuleTemplate, error) {
+func (r *ExternalModuleTemplatesRepository) Get(urls []string) ([]kyma.ModuleTemplate, error) {
+	if len(urls) == 1 && r.ModulesByUrl != nil {
+		if modules, ok := r.ModulesByUrl[urls[0]]; ok {
+			return modules, r.Err
+		}
+	}
+
 	return r.Modules, r.Err
 }
+
+func (r *ExternalModuleTemplatesRepository) GetResource(link string) ([]byte, error) {
+	if r.ResourceErr != nil {
+		return nil, r.ResourceErr
+	}
+
+	resource, ok := r.Resources[link]
+	if !ok {
+		return nil, fmt.Errorf("resource %s not found", link)
+	}
+
+	return resource, nil
+}
+
+func (r *ExternalModuleTemplatesRepository) Save(path string, moduleTemplates []kyma.ModuleTemplate) error {
+	r.SavedPath = path
+	r.SavedModules = moduleTemplates
+	return r.SaveErr
+}
@@ -0,0 +1,17 @@
+package fake
+
+import (
+	"context"
+)
+
+type ModuleEventsRepository struct {
+	WatchResult chan struct{}
+	WatchError  error
+}
+
+func (f *ModuleEventsRepository) Watch(_ context.Context) (<-chan struct{}, error) {
+	if f.WatchError != nil {
+		return nil, f.WatchError
+	}
+	return f.WatchResult, nil
+}
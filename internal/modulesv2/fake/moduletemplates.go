@@ -3,6 +3,7 @@ package fake
 import (
 	"context"
 
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
 	"github.com/kyma-project/cli.v3/internal/modulesv2/entities"
 )
 
@@ -13,6 +14,8 @@ type ModuleTemplatesRepository struct {
 	ListLocalCommunityError     error
 	ListExternalCommunityResult []*entities.ExternalModuleTemplate
 	ListExternalCommunityError  error
+	GetLocalResult              *kyma.ModuleTemplate
+	GetLocalError               error
 	GetLocalCommunityResult     *entities.CommunityModuleTemplate
 	GetLocalCommunityError      error
 	SaveCommunityModuleError    error
@@ -30,6 +33,10 @@ func (m *ModuleTemplatesRepository) ListExternalCommunity(_ context.Context, _ [
 	return m.ListExternalCommunityResult, m.ListExternalCommunityError
 }
 
+func (m *ModuleTemplatesRepository) GetLocal(_ context.Context, _, _ string) (*kyma.ModuleTemplate, error) {
+	return m.GetLocalResult, m.GetLocalError
+}
+
 func (m *ModuleTemplatesRepository) GetLocalCommunity(_ context.Context, _, _ string) (*entities.CommunityModuleTemplate, error) {
 	return m.GetLocalCommunityResult, m.GetLocalCommunityError
 }
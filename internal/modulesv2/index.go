@@ -0,0 +1,233 @@
+package modulesv2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	semver "github.com/Masterminds/semver/v3"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/repository"
+	"gopkg.in/yaml.v3"
+)
+
+type IndexService struct {
+	externalModuleTemplateRepository repository.ExternalModuleTemplateRepository
+}
+
+func NewIndexService(
+	externalModuleTemplateRepository repository.ExternalModuleTemplateRepository,
+) *IndexService {
+	return &IndexService{
+		externalModuleTemplateRepository: externalModuleTemplateRepository,
+	}
+}
+
+// Run validates module templates from the manifests directory and writes them to the index file
+// the index file is not written if any module template is invalid
+func (s *IndexService) Run(indexConfig *dtos.IndexConfig) (*dtos.IndexResult, error) {
+	moduleTemplates, err := s.externalModuleTemplateRepository.Get([]string{indexConfig.ManifestsDir})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read module templates: %v", err)
+	}
+
+	if len(moduleTemplates) == 0 {
+		return nil, fmt.Errorf("no module templates found in the %s directory", indexConfig.ManifestsDir)
+	}
+
+	result := &dtos.IndexResult{
+		IndexPath:        indexConfig.IndexPath,
+		ValidationErrors: s.validateModuleTemplates(moduleTemplates, indexConfig.SkipLinkCheck),
+	}
+	if len(result.ValidationErrors) > 0 {
+		return result, nil
+	}
+
+	indexedTemplates := []kyma.ModuleTemplate{}
+	if indexConfig.Merge {
+		indexedTemplates, err = s.externalModuleTemplateRepository.Get([]string{indexConfig.IndexPath})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the existing index: %v", err)
+		}
+	}
+
+	for _, moduleTemplate := range moduleTemplates {
+		key := moduleVersionKey(&moduleTemplate)
+		i := slices.IndexFunc(indexedTemplates, func(indexed kyma.ModuleTemplate) bool {
+			return moduleVersionKey(&indexed) == key
+		})
+
+		if i == -1 {
+			indexedTemplates = append(indexedTemplates, moduleTemplate)
+			result.Added = append(result.Added, key)
+		} else {
+			indexedTemplates[i] = moduleTemplate
+			result.Updated = append(result.Updated, key)
+		}
+	}
+
+	sortModuleTemplates(indexedTemplates)
+
+	if err := s.externalModuleTemplateRepository.Save(indexConfig.IndexPath, indexedTemplates); err != nil {
+		return nil, fmt.Errorf("failed to save the index: %v", err)
+	}
+
+	result.Total = len(indexedTemplates)
+	return result, nil
+}
+
+func (s *IndexService) validateModuleTemplates(moduleTemplates []kyma.ModuleTemplate, skipLinkCheck bool) []string {
+	validationErrors := []string{}
+	seen := map[string]bool{}
+
+	for i, moduleTemplate := range moduleTemplates {
+		prefix := moduleTemplate.GetName()
+		if prefix == "" {
+			prefix = fmt.Sprintf("module template %d", i+1)
+		}
+
+		errs := validateModuleTemplateSpec(&moduleTemplate)
+		if len(errs) == 0 && !skipLinkCheck {
+			errs = s.validateModuleTemplateResources(&moduleTemplate)
+		}
+
+		key := moduleVersionKey(&moduleTemplate)
+		if seen[key] {
+			errs = append(errs, fmt.Errorf("version %s of the %s module is defined more than once", moduleTemplate.Spec.Version, moduleTemplate.Spec.ModuleName))
+		}
+		seen[key] = true
+
+		for _, err := range errs {
+			validationErrors = append(validationErrors, fmt.Sprintf("%s: %v", prefix, err))
+		}
+	}
+
+	return validationErrors
+}
+
+func validateModuleTemplateSpec(moduleTemplate *kyma.ModuleTemplate) []error {
+	errs := []error{}
+	if moduleTemplate.GetName() == "" {
+		errs = append(errs, errors.New("metadata.name is required"))
+	}
+
+	if moduleTemplate.Spec.ModuleName == "" {
+		errs = append(errs, errors.New("spec.moduleName is required"))
+	}
+
+	if moduleTemplate.Spec.Version == "" {
+		errs = append(errs, errors.New("spec.version is required"))
+	} else if _, err := semver.StrictNewVersion(moduleTemplate.Spec.Version); err != nil {
+		errs = append(errs, fmt.Errorf("spec.version '%s' is not a valid semantic version", moduleTemplate.Spec.Version))
+	}
+
+	manager := moduleTemplate.Spec.Manager
+	if manager == nil {
+		errs = append(errs, errors.New("spec.manager is required"))
+	} else if manager.Name == "" || manager.Kind == "" || manager.Version == "" {
+		errs = append(errs, errors.New("spec.manager must contain name, kind and version"))
+	}
+
+	if !slices.ContainsFunc(moduleTemplate.Spec.Resources, func(resource kyma.Resource) bool {
+		return resource.Name == "rawManifest"
+	}) {
+		errs = append(errs, errors.New("spec.resources must contain the rawManifest resource"))
+	}
+
+	for _, resource := range moduleTemplate.Spec.Resources {
+		if resource.Link == "" {
+			errs = append(errs, fmt.Errorf("link of the %s resource is required", resource.Name))
+		}
+	}
+
+	return errs
+}
+
+// validateModuleTemplateResources checks that all resource links can be downloaded
+// and the manager is one of the resources
+func (s *IndexService) validateModuleTemplateResources(moduleTemplate *kyma.ModuleTemplate) []error {
+	errs := []error{}
+	managerFound := false
+
+	for _, resource := range moduleTemplate.Spec.Resources {
+		data, err := s.externalModuleTemplateRepository.GetResource(resource.Link)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to resolve the %s resource link: %v", resource.Name, err))
+			continue
+		}
+
+		if resource.Name != "rawManifest" {
+			continue
+		}
+
+		found, err := containsManager(data, moduleTemplate.Spec.Manager)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to parse the %s resource: %v", resource.Name, err))
+		}
+		managerFound = managerFound || found
+	}
+
+	if len(errs) == 0 && !managerFound {
+		manager := moduleTemplate.Spec.Manager
+		errs = append(errs, fmt.Errorf("manager %s %s not found in the rawManifest resource", manager.Kind, manager.Name))
+	}
+
+	return errs
+}
+
+func containsManager(manifest []byte, manager *kyma.Manager) (bool, error) {
+	apiVersion := manager.Version
+	if manager.Group != "" {
+		apiVersion = fmt.Sprintf("%s/%s", manager.Group, manager.Version)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(manifest))
+	for {
+		var resource struct {
+			APIVersion string `yaml:"apiVersion"`
+			Kind       string `yaml:"kind"`
+			Metadata   struct {
+				Name      string `yaml:"name"`
+				Namespace string `yaml:"namespace"`
+			} `yaml:"metadata"`
+		}
+
+		err := decoder.Decode(&resource)
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		if resource.APIVersion == apiVersion && resource.Kind == manager.Kind && resource.Metadata.Name == manager.Name &&
+			(manager.Namespace == "" || resource.Metadata.Namespace == manager.Namespace) {
+			return true, nil
+		}
+	}
+}
+
+func moduleVersionKey(moduleTemplate *kyma.ModuleTemplate) string {
+	return fmt.Sprintf("%s:%s", moduleTemplate.Spec.ModuleName, moduleTemplate.Spec.Version)
+}
+
+// sortModuleTemplates sorts by module name and version to keep the index stable
+func sortModuleTemplates(moduleTemplates []kyma.ModuleTemplate) {
+	slices.SortStableFunc(moduleTemplates, func(a, b kyma.ModuleTemplate) int {
+		if byName := strings.Compare(a.Spec.ModuleName, b.Spec.ModuleName); byName != 0 {
+			return byName
+		}
+
+		aVersion, aErr := semver.NewVersion(a.Spec.Version)
+		bVersion, bErr := semver.NewVersion(b.Spec.Version)
+		if aErr != nil || bErr != nil {
+			return strings.Compare(a.Spec.Version, b.Spec.Version)
+		}
+
+		return aVersion.Compare(bVersion)
+	})
+}
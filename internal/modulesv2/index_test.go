@@ -0,0 +1,133 @@
+package modulesv2_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modulesv2"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
+	modulesfake "github.com/kyma-project/cli.v3/internal/modulesv2/fake"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testManagerManifest = `apiVersion: v1
+kind: Namespace
+metadata:
+  name: sample-system
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: sample-manager
+  namespace: sample-system
+`
+
+func indexModuleTemplate(version string) kyma.ModuleTemplate {
+	return kyma.ModuleTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-" + version},
+		Spec: kyma.ModuleTemplateSpec{
+			ModuleName: "sample",
+			Version:    version,
+			Manager: &kyma.Manager{
+				GroupVersionKind: metav1.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+				Namespace:        "sample-system",
+				Name:             "sample-manager",
+			},
+			Resources: []kyma.Resource{
+				{Name: "rawManifest", Link: "https://example.com/" + version + "/sample.yaml"},
+			},
+		},
+	}
+}
+
+func TestIndexService_Run(t *testing.T) {
+	resources := map[string][]byte{
+		"https://example.com/1.0.0/sample.yaml": []byte(testManagerManifest),
+		"https://example.com/1.1.0/sample.yaml": []byte(testManagerManifest),
+	}
+
+	t.Run("write new index", func(t *testing.T) {
+		repo := &modulesfake.ExternalModuleTemplatesRepository{
+			Modules:   []kyma.ModuleTemplate{indexModuleTemplate("1.1.0"), indexModuleTemplate("1.0.0")},
+			Resources: resources,
+		}
+
+		result, err := modulesv2.NewIndexService(repo).Run(dtos.NewIndexConfig("./templates", "all-modules.json", false, false))
+		require.NoError(t, err)
+		require.Empty(t, result.ValidationErrors)
+		require.Equal(t, []string{"sample:1.1.0", "sample:1.0.0"}, result.Added)
+		require.Equal(t, 2, result.Total)
+		require.Equal(t, "all-modules.json", repo.SavedPath)
+		require.Equal(t, "1.0.0", repo.SavedModules[0].Spec.Version)
+		require.Equal(t, "1.1.0", repo.SavedModules[1].Spec.Version)
+	})
+
+	t.Run("merge into existing index", func(t *testing.T) {
+		updated := indexModuleTemplate("1.0.0")
+		updated.Spec.Info.Repository = "https://example.com/sample"
+		other := indexModuleTemplate("0.1.0")
+		other.Spec.ModuleName = "other"
+		repo := &modulesfake.ExternalModuleTemplatesRepository{
+			ModulesByUrl: map[string][]kyma.ModuleTemplate{
+				"./templates":      {updated, indexModuleTemplate("1.1.0")},
+				"all-modules.json": {indexModuleTemplate("1.0.0"), other},
+			},
+			Resources: resources,
+		}
+
+		result, err := modulesv2.NewIndexService(repo).Run(dtos.NewIndexConfig("./templates", "all-modules.json", true, false))
+		require.NoError(t, err)
+		require.Equal(t, []string{"sample:1.1.0"}, result.Added)
+		require.Equal(t, []string{"sample:1.0.0"}, result.Updated)
+		require.Equal(t, 3, result.Total)
+		require.Equal(t, "other", repo.SavedModules[0].Spec.ModuleName)
+		require.Equal(t, "https://example.com/sample", repo.SavedModules[1].Spec.Info.Repository)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		invalidVersion := indexModuleTemplate("v1")
+		missingManager := indexModuleTemplate("1.0.0")
+		missingManager.Spec.Manager.Name = "other-manager"
+		unresolvable := indexModuleTemplate("1.2.0")
+		repo := &modulesfake.ExternalModuleTemplatesRepository{
+			Modules:   []kyma.ModuleTemplate{invalidVersion, missingManager, unresolvable, {}},
+			Resources: resources,
+		}
+
+		result, err := modulesv2.NewIndexService(repo).Run(dtos.NewIndexConfig("./templates", "all-modules.json", false, false))
+		require.NoError(t, err)
+		require.Equal(t, []string{
+			"sample-v1: spec.version 'v1' is not a valid semantic version",
+			"sample-1.0.0: manager Deployment other-manager not found in the rawManifest resource",
+			"sample-1.2.0: failed to resolve the rawManifest resource link: resource https://example.com/1.2.0/sample.yaml not found",
+			"module template 4: metadata.name is required",
+			"module template 4: spec.moduleName is required",
+			"module template 4: spec.version is required",
+			"module template 4: spec.manager is required",
+			"module template 4: spec.resources must contain the rawManifest resource",
+		}, result.ValidationErrors)
+		require.Empty(t, repo.SavedPath)
+	})
+
+	t.Run("duplicated version", func(t *testing.T) {
+		repo := &modulesfake.ExternalModuleTemplatesRepository{
+			Modules: []kyma.ModuleTemplate{indexModuleTemplate("1.0.0"), indexModuleTemplate("1.0.0")},
+		}
+
+		result, err := modulesv2.NewIndexService(repo).Run(dtos.NewIndexConfig("./templates", "all-modules.json", false, true))
+		require.NoError(t, err)
+		require.Equal(t, []string{"sample-1.0.0: version 1.0.0 of the sample module is defined more than once"}, result.ValidationErrors)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		repo := &modulesfake.ExternalModuleTemplatesRepository{
+			Err: errors.New("directory not found"),
+		}
+
+		result, err := modulesv2.NewIndexService(repo).Run(dtos.NewIndexConfig("./templates", "all-modules.json", false, false))
+		require.EqualError(t, err, "failed to read module templates: directory not found")
+		require.Nil(t, result)
+	})
+}
@@ -0,0 +1,225 @@
+package modulesv2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
+	"gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	moduleTemplateAPIVersion = "operator.kyma-project.io/v1beta2"
+	moduleTemplateKind       = "ModuleTemplate"
+	moduleNameLabel          = "operator.kyma-project.io/module-name"
+)
+
+type InitService struct{}
+
+func NewInitService() *InitService {
+	return &InitService{}
+}
+
+// Run generates the community module template from the module bundle and the sample CR
+func (s *InitService) Run(initConfig *dtos.InitConfig) (*kyma.ModuleTemplate, error) {
+	if initConfig.ModuleName == "" {
+		return nil, errors.New("module name is required")
+	}
+
+	if initConfig.Version == "" {
+		return nil, errors.New("module version is required")
+	}
+
+	if initConfig.ResourceLink == "" {
+		return nil, errors.New("link to the module resources is required")
+	}
+
+	manager, err := s.findManager(initConfig.Resources, initConfig.ManagerName)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleTemplate := &kyma.ModuleTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: moduleTemplateAPIVersion,
+			Kind:       moduleTemplateKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s-%s", initConfig.ModuleName, initConfig.Version),
+			Labels: map[string]string{
+				moduleNameLabel: initConfig.ModuleName,
+			},
+		},
+		Spec: kyma.ModuleTemplateSpec{
+			ModuleName: initConfig.ModuleName,
+			Version:    initConfig.Version,
+			Manager: &kyma.Manager{
+				GroupVersionKind: toGroupVersionKind(manager),
+				Namespace:        manager.GetNamespace(),
+				Name:             manager.GetName(),
+			},
+			Resources: []kyma.Resource{
+				{Name: "rawManifest", Link: initConfig.ResourceLink},
+			},
+			Info: kyma.ModuleInfo{
+				Repository:    initConfig.Repository,
+				Documentation: initConfig.Documentation,
+			},
+		},
+	}
+
+	configKind := ""
+	if initConfig.SampleCR != nil {
+		moduleTemplate.Spec.Data = *cleanSampleCR(initConfig.SampleCR)
+		configKind = initConfig.SampleCR.GetKind()
+	}
+
+	moduleTemplate.Spec.AssociatedResources = associatedResources(initConfig.Resources, configKind)
+
+	return moduleTemplate, nil
+}
+
+// ManagerCandidates returns names of deployments from the bundle that can be the module manager
+func (s *InitService) ManagerCandidates(resources []unstructured.Unstructured) []string {
+	candidates := []string{}
+	for _, resource := range resources {
+		if isDeployment(&resource) {
+			candidates = append(candidates, resource.GetName())
+		}
+	}
+
+	return candidates
+}
+
+func (s *InitService) findManager(resources []unstructured.Unstructured, managerName string) (*unstructured.Unstructured, error) {
+	candidates := s.ManagerCandidates(resources)
+	if len(candidates) == 0 {
+		return nil, errors.New("manager deployment not found in the module resources")
+	}
+
+	if managerName == "" && len(candidates) > 1 {
+		return nil, fmt.Errorf("found multiple deployments (%s), choose the manager", strings.Join(candidates, ", "))
+	}
+
+	if managerName == "" {
+		managerName = candidates[0]
+	}
+
+	for _, resource := range resources {
+		if isDeployment(&resource) && resource.GetName() == managerName {
+			return &resource, nil
+		}
+	}
+
+	return nil, fmt.Errorf("manager deployment %s not found in the module resources", managerName)
+}
+
+// associatedResources returns kinds defined by CRDs from the bundle except the module configuration kind
+func associatedResources(resources []unstructured.Unstructured, configKind string) []metav1.GroupVersionKind {
+	gvks := []metav1.GroupVersionKind{}
+	for _, resource := range resources {
+		if resource.GetKind() != "CustomResourceDefinition" {
+			continue
+		}
+
+		group, _, _ := unstructured.NestedString(resource.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(resource.Object, "spec", "names", "kind")
+		if kind == "" || kind == configKind {
+			continue
+		}
+
+		gvks = append(gvks, metav1.GroupVersionKind{
+			Group:   group,
+			Version: crdStorageVersion(&resource),
+			Kind:    kind,
+		})
+	}
+
+	return gvks
+}
+
+func crdStorageVersion(crd *unstructured.Unstructured) string {
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, version := range versions {
+		versionMap, ok := version.(map[string]any)
+		if ok && versionMap["storage"] == true {
+			name, _ := versionMap["name"].(string)
+			return name
+		}
+	}
+
+	if len(versions) > 0 {
+		if versionMap, ok := versions[0].(map[string]any); ok {
+			name, _ := versionMap["name"].(string)
+			return name
+		}
+	}
+
+	return ""
+}
+
+func cleanSampleCR(sampleCR *unstructured.Unstructured) *unstructured.Unstructured {
+	cleaned := sampleCR.DeepCopy()
+	for _, field := range []string{"managedFields", "resourceVersion", "uid", "creationTimestamp", "generation"} {
+		unstructured.RemoveNestedField(cleaned.Object, "metadata", field)
+	}
+	unstructured.RemoveNestedField(cleaned.Object, "status")
+	return cleaned
+}
+
+func isDeployment(resource *unstructured.Unstructured) bool {
+	return resource.GetKind() == "Deployment" && resource.GroupVersionKind().Group == "apps"
+}
+
+func toGroupVersionKind(resource *unstructured.Unstructured) metav1.GroupVersionKind {
+	gvk := resource.GroupVersionKind()
+	return metav1.GroupVersionKind{
+		Group:   gvk.Group,
+		Version: gvk.Version,
+		Kind:    gvk.Kind,
+	}
+}
+
+// ModuleTemplateToYAML returns the module template as YAML without empty fields
+func ModuleTemplateToYAML(moduleTemplate *kyma.ModuleTemplate) ([]byte, error) {
+	// module template types contain only json tags
+	serializedTemplate, err := json.Marshal(moduleTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawTemplate map[string]any
+	if err := json.Unmarshal(serializedTemplate, &rawTemplate); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(removeEmptyFields(rawTemplate))
+}
+
+func removeEmptyFields(obj map[string]any) map[string]any {
+	for key, value := range obj {
+		switch typedValue := value.(type) {
+		case nil:
+			delete(obj, key)
+		case string:
+			if typedValue == "" {
+				delete(obj, key)
+			}
+		case map[string]any:
+			// the default CR is kept as it was provided
+			if key != "data" {
+				removeEmptyFields(typedValue)
+			}
+			if len(typedValue) == 0 {
+				delete(obj, key)
+			}
+		}
+	}
+
+	return obj
+}
@@ -0,0 +1,149 @@
+package modulesv2_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modules/source"
+	"github.com/kyma-project/cli.v3/internal/modulesv2"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var (
+	testInitManager = unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "sample-manager", "namespace": "sample-system"},
+	}}
+	testInitWebhook = unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]any{"name": "sample-webhook", "namespace": "sample-system"},
+	}}
+	testInitConfigCRD = unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]any{"name": "samples.operator.example.com"},
+		"spec": map[string]any{
+			"group":    "operator.example.com",
+			"names":    map[string]any{"kind": "Sample"},
+			"versions": []any{map[string]any{"name": "v1alpha1", "storage": true}},
+		},
+	}}
+	testInitWorkloadCRD = unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]any{"name": "workloads.example.com"},
+		"spec": map[string]any{
+			"group": "example.com",
+			"names": map[string]any{"kind": "Workload"},
+			"versions": []any{
+				map[string]any{"name": "v1alpha1"},
+				map[string]any{"name": "v1", "storage": true},
+			},
+		},
+	}}
+	testInitSampleCR = unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "operator.example.com/v1alpha1",
+		"kind":       "Sample",
+		"metadata":   map[string]any{"name": "default", "namespace": "sample-system", "uid": "1234"},
+		"spec":       map[string]any{"replicas": int64(1), "labels": map[string]any{}},
+		"status":     map[string]any{"state": "Ready"},
+	}}
+)
+
+func TestInitService_Run(t *testing.T) {
+	t.Run("generate module template", func(t *testing.T) {
+		moduleTemplate, err := modulesv2.NewInitService().Run(&dtos.InitConfig{
+			ModuleName:   "sample",
+			Version:      "1.0.0",
+			ResourceLink: "https://example.com/sample.yaml",
+			Resources:    []unstructured.Unstructured{testInitConfigCRD, testInitWorkloadCRD, testInitManager},
+			SampleCR:     &testInitSampleCR,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "sample-1.0.0", moduleTemplate.GetName())
+		require.Equal(t, &kyma.Manager{
+			GroupVersionKind: metav1.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+			Namespace:        "sample-system",
+			Name:             "sample-manager",
+		}, moduleTemplate.Spec.Manager)
+		require.Equal(t, []metav1.GroupVersionKind{{Group: "example.com", Version: "v1", Kind: "Workload"}}, moduleTemplate.Spec.AssociatedResources)
+		require.Equal(t, []kyma.Resource{{Name: "rawManifest", Link: "https://example.com/sample.yaml"}}, moduleTemplate.Spec.Resources)
+		require.Equal(t, "default", moduleTemplate.Spec.Data.GetName())
+		require.Empty(t, moduleTemplate.Spec.Data.GetUID())
+		require.NotContains(t, moduleTemplate.Spec.Data.Object, "status")
+	})
+
+	t.Run("multiple deployments", func(t *testing.T) {
+		service := modulesv2.NewInitService()
+		initConfig := &dtos.InitConfig{
+			ModuleName:   "sample",
+			Version:      "1.0.0",
+			ResourceLink: "https://example.com/sample.yaml",
+			Resources:    []unstructured.Unstructured{testInitManager, testInitWebhook},
+		}
+
+		moduleTemplate, err := service.Run(initConfig)
+		require.EqualError(t, err, "found multiple deployments (sample-manager, sample-webhook), choose the manager")
+		require.Nil(t, moduleTemplate)
+
+		initConfig.ManagerName = "sample-webhook"
+		moduleTemplate, err = service.Run(initConfig)
+		require.NoError(t, err)
+		require.Equal(t, "sample-webhook", moduleTemplate.Spec.Manager.Name)
+	})
+
+	t.Run("missing manager", func(t *testing.T) {
+		moduleTemplate, err := modulesv2.NewInitService().Run(&dtos.InitConfig{
+			ModuleName:   "sample",
+			Version:      "1.0.0",
+			ResourceLink: "https://example.com/sample.yaml",
+			Resources:    []unstructured.Unstructured{testInitConfigCRD},
+		})
+		require.EqualError(t, err, "manager deployment not found in the module resources")
+		require.Nil(t, moduleTemplate)
+	})
+
+	t.Run("missing module name", func(t *testing.T) {
+		moduleTemplate, err := modulesv2.NewInitService().Run(&dtos.InitConfig{Version: "1.0.0"})
+		require.EqualError(t, err, "module name is required")
+		require.Nil(t, moduleTemplate)
+	})
+}
+
+func TestModuleTemplateToYAML(t *testing.T) {
+	moduleTemplate, err := modulesv2.NewInitService().Run(&dtos.InitConfig{
+		ModuleName:   "sample",
+		Version:      "1.0.0",
+		ResourceLink: "https://example.com/sample.yaml",
+		Resources:    []unstructured.Unstructured{testInitConfigCRD, testInitWorkloadCRD, testInitManager},
+		SampleCR:     &testInitSampleCR,
+	})
+	require.NoError(t, err)
+
+	moduleTemplateYAML, err := modulesv2.ModuleTemplateToYAML(moduleTemplate)
+	require.NoError(t, err)
+	require.NotContains(t, string(moduleTemplateYAML), "null")
+	require.NotContains(t, string(moduleTemplateYAML), "channel")
+	require.Contains(t, string(moduleTemplateYAML), "labels: {}")
+
+	path := filepath.Join(t.TempDir(), "sample-1.0.0.yaml")
+	require.NoError(t, os.WriteFile(path, moduleTemplateYAML, 0600))
+
+	// generated module templates are read the same way as community modules catalogs
+	parsedTemplates, err := source.ReadModuleTemplates(path)
+	require.NoError(t, err)
+	require.Len(t, parsedTemplates, 1)
+	require.Equal(t, moduleTemplate.Spec.ModuleName, parsedTemplates[0].Spec.ModuleName)
+	require.Equal(t, moduleTemplate.Spec.Manager, parsedTemplates[0].Spec.Manager)
+	require.Equal(t, moduleTemplate.Spec.AssociatedResources, parsedTemplates[0].Spec.AssociatedResources)
+	require.Equal(t, moduleTemplate.Spec.Resources, parsedTemplates[0].Spec.Resources)
+	require.Equal(t, moduleTemplate.Spec.Data.Object, parsedTemplates[0].Spec.Data.Object)
+	require.Equal(t, moduleTemplate.GetLabels(), parsedTemplates[0].GetLabels())
+}
@@ -0,0 +1,297 @@
+package modulesv2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/kube/resources"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/entities"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/repository"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	LintRuleManager               = "manager"
+	LintRuleManagerVersion        = "manager-version"
+	LintRuleCustomStateCheck      = "custom-state-check"
+	LintRuleCustomStateCheckReady = "custom-state-check-ready"
+	LintRuleDefaultCustomResource = "default-cr"
+	LintRuleNamespace             = "namespace"
+)
+
+const (
+	managerVersionLabel    = "app.kubernetes.io/version"
+	managerContainerSubstr = "manager"
+)
+
+// LintRules describes rules checked by the lint command
+var LintRules = map[string]string{
+	LintRuleManager:               "The manager is defined and can be found in the rawManifest resource",
+	LintRuleManagerVersion:        "The module version can be read from the manager label or the manager container image",
+	LintRuleCustomStateCheck:      "Custom state checks use valid field paths and known module states",
+	LintRuleCustomStateCheckReady: "Custom state checks map a value to the Ready state",
+	LintRuleDefaultCustomResource: "The default custom resource in spec.data defines apiVersion and kind",
+	LintRuleNamespace:             "The module doesn't use namespaces reserved for Kyma",
+}
+
+var (
+	// custom state checks are resolved as dot-separated paths to fields of the module custom resource
+	customStateCheckPathRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]+(\.[A-Za-z0-9_-]+)*$`)
+	moduleStates               = []string{"Ready", "Processing", "Error", "Deleting", "Warning"}
+)
+
+type LintService struct {
+	externalModuleTemplateRepository repository.ExternalModuleTemplateRepository
+	// the cluster repository is created only to lint module templates from the cluster
+	// so linting files doesn't require the kubeconfig
+	moduleTemplatesRepository func() (repository.ModuleTemplatesRepository, error)
+}
+
+func NewLintService(
+	externalModuleTemplateRepository repository.ExternalModuleTemplateRepository,
+	moduleTemplatesRepository func() (repository.ModuleTemplatesRepository, error),
+) *LintService {
+	return &LintService{
+		externalModuleTemplateRepository: externalModuleTemplateRepository,
+		moduleTemplatesRepository:        moduleTemplatesRepository,
+	}
+}
+
+// Run checks module templates against assumptions made by module commands about the manager,
+// the module version, custom state checks, the default custom resource, and namespaces
+func (s *LintService) Run(ctx context.Context, lintConfig *dtos.LintConfig) (*dtos.LintResult, error) {
+	moduleTemplates, source, err := s.getModuleTemplates(ctx, lintConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dtos.LintResult{
+		Source:          source,
+		ModuleTemplates: len(moduleTemplates),
+		Findings:        []dtos.LintFinding{},
+	}
+
+	for i, moduleTemplate := range moduleTemplates {
+		name := moduleTemplate.GetName()
+		if name == "" {
+			name = fmt.Sprintf("module template %d", i+1)
+		}
+
+		linter := &moduleTemplateLinter{name: name}
+		linter.lintManager(&moduleTemplate)
+		if !lintConfig.SkipLinkCheck {
+			s.lintManifest(linter, &moduleTemplate)
+		}
+		linter.lintCustomStateChecks(&moduleTemplate)
+		linter.lintDefaultCustomResource(&moduleTemplate)
+		linter.lintNamespaces(&moduleTemplate)
+
+		result.Findings = append(result.Findings, linter.findings...)
+	}
+
+	return result, nil
+}
+
+func (s *LintService) getModuleTemplates(ctx context.Context, lintConfig *dtos.LintConfig) ([]kyma.ModuleTemplate, string, error) {
+	if lintConfig.Name == "" {
+		moduleTemplates, err := s.externalModuleTemplateRepository.Get([]string{lintConfig.Path})
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read module templates: %v", err)
+		}
+
+		if len(moduleTemplates) == 0 {
+			return nil, "", fmt.Errorf("no module templates found in %s", lintConfig.Path)
+		}
+
+		return moduleTemplates, lintConfig.Path, nil
+	}
+
+	source := fmt.Sprintf("%s/%s", lintConfig.Namespace, lintConfig.Name)
+	moduleTemplatesRepository, err := s.moduleTemplatesRepository()
+	if err != nil {
+		return nil, "", err
+	}
+
+	moduleTemplate, err := moduleTemplatesRepository.GetLocal(ctx, lintConfig.Name, lintConfig.Namespace)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get the %s module template: %v", source, err)
+	}
+
+	if moduleTemplate == nil {
+		return nil, "", fmt.Errorf("module template %s not found", source)
+	}
+
+	return []kyma.ModuleTemplate{*moduleTemplate}, source, nil
+}
+
+// lintManifest checks that the manager is in the rawManifest resource and its version can be read
+func (s *LintService) lintManifest(linter *moduleTemplateLinter, moduleTemplate *kyma.ModuleTemplate) {
+	manager := moduleTemplate.Spec.Manager
+	if manager == nil {
+		return
+	}
+
+	for _, resource := range moduleTemplate.Spec.Resources {
+		if resource.Name != "rawManifest" || resource.Link == "" {
+			continue
+		}
+
+		data, err := s.externalModuleTemplateRepository.GetResource(resource.Link)
+		if err != nil {
+			linter.errorf(LintRuleManager, "failed to resolve the rawManifest resource link: %v", err)
+			return
+		}
+
+		manifestResources, err := resources.DecodeYaml(bytes.NewReader(data))
+		if err != nil {
+			linter.errorf(LintRuleManager, "failed to parse the rawManifest resource: %v", err)
+			return
+		}
+
+		managerResource := findManagerResource(manifestResources, manager)
+		if managerResource == nil {
+			linter.errorf(LintRuleManager, "manager %s %s not found in the rawManifest resource", manager.Kind, manager.Name)
+			return
+		}
+
+		version := extractManagerVersion(managerResource)
+		if version == "" {
+			linter.warningf(LintRuleManagerVersion,
+				"module version can't be read from the manager, add the %s label or a container with '%s' in the name and a tagged image",
+				managerVersionLabel, managerContainerSubstr)
+		} else if version != moduleTemplate.Spec.Version {
+			linter.warningf(LintRuleManagerVersion, "manager version '%s' doesn't match spec.version '%s'", version, moduleTemplate.Spec.Version)
+		}
+
+		return
+	}
+}
+
+type moduleTemplateLinter struct {
+	name     string
+	findings []dtos.LintFinding
+}
+
+func (l *moduleTemplateLinter) lintManager(moduleTemplate *kyma.ModuleTemplate) {
+	manager := moduleTemplate.Spec.Manager
+	if manager == nil {
+		l.errorf(LintRuleManager, "spec.manager is required")
+		return
+	}
+
+	if manager.Name == "" || manager.Kind == "" || manager.Version == "" {
+		l.errorf(LintRuleManager, "spec.manager must contain name, kind and version")
+	}
+
+	if !slices.ContainsFunc(moduleTemplate.Spec.Resources, func(resource kyma.Resource) bool {
+		return resource.Name == "rawManifest" && resource.Link != ""
+	}) {
+		l.errorf(LintRuleManager, "spec.resources must contain the rawManifest resource with the link")
+	}
+}
+
+func (l *moduleTemplateLinter) lintCustomStateChecks(moduleTemplate *kyma.ModuleTemplate) {
+	checks := moduleTemplate.Spec.CustomStateCheck
+	if len(checks) == 0 {
+		return
+	}
+
+	readyMapped := false
+	for i, check := range checks {
+		if !customStateCheckPathRegexp.MatchString(check.JSONPath) {
+			l.errorf(LintRuleCustomStateCheck, "spec.customStateCheck[%d].jsonPath '%s' is not a valid field path", i, check.JSONPath)
+		}
+
+		if !slices.Contains(moduleStates, check.MappedState) {
+			l.errorf(LintRuleCustomStateCheck, "spec.customStateCheck[%d].mappedState '%s' is not one of: %s",
+				i, check.MappedState, strings.Join(moduleStates, ", "))
+		}
+
+		readyMapped = readyMapped || check.MappedState == "Ready"
+	}
+
+	if !readyMapped {
+		l.errorf(LintRuleCustomStateCheckReady, "spec.customStateCheck must map a value to the Ready state")
+	}
+}
+
+func (l *moduleTemplateLinter) lintDefaultCustomResource(moduleTemplate *kyma.ModuleTemplate) {
+	data := moduleTemplate.Spec.Data
+	if data.GetAPIVersion() == "" || data.GetKind() == "" {
+		l.errorf(LintRuleDefaultCustomResource, "spec.data must define apiVersion and kind")
+	}
+}
+
+func (l *moduleTemplateLinter) lintNamespaces(moduleTemplate *kyma.ModuleTemplate) {
+	fields := []string{"metadata.namespace", "spec.data.metadata.namespace"}
+	namespaces := []string{moduleTemplate.GetNamespace(), moduleTemplate.Spec.Data.GetNamespace()}
+	if moduleTemplate.Spec.Manager != nil {
+		fields = append(fields, "spec.manager.namespace")
+		namespaces = append(namespaces, moduleTemplate.Spec.Manager.Namespace)
+	}
+
+	for i, namespace := range namespaces {
+		if err := entities.ValidateNamespace(namespace); err != nil {
+			l.errorf(LintRuleNamespace, "%s: %v", fields[i], err)
+		}
+	}
+}
+
+func (l *moduleTemplateLinter) errorf(ruleID, format string, args ...any) {
+	l.add(dtos.LintLevelError, ruleID, format, args...)
+}
+
+func (l *moduleTemplateLinter) warningf(ruleID, format string, args ...any) {
+	l.add(dtos.LintLevelWarning, ruleID, format, args...)
+}
+
+func (l *moduleTemplateLinter) add(level, ruleID, format string, args ...any) {
+	l.findings = append(l.findings, dtos.LintFinding{
+		RuleID:         ruleID,
+		Level:          level,
+		ModuleTemplate: l.name,
+		Message:        fmt.Sprintf(format, args...),
+	})
+}
+
+func findManagerResource(manifestResources []unstructured.Unstructured, manager *kyma.Manager) *unstructured.Unstructured {
+	for i, resource := range manifestResources {
+		gvk := resource.GroupVersionKind()
+		if gvk.Group == manager.Group && gvk.Version == manager.Version && gvk.Kind == manager.Kind &&
+			resource.GetName() == manager.Name &&
+			(manager.Namespace == "" || resource.GetNamespace() == manager.Namespace) {
+			return &manifestResources[i]
+		}
+	}
+
+	return nil
+}
+
+// extractManagerVersion follows rules used to show versions of installed community modules
+// the version label wins over the image tag of the container with 'manager' in the name
+func extractManagerVersion(manager *unstructured.Unstructured) string {
+	if version := manager.GetLabels()[managerVersionLabel]; version != "" {
+		return version
+	}
+
+	containers, _, _ := unstructured.NestedSlice(manager.Object, "spec", "template", "spec", "containers")
+	for _, c := range containers {
+		container, _ := c.(map[string]any)
+		if name, ok := container["name"].(string); ok && strings.Contains(name, managerContainerSubstr) {
+			if image, ok := container["image"].(string); ok && image != "" {
+				parts := strings.Split(image, ":")
+				if len(parts) > 1 {
+					return parts[len(parts)-1]
+				}
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,163 @@
+package modulesv2_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modulesv2"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
+	modulesfake "github.com/kyma-project/cli.v3/internal/modulesv2/fake"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/repository"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const testLintManifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: sample-manager
+  namespace: sample-system
+  labels:
+    app.kubernetes.io/version: 1.0.0
+`
+
+func lintModuleTemplate() kyma.ModuleTemplate {
+	moduleTemplate := indexModuleTemplate("1.0.0")
+	moduleTemplate.Spec.Data = unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "operator.example.com/v1alpha1",
+		"kind":       "Sample",
+		"metadata":   map[string]any{"name": "default"},
+	}}
+	moduleTemplate.Spec.CustomStateCheck = []kyma.CustomStateCheck{
+		{JSONPath: "status.health", Value: "green", MappedState: "Ready"},
+		{JSONPath: "status.health", Value: "red", MappedState: "Error"},
+	}
+	return moduleTemplate
+}
+
+func noClusterRepository() (repository.ModuleTemplatesRepository, error) {
+	return nil, errors.New("cluster not available")
+}
+
+func TestLintService_Run(t *testing.T) {
+	resources := map[string][]byte{
+		"https://example.com/1.0.0/sample.yaml": []byte(testLintManifest),
+	}
+
+	t.Run("valid module template", func(t *testing.T) {
+		repo := &modulesfake.ExternalModuleTemplatesRepository{
+			Modules:   []kyma.ModuleTemplate{lintModuleTemplate()},
+			Resources: resources,
+		}
+
+		result, err := modulesv2.NewLintService(repo, noClusterRepository).Run(context.Background(), dtos.NewLintConfig("./templates", false))
+		require.NoError(t, err)
+		require.Equal(t, &dtos.LintResult{Source: "./templates", ModuleTemplates: 1, Findings: []dtos.LintFinding{}}, result)
+	})
+
+	t.Run("invalid module template", func(t *testing.T) {
+		moduleTemplate := lintModuleTemplate()
+		moduleTemplate.Namespace = "kyma-system"
+		moduleTemplate.Spec.Data = unstructured.Unstructured{}
+		moduleTemplate.Spec.CustomStateCheck = []kyma.CustomStateCheck{
+			{JSONPath: "{.status.health}", Value: "red", MappedState: "Broken"},
+		}
+		repo := &modulesfake.ExternalModuleTemplatesRepository{
+			Modules:   []kyma.ModuleTemplate{moduleTemplate},
+			Resources: resources,
+		}
+
+		result, err := modulesv2.NewLintService(repo, noClusterRepository).Run(context.Background(), dtos.NewLintConfig("./templates", false))
+		require.NoError(t, err)
+		require.Equal(t, []dtos.LintFinding{
+			{RuleID: modulesv2.LintRuleCustomStateCheck, Level: dtos.LintLevelError, ModuleTemplate: "sample-1.0.0", Message: "spec.customStateCheck[0].jsonPath '{.status.health}' is not a valid field path"},
+			{RuleID: modulesv2.LintRuleCustomStateCheck, Level: dtos.LintLevelError, ModuleTemplate: "sample-1.0.0", Message: "spec.customStateCheck[0].mappedState 'Broken' is not one of: Ready, Processing, Error, Deleting, Warning"},
+			{RuleID: modulesv2.LintRuleCustomStateCheckReady, Level: dtos.LintLevelError, ModuleTemplate: "sample-1.0.0", Message: "spec.customStateCheck must map a value to the Ready state"},
+			{RuleID: modulesv2.LintRuleDefaultCustomResource, Level: dtos.LintLevelError, ModuleTemplate: "sample-1.0.0", Message: "spec.data must define apiVersion and kind"},
+			{RuleID: modulesv2.LintRuleNamespace, Level: dtos.LintLevelError, ModuleTemplate: "sample-1.0.0", Message: "metadata.namespace: 'kyma-system' namespace is not allowed"},
+		}, result.Findings)
+		require.Equal(t, 5, result.Errors())
+	})
+
+	t.Run("manager not found in manifest", func(t *testing.T) {
+		moduleTemplate := lintModuleTemplate()
+		moduleTemplate.Spec.Manager.Name = "other-manager"
+		repo := &modulesfake.ExternalModuleTemplatesRepository{
+			Modules:   []kyma.ModuleTemplate{moduleTemplate},
+			Resources: resources,
+		}
+
+		result, err := modulesv2.NewLintService(repo, noClusterRepository).Run(context.Background(), dtos.NewLintConfig("./templates", false))
+		require.NoError(t, err)
+		require.Equal(t, []dtos.LintFinding{
+			{RuleID: modulesv2.LintRuleManager, Level: dtos.LintLevelError, ModuleTemplate: "sample-1.0.0", Message: "manager Deployment other-manager not found in the rawManifest resource"},
+		}, result.Findings)
+
+		result, err = modulesv2.NewLintService(repo, noClusterRepository).Run(context.Background(), dtos.NewLintConfig("./templates", true))
+		require.NoError(t, err)
+		require.Empty(t, result.Findings)
+	})
+
+	t.Run("manager version", func(t *testing.T) {
+		moduleTemplate := lintModuleTemplate()
+		moduleTemplate.Spec.Version = "1.1.0"
+		moduleTemplate.Spec.Resources[0].Link = "https://example.com/1.0.0/sample.yaml"
+		noVersionTemplate := lintModuleTemplate()
+		noVersionTemplate.Name = "sample-no-version"
+		noVersionTemplate.Spec.Resources[0].Link = "https://example.com/no-version/sample.yaml"
+		repo := &modulesfake.ExternalModuleTemplatesRepository{
+			Modules: []kyma.ModuleTemplate{moduleTemplate, noVersionTemplate},
+			Resources: map[string][]byte{
+				"https://example.com/1.0.0/sample.yaml":      []byte(testLintManifest),
+				"https://example.com/no-version/sample.yaml": []byte(testManagerManifest),
+			},
+		}
+
+		result, err := modulesv2.NewLintService(repo, noClusterRepository).Run(context.Background(), dtos.NewLintConfig("./templates", false))
+		require.NoError(t, err)
+		require.Equal(t, []dtos.LintFinding{
+			{RuleID: modulesv2.LintRuleManagerVersion, Level: dtos.LintLevelWarning, ModuleTemplate: "sample-1.0.0", Message: "manager version '1.0.0' doesn't match spec.version '1.1.0'"},
+			{RuleID: modulesv2.LintRuleManagerVersion, Level: dtos.LintLevelWarning, ModuleTemplate: "sample-no-version", Message: "module version can't be read from the manager, add the app.kubernetes.io/version label or a container with 'manager' in the name and a tagged image"},
+		}, result.Findings)
+		require.Zero(t, result.Errors())
+	})
+
+	t.Run("module template from the cluster", func(t *testing.T) {
+		moduleTemplate := lintModuleTemplate()
+		moduleTemplate.ObjectMeta = metav1.ObjectMeta{Name: "sample-1.0.0", Namespace: "default"}
+		clusterRepo := &modulesfake.ModuleTemplatesRepository{GetLocalResult: &moduleTemplate}
+		lintService := modulesv2.NewLintService(
+			&modulesfake.ExternalModuleTemplatesRepository{Resources: resources},
+			func() (repository.ModuleTemplatesRepository, error) { return clusterRepo, nil },
+		)
+
+		result, err := lintService.Run(context.Background(), dtos.NewClusterLintConfig("default", "sample-1.0.0", false))
+		require.NoError(t, err)
+		require.Equal(t, "default/sample-1.0.0", result.Source)
+		require.Equal(t, 1, result.ModuleTemplates)
+		require.Empty(t, result.Findings)
+	})
+
+	t.Run("module template not found in the cluster", func(t *testing.T) {
+		lintService := modulesv2.NewLintService(
+			&modulesfake.ExternalModuleTemplatesRepository{},
+			func() (repository.ModuleTemplatesRepository, error) {
+				return &modulesfake.ModuleTemplatesRepository{}, nil
+			},
+		)
+
+		result, err := lintService.Run(context.Background(), dtos.NewClusterLintConfig("default", "sample-1.0.0", false))
+		require.EqualError(t, err, "module template default/sample-1.0.0 not found")
+		require.Nil(t, result)
+	})
+
+	t.Run("no module templates", func(t *testing.T) {
+		result, err := modulesv2.NewLintService(&modulesfake.ExternalModuleTemplatesRepository{}, noClusterRepository).
+			Run(context.Background(), dtos.NewLintConfig("./templates", false))
+		require.EqualError(t, err, "no module templates found in ./templates")
+		require.Nil(t, result)
+	})
+}
@@ -2,6 +2,7 @@ package modulesv2
 
 import (
 	"context"
+	"sync"
 
 	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
 	"github.com/kyma-project/cli.v3/internal/modulesv2/repository"
@@ -9,6 +10,8 @@ import (
 
 type ListService struct {
 	installedModulesRepository repository.ModuleInstallationsRepository
+	// installedModulesRepositoryForContext creates repositories for clusters of other kubeconfig contexts
+	installedModulesRepositoryForContext func(kubeContext string) (repository.ModuleInstallationsRepository, error)
 }
 
 func NewListService(installedModulesRepository repository.ModuleInstallationsRepository) *ListService {
@@ -17,17 +20,57 @@ func NewListService(installedModulesRepository repository.ModuleInstallationsRep
 	}
 }
 
+func NewListServiceWithContexts(
+	installedModulesRepository repository.ModuleInstallationsRepository,
+	installedModulesRepositoryForContext func(kubeContext string) (repository.ModuleInstallationsRepository, error),
+) *ListService {
+	return &ListService{
+		installedModulesRepository:           installedModulesRepository,
+		installedModulesRepositoryForContext: installedModulesRepositoryForContext,
+	}
+}
+
 func (s *ListService) Run(ctx context.Context) ([]dtos.ListResult, []dtos.CommunityListResult, error) {
-	if s.installedModulesRepository == nil {
+	return s.list(ctx, s.installedModulesRepository)
+}
+
+// RunForContexts lists installed modules in clusters of all contexts concurrently
+// a failure in one cluster is returned in its result and doesn't stop listing others
+func (s *ListService) RunForContexts(ctx context.Context, kubeContexts []string) *dtos.FleetListResult {
+	results := make([]dtos.ContextListResult, len(kubeContexts))
+	var wg sync.WaitGroup
+
+	for i, kubeContext := range kubeContexts {
+		wg.Add(1)
+		go func(i int, kubeContext string) {
+			defer wg.Done()
+			results[i] = dtos.ContextListResult{Context: kubeContext}
+
+			installedModulesRepository, err := s.installedModulesRepositoryForContext(kubeContext)
+			if err != nil {
+				results[i].Err = err
+				return
+			}
+
+			results[i].Modules, results[i].CommunityModules, results[i].Err = s.list(ctx, installedModulesRepository)
+		}(i, kubeContext)
+	}
+
+	wg.Wait()
+	return &dtos.FleetListResult{Contexts: results}
+}
+
+func (s *ListService) list(ctx context.Context, installedModulesRepository repository.ModuleInstallationsRepository) ([]dtos.ListResult, []dtos.CommunityListResult, error) {
+	if installedModulesRepository == nil {
 		return nil, nil, nil
 	}
 
-	installedModules, err := s.installedModulesRepository.ListInstalledModules(ctx)
+	installedModules, err := installedModulesRepository.ListInstalledModules(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	communityModules, err := s.installedModulesRepository.ListInstalledCommunityModules(ctx)
+	communityModules, err := installedModulesRepository.ListInstalledCommunityModules(ctx)
 	if err != nil {
 		return nil, nil, err
 	}
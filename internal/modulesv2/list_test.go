@@ -2,10 +2,12 @@ package modulesv2
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/kyma-project/cli.v3/internal/modulesv2/entities"
 	modulesfake "github.com/kyma-project/cli.v3/internal/modulesv2/fake"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/repository"
 	"github.com/stretchr/testify/require"
 )
 
@@ -101,3 +103,39 @@ func TestListService_Run_ReturnsManagedFalseWhenUnmanaged(t *testing.T) {
 	module := result[0]
 	require.False(t, module.Managed)
 }
+
+func TestListService_RunForContexts(t *testing.T) {
+	repos := map[string]repository.ModuleInstallationsRepository{
+		"dev": &modulesfake.ModuleInstallationsRepository{
+			ListInstalledModulesResult: []entities.ModuleInstallation{{Name: "keda", Version: "1.1.0", Channel: "fast"}},
+		},
+		"prod": &modulesfake.ModuleInstallationsRepository{
+			ListInstalledModulesResult: []entities.ModuleInstallation{{Name: "keda", Version: "1.0.0", Channel: "regular"}},
+		},
+		"stage": &modulesfake.ModuleInstallationsRepository{
+			ListInstalledModulesError: errors.New("connection refused"),
+		},
+	}
+	svc := NewListServiceWithContexts(nil, func(kubeContext string) (repository.ModuleInstallationsRepository, error) {
+		repo, ok := repos[kubeContext]
+		if !ok {
+			return nil, errors.New("context not found")
+		}
+		return repo, nil
+	})
+
+	result := svc.RunForContexts(context.Background(), []string{"dev", "prod", "stage", "missing"})
+
+	require.Len(t, result.Contexts, 4)
+	require.Equal(t, "dev", result.Contexts[0].Context)
+	require.NoError(t, result.Contexts[0].Err)
+	require.EqualError(t, result.Contexts[2].Err, "connection refused")
+	require.EqualError(t, result.Contexts[3].Err, "context not found")
+
+	modules := result.Modules()
+	require.Len(t, modules, 1)
+	require.Equal(t, "keda", modules[0].Name)
+	require.Equal(t, "1.1.0", modules[0].Installations["dev"].Version)
+	require.Equal(t, "1.0.0", modules[0].Installations["prod"].Version)
+	require.True(t, modules[0].HasVersionDrift())
+}
@@ -56,6 +56,11 @@ func (s *PullService) Run(ctx context.Context, pullConfig *dtos.PullConfig) (*dt
 		return nil, fmt.Errorf("failed to store community module in the provided namespace: %v", err)
 	}
 
+	err = externalModule.RewriteResourceLinks(pullConfig.ResourceMirrors)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rewrite resource links with mirrors: %v", err)
+	}
+
 	if err = s.moduleTemplatesRepository.SaveCommunityModule(ctx, externalModule); err != nil {
 		return nil, fmt.Errorf("failed to save %s module template in the %s namespace: %v", externalModule.TemplateName, externalModule.Namespace, err)
 	}
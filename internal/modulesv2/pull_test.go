@@ -27,21 +27,21 @@ func TestPullService_Run(t *testing.T) {
 	}{
 		{
 			name:                       "External community modules call fails",
-			pullConfig:                 dtos.NewPullConfig("sample-module", "default", "", ""),
+			pullConfig:                 dtos.NewPullConfig("sample-module", "default", "", "", nil),
 			listExternalCommunityError: errors.New("moduleTemplatesRepository.ListExternalCommunity#Error"),
 			expectedError:              true,
 			expectedErrorMsg:           "failed to get community module from remote: failed to list external modules: moduleTemplatesRepository.ListExternalCommunity#Error",
 		},
 		{
 			name:                        "Community module does not exist in external repo",
-			pullConfig:                  dtos.NewPullConfig("sample-module", "default", "", ""),
+			pullConfig:                  dtos.NewPullConfig("sample-module", "default", "", "", nil),
 			listExternalCommunityResult: []*entities.ExternalModuleTemplate{},
 			expectedError:               true,
 			expectedErrorMsg:            "failed to get community module from remote: community module sample-module does not exist in the https://kyma-project.github.io/community-modules/all-modules.json repository",
 		},
 		{
 			name:       "Operation fails to save moduletemplate in kyma-system namespace",
-			pullConfig: dtos.NewPullConfig("sample-module", "kyma-system", "", ""),
+			pullConfig: dtos.NewPullConfig("sample-module", "kyma-system", "", "", nil),
 			listExternalCommunityResult: []*entities.ExternalModuleTemplate{
 				modulesfake.ExternalModuleTemplate(&modulesfake.ExternalParams{Version: "1.0.1"}),
 				modulesfake.ExternalModuleTemplate(&modulesfake.ExternalParams{Version: "1.0.0"}),
@@ -51,7 +51,7 @@ func TestPullService_Run(t *testing.T) {
 		},
 		{
 			name:       "Operation fails when provided version is not present in the repository",
-			pullConfig: dtos.NewPullConfig("sample-module", "default", "", "2.3.4"),
+			pullConfig: dtos.NewPullConfig("sample-module", "default", "", "2.3.4", nil),
 			listExternalCommunityResult: []*entities.ExternalModuleTemplate{
 				modulesfake.ExternalModuleTemplate(&modulesfake.ExternalParams{Version: "1.0.0"}),
 				modulesfake.ExternalModuleTemplate(&modulesfake.ExternalParams{Version: "1.0.1"}),
@@ -61,7 +61,7 @@ func TestPullService_Run(t *testing.T) {
 		},
 		{
 			name:       "Operation fails on unsuccessful module save",
-			pullConfig: dtos.NewPullConfig("sample-module", "default", "", "1.0.1"),
+			pullConfig: dtos.NewPullConfig("sample-module", "default", "", "1.0.1", nil),
 			listExternalCommunityResult: []*entities.ExternalModuleTemplate{
 				modulesfake.ExternalModuleTemplate(&modulesfake.ExternalParams{Version: "1.0.0"}),
 				modulesfake.ExternalModuleTemplate(&modulesfake.ExternalParams{Version: "1.0.1"}),
@@ -72,7 +72,7 @@ func TestPullService_Run(t *testing.T) {
 		},
 		{
 			name:       "Operation succeeds",
-			pullConfig: dtos.NewPullConfig("sample-module", "default", "", "1.0.1"),
+			pullConfig: dtos.NewPullConfig("sample-module", "default", "", "1.0.1", nil),
 			listExternalCommunityResult: []*entities.ExternalModuleTemplate{
 				modulesfake.ExternalModuleTemplate(&modulesfake.ExternalParams{Version: "1.0.0"}),
 				modulesfake.ExternalModuleTemplate(&modulesfake.ExternalParams{Version: "1.0.1"}),
@@ -120,14 +120,14 @@ func TestPullService_GetInstalledModuleTemplate(t *testing.T) {
 	}{
 		{
 			name:                       "External community modules call fails",
-			pullConfig:                 dtos.NewPullConfig("sample-module", "default", "", ""),
+			pullConfig:                 dtos.NewPullConfig("sample-module", "default", "", "", nil),
 			listExternalCommunityError: errors.New("moduleTemplatesRepository.ListExternalCommunity#Error"),
 			expectedError:              true,
 			expectedErrorMsg:           "failed to get community module from remote: failed to list external modules: moduleTemplatesRepository.ListExternalCommunity#Error",
 		},
 		{
 			name:       "GetLocalCommunity call fails",
-			pullConfig: dtos.NewPullConfig("sample-module", "default", "", "1.0.1"),
+			pullConfig: dtos.NewPullConfig("sample-module", "default", "", "1.0.1", nil),
 			listExternalCommunityResult: []*entities.ExternalModuleTemplate{
 				modulesfake.ExternalModuleTemplate(&modulesfake.ExternalParams{Version: "1.0.1"}),
 			},
@@ -137,7 +137,7 @@ func TestPullService_GetInstalledModuleTemplate(t *testing.T) {
 		},
 		{
 			name:       "Module exists locally - returns PullResult",
-			pullConfig: dtos.NewPullConfig("sample-module", "default", "", "1.0.1"),
+			pullConfig: dtos.NewPullConfig("sample-module", "default", "", "1.0.1", nil),
 			listExternalCommunityResult: []*entities.ExternalModuleTemplate{
 				modulesfake.ExternalModuleTemplate(&modulesfake.ExternalParams{Version: "1.0.1"}),
 			},
@@ -157,7 +157,7 @@ func TestPullService_GetInstalledModuleTemplate(t *testing.T) {
 		},
 		{
 			name:       "Module does not exist locally - returns nil",
-			pullConfig: dtos.NewPullConfig("sample-module", "default", "", "1.0.1"),
+			pullConfig: dtos.NewPullConfig("sample-module", "default", "", "1.0.1", nil),
 			listExternalCommunityResult: []*entities.ExternalModuleTemplate{
 				modulesfake.ExternalModuleTemplate(&modulesfake.ExternalParams{Version: "1.0.1"}),
 			},
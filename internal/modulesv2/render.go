@@ -135,6 +135,141 @@ func versionWithChannel(r dtos.ListResult) string {
 	return fmt.Sprintf("%s(%s)", r.Version, r.Channel)
 }
 
+// RenderFleetList prints modules installed in clusters of multiple kubeconfig contexts
+// the table view is a matrix with modules as rows and contexts as columns
+func RenderFleetList(result *dtos.FleetListResult, format types.Format, printer *out.Printer) error {
+	switch format {
+	case types.JSONFormat:
+		obj, err := json.MarshalIndent(convertFleetToOutputFormat(result), "", "  ")
+		if err != nil {
+			return err
+		}
+		printer.Msgln(string(obj))
+		return nil
+	case types.YAMLFormat:
+		obj, err := yaml.Marshal(convertFleetToOutputFormat(result))
+		if err != nil {
+			return err
+		}
+		printer.Msgln(string(obj))
+		return nil
+	default:
+		renderFleetTable("MODULE", result.Contexts, result.Modules(), printer)
+		if communityModules := result.CommunityModules(); len(communityModules) > 0 {
+			renderFleetTable("COMMUNITY MODULE", result.Contexts, communityModules, printer)
+		}
+		return nil
+	}
+}
+
+func renderFleetTable(nameHeader string, contexts []dtos.ContextListResult, modules []dtos.FleetModuleResult, printer *out.Printer) {
+	headers := []interface{}{nameHeader}
+	for _, contextResult := range contexts {
+		headers = append(headers, contextResult.Context)
+	}
+	headers = append(headers, "VERSION DRIFT")
+
+	rows := make([][]interface{}, len(modules))
+	for i, module := range modules {
+		rows[i] = []interface{}{module.Name}
+		for _, contextResult := range contexts {
+			rows[i] = append(rows[i], fleetCell(contextResult, module))
+		}
+		rows[i] = append(rows[i], module.HasVersionDrift())
+	}
+
+	render.Table(printer, headers, rows)
+}
+
+// fleetCell returns 'version(channel) state', '-' if the module is not installed
+// or 'error' if modules can't be listed in the cluster
+func fleetCell(contextResult dtos.ContextListResult, module dtos.FleetModuleResult) string {
+	if contextResult.Err != nil {
+		return "error"
+	}
+
+	installation, ok := module.Installations[contextResult.Context]
+	if !ok {
+		return "-"
+	}
+
+	return fmt.Sprintf("%s %s", versionWithChannel(installation), installation.ModuleState)
+}
+
+func convertFleetToOutputFormat(result *dtos.FleetListResult) map[string]interface{} {
+	contexts := make([]map[string]interface{}, len(result.Contexts))
+	for i, contextResult := range result.Contexts {
+		contexts[i] = map[string]interface{}{"name": contextResult.Context}
+		if contextResult.Err != nil {
+			contexts[i]["error"] = contextResult.Err.Error()
+		}
+	}
+
+	return map[string]interface{}{
+		"contexts":         contexts,
+		"modules":          convertFleetModulesToOutputFormat(result.Modules()),
+		"communityModules": convertFleetModulesToOutputFormat(result.CommunityModules()),
+	}
+}
+
+func convertFleetModulesToOutputFormat(modules []dtos.FleetModuleResult) []map[string]interface{} {
+	output := make([]map[string]interface{}, len(modules))
+	for i, module := range modules {
+		clusters := map[string]interface{}{}
+		for kubeContext, installation := range module.Installations {
+			clusters[kubeContext] = map[string]interface{}{
+				"version":            installation.Version,
+				"channel":            installation.Channel,
+				"moduleStatus":       installation.ModuleState,
+				"installationStatus": installation.InstallationState,
+			}
+		}
+
+		output[i] = map[string]interface{}{
+			"name":         module.Name,
+			"versionDrift": module.HasVersionDrift(),
+			"clusters":     clusters,
+		}
+	}
+	return output
+}
+
+// clearScreen moves the cursor to the top left corner and clears the terminal
+const clearScreen = "\033[H\033[2J"
+
+// RenderWatch prints changes of installed modules
+// the table view is redrawn on every change and the JSON view prints one event per line
+func RenderWatch(result *dtos.WatchResult, format types.Format, printer *out.Printer) error {
+	if format == types.JSONFormat {
+		for _, event := range result.Events {
+			obj, err := json.Marshal(convertWatchEventToOutputFormat(event))
+			if err != nil {
+				return err
+			}
+			printer.Msgln(string(obj))
+		}
+		return nil
+	}
+
+	printer.Msg(clearScreen)
+	return renderListTable(result.Modules, result.CommunityModules, printer)
+}
+
+func convertWatchEventToOutputFormat(event dtos.WatchEvent) map[string]interface{} {
+	output := map[string]interface{}{
+		"type":               event.Type,
+		"name":               event.Name,
+		"version":            event.Version,
+		"moduleStatus":       event.ModuleState,
+		"installationStatus": event.InstallationState,
+		"community":          event.Community,
+	}
+	if event.Channel != "" {
+		output["channel"] = event.Channel
+	}
+	return output
+}
+
 func RenderCatalog(results []dtos.CatalogResult, format types.Format) error {
 	switch format {
 	case types.JSONFormat:
@@ -224,3 +359,110 @@ func sortCatalogResults(results []dtos.CatalogResult) {
 		return results[i].Name < results[j].Name
 	})
 }
+
+const (
+	LintTextFormat  LintFormat = "text"
+	LintSARIFFormat LintFormat = "sarif"
+
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+// LintFormat is the output format of the lint command
+type LintFormat string
+
+func (f *LintFormat) String() string {
+	return string(*f)
+}
+
+func (f *LintFormat) Set(v string) error {
+	if v != string(LintTextFormat) && v != string(LintSARIFFormat) {
+		return fmt.Errorf("invalid output format '%s'", v)
+	}
+
+	*f = LintFormat(v)
+	return nil
+}
+
+func (f *LintFormat) Type() string {
+	return "string"
+}
+
+func RenderLint(result *dtos.LintResult, format LintFormat, printer *out.Printer) error {
+	switch format {
+	case LintSARIFFormat:
+		return renderLintSARIF(result, printer)
+	default:
+		renderLintText(result, printer)
+		return nil
+	}
+}
+
+func renderLintText(result *dtos.LintResult, printer *out.Printer) {
+	for _, finding := range result.Findings {
+		printer.Msgfln("%s [%s] %s: %s", finding.Level, finding.RuleID, finding.ModuleTemplate, finding.Message)
+	}
+
+	errors := result.Errors()
+	printer.Msgfln("Linted %d module templates from %s (errors: %d, warnings: %d)",
+		result.ModuleTemplates, result.Source, errors, len(result.Findings)-errors)
+}
+
+// renderLintSARIF prints findings in the Static Analysis Results Interchange Format
+// so they can be uploaded to code scanning tools in CI
+func renderLintSARIF(result *dtos.LintResult, printer *out.Printer) error {
+	ruleIDs := make([]string, 0, len(LintRules))
+	for ruleID := range LintRules {
+		ruleIDs = append(ruleIDs, ruleID)
+	}
+	sort.Strings(ruleIDs)
+
+	rules := make([]map[string]interface{}, len(ruleIDs))
+	for i, ruleID := range ruleIDs {
+		rules[i] = map[string]interface{}{
+			"id":               ruleID,
+			"shortDescription": map[string]interface{}{"text": LintRules[ruleID]},
+		}
+	}
+
+	results := make([]map[string]interface{}, len(result.Findings))
+	for i, finding := range result.Findings {
+		results[i] = map[string]interface{}{
+			"ruleId":  finding.RuleID,
+			"level":   finding.Level,
+			"message": map[string]interface{}{"text": finding.Message},
+			"locations": []map[string]interface{}{{
+				"physicalLocation": map[string]interface{}{
+					"artifactLocation": map[string]interface{}{"uri": result.Source},
+				},
+				"logicalLocations": []map[string]interface{}{{
+					"name": finding.ModuleTemplate,
+					"kind": "object",
+				}},
+			}},
+		}
+	}
+
+	output := map[string]interface{}{
+		"$schema": sarifSchema,
+		"version": sarifVersion,
+		"runs": []map[string]interface{}{{
+			"tool": map[string]interface{}{
+				"driver": map[string]interface{}{
+					"name":           "kyma alpha module lint",
+					"informationUri": "https://github.com/kyma-project/cli",
+					"rules":          rules,
+				},
+			},
+			"results": results,
+		}},
+	}
+
+	obj, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	printer.Msgln(string(obj))
+	return nil
+}
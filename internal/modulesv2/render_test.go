@@ -2,6 +2,9 @@ package modulesv2
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/kyma-project/cli.v3/internal/cmdcommon/types"
@@ -114,3 +117,155 @@ func TestRenderList_YAML(t *testing.T) {
 	require.Equal(t, "Ready", communityModule["moduleStatus"])
 	require.Equal(t, "Ready", communityModule["installationStatus"])
 }
+
+func TestRenderLint(t *testing.T) {
+	result := &dtos.LintResult{
+		Source:          "./templates",
+		ModuleTemplates: 2,
+		Findings: []dtos.LintFinding{
+			{RuleID: LintRuleDefaultCustomResource, Level: dtos.LintLevelError, ModuleTemplate: "sample-1.0.0", Message: "spec.data must define apiVersion and kind"},
+			{RuleID: LintRuleManagerVersion, Level: dtos.LintLevelWarning, ModuleTemplate: "sample-1.1.0", Message: "manager version '1.0.0' doesn't match spec.version '1.1.0'"},
+		},
+	}
+
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := RenderLint(result, LintTextFormat, out.NewToWriter(&buf))
+
+		require.NoError(t, err)
+		require.Equal(t, "error [default-cr] sample-1.0.0: spec.data must define apiVersion and kind\n"+
+			"warning [manager-version] sample-1.1.0: manager version '1.0.0' doesn't match spec.version '1.1.0'\n"+
+			"Linted 2 module templates from ./templates (errors: 1, warnings: 1)\n", buf.String())
+	})
+
+	t.Run("sarif", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := RenderLint(result, LintSARIFFormat, out.NewToWriter(&buf))
+		require.NoError(t, err)
+
+		var sarif struct {
+			Version string `json:"version"`
+			Runs    []struct {
+				Tool struct {
+					Driver struct {
+						Rules []struct {
+							ID string `json:"id"`
+						} `json:"rules"`
+					} `json:"driver"`
+				} `json:"tool"`
+				Results []struct {
+					RuleID    string `json:"ruleId"`
+					Level     string `json:"level"`
+					Locations []struct {
+						PhysicalLocation struct {
+							ArtifactLocation struct {
+								URI string `json:"uri"`
+							} `json:"artifactLocation"`
+						} `json:"physicalLocation"`
+						LogicalLocations []struct {
+							Name string `json:"name"`
+						} `json:"logicalLocations"`
+					} `json:"locations"`
+				} `json:"results"`
+			} `json:"runs"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &sarif))
+		require.Equal(t, "2.1.0", sarif.Version)
+		require.Len(t, sarif.Runs, 1)
+		require.Len(t, sarif.Runs[0].Tool.Driver.Rules, len(LintRules))
+		require.Len(t, sarif.Runs[0].Results, 2)
+		require.Equal(t, "default-cr", sarif.Runs[0].Results[0].RuleID)
+		require.Equal(t, "error", sarif.Runs[0].Results[0].Level)
+		require.Equal(t, "./templates", sarif.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+		require.Equal(t, "sample-1.1.0", sarif.Runs[0].Results[1].Locations[0].LogicalLocations[0].Name)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		format := LintTextFormat
+		require.EqualError(t, format.Set("yaml"), "invalid output format 'yaml'")
+		require.NoError(t, format.Set("sarif"))
+		require.Equal(t, LintSARIFFormat, format)
+	})
+}
+
+func TestRenderFleetList(t *testing.T) {
+	result := &dtos.FleetListResult{
+		Contexts: []dtos.ContextListResult{
+			{
+				Context:          "dev",
+				Modules:          []dtos.ListResult{{Name: "keda", Version: "1.1.0", Channel: "fast", ModuleState: "Ready"}},
+				CommunityModules: []dtos.CommunityListResult{{Name: "default/docker-registry", Version: "0.10.0", ModuleState: "Ready"}},
+			},
+			{
+				Context: "prod",
+				Modules: []dtos.ListResult{{Name: "keda", Version: "1.0.0", Channel: "regular", ModuleState: "Warning"}},
+			},
+			{
+				Context: "stage",
+				Err:     errors.New("connection refused"),
+			},
+		},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := RenderFleetList(result, types.DefaultFormat, out.NewToWriter(&buf))
+
+		require.NoError(t, err)
+		require.Regexp(t, `MODULE.*DEV.*PROD.*STAGE.*VERSION DRIFT`, buf.String())
+		require.Regexp(t, `keda.*1\.1\.0\(fast\) Ready.*1\.0\.0\(regular\) Warning.*error.*true`, buf.String())
+		require.Regexp(t, `COMMUNITY MODULE.*DEV.*PROD.*STAGE.*VERSION DRIFT`, buf.String())
+		require.Regexp(t, `default/docker-registry.*0\.10\.0 Ready.*-.*error.*false`, buf.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := RenderFleetList(result, types.JSONFormat, out.NewToWriter(&buf))
+		require.NoError(t, err)
+
+		var output struct {
+			Contexts []map[string]string `json:"contexts"`
+			Modules  []struct {
+				Name         string                       `json:"name"`
+				VersionDrift bool                         `json:"versionDrift"`
+				Clusters     map[string]map[string]string `json:"clusters"`
+			} `json:"modules"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+		require.Equal(t, []map[string]string{{"name": "dev"}, {"name": "prod"}, {"name": "stage", "error": "connection refused"}}, output.Contexts)
+		require.Len(t, output.Modules, 1)
+		require.True(t, output.Modules[0].VersionDrift)
+		require.Equal(t, "regular", output.Modules[0].Clusters["prod"]["channel"])
+	})
+}
+
+func TestRenderWatch(t *testing.T) {
+	result := &dtos.WatchResult{
+		Modules: []dtos.ListResult{{Name: "keda", Version: "1.0.0", Channel: "regular", ModuleState: "Ready", InstallationState: "Ready"}},
+		Events: []dtos.WatchEvent{
+			{Type: dtos.WatchEventModified, Name: "keda", Version: "1.0.0", Channel: "regular", ModuleState: "Ready", InstallationState: "Ready"},
+			{Type: dtos.WatchEventDeleted, Name: "default/cap", Version: "0.1.0", ModuleState: "Ready", InstallationState: "Ready", Community: true},
+		},
+	}
+
+	t.Run("table", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := RenderWatch(result, types.DefaultFormat, out.NewToWriter(&buf))
+
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(buf.String(), clearScreen))
+		require.Regexp(t, `keda.*1\.0\.0\(regular\).*Ready.*Ready`, buf.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := RenderWatch(result, types.JSONFormat, out.NewToWriter(&buf))
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Equal(t, []string{
+			`{"channel":"regular","community":false,"installationStatus":"Ready","moduleStatus":"Ready","name":"keda","type":"Modified","version":"1.0.0"}`,
+			`{"community":true,"installationStatus":"Ready","moduleStatus":"Ready","name":"default/cap","type":"Deleted","version":"0.1.0"}`,
+		}, lines)
+	})
+}
@@ -3,14 +3,16 @@ package repository
 import (
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
+	"os"
 
 	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modules/source"
 )
 
 type ExternalModuleTemplateRepository interface {
 	Get(urls []string) ([]kyma.ModuleTemplate, error)
+	GetResource(link string) ([]byte, error)
+	Save(path string, moduleTemplates []kyma.ModuleTemplate) error
 }
 
 type externalModuleTemplateRepository struct{}
@@ -23,33 +25,49 @@ func (r *externalModuleTemplateRepository) Get(urls []string) ([]kyma.ModuleTemp
 	externalModules := []kyma.ModuleTemplate{}
 
 	for _, url := range urls {
-		externalModuleTemplatesList, err := getFileFromURL(url)
+		result, err := source.ReadModuleTemplates(url)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get community modules definitions: %v", err)
 		}
 
-		var result []kyma.ModuleTemplate
-		if err := json.Unmarshal(externalModuleTemplatesList, &result); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal module template: %w", err)
-		}
-
 		externalModules = append(externalModules, result...)
 	}
 
 	return externalModules, nil
 }
 
-func getFileFromURL(url string) ([]byte, error) {
-	resp, err := http.Get(url)
+// GetResource returns content of the file linked in the module template resources
+func (r *externalModuleTemplateRepository) GetResource(link string) ([]byte, error) {
+	return source.ReadFile(link)
+}
+
+// Save writes module templates to the file as a JSON list
+func (r *externalModuleTemplateRepository) Save(path string, moduleTemplates []kyma.ModuleTemplate) error {
+	serializedTemplates, err := json.Marshal(moduleTemplates)
 	if err != nil {
-		return nil, fmt.Errorf("failed to download resource from %s: %w", url, err)
+		return fmt.Errorf("failed to serialize module templates: %v", err)
+	}
+
+	var rawTemplates []map[string]any
+	if err := json.Unmarshal(serializedTemplates, &rawTemplates); err != nil {
+		return fmt.Errorf("failed to serialize module templates: %v", err)
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	for _, rawTemplate := range rawTemplates {
+		// empty data is serialized as null and can't be read back
+		if spec, ok := rawTemplate["spec"].(map[string]any); ok && spec["data"] == nil {
+			delete(spec, "data")
+		}
+	}
+
+	index, err := json.MarshalIndent(rawTemplates, "", "  ")
 	if err != nil {
-		return nil, fmt.Errorf("failed to read resource body: %w", err)
+		return fmt.Errorf("failed to serialize module templates: %v", err)
+	}
+
+	if err := os.WriteFile(path, append(index, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write %s file: %v", path, err)
 	}
 
-	return body, nil
+	return nil
 }
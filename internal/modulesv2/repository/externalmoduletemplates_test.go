@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestExternalModuleTemplateRepository_SaveAndGet(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "all-modules.json")
+	moduleTemplates := []kyma.ModuleTemplate{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "sample-1.0.0"},
+			Spec: kyma.ModuleTemplateSpec{
+				ModuleName: "sample",
+				Version:    "1.0.0",
+				Data: unstructured.Unstructured{Object: map[string]any{
+					"apiVersion": "operator.kyma-project.io/v1alpha1",
+					"kind":       "Sample",
+				}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-0.1.0"},
+			Spec: kyma.ModuleTemplateSpec{
+				ModuleName: "other",
+				Version:    "0.1.0",
+			},
+		},
+	}
+
+	repo := NewExternalModuleTemplateRepository()
+	require.NoError(t, repo.Save(path, moduleTemplates))
+
+	index, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(index), "\"kind\": \"Sample\"")
+
+	result, err := repo.Get([]string{path})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	require.Equal(t, "Sample", result[0].Spec.Data.GetKind())
+	require.Equal(t, "other", result[1].Spec.ModuleName)
+}
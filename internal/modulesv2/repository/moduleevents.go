@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/kube"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/kube/rootlessdynamic"
+	"github.com/kyma-project/cli.v3/internal/out"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// resubscribeInterval is the time to wait before watching again when no resources can be watched
+const resubscribeInterval = 30 * time.Second
+
+type ModuleEventsRepository interface {
+	// Watch notifies about changes of the default Kyma CR, module CRs, and module managers
+	// the returned channel is closed when the context is done
+	Watch(ctx context.Context) (<-chan struct{}, error)
+}
+
+type moduleEventsRepository struct {
+	kubeClient kube.Client
+}
+
+func NewModuleEventsRepository(kubeClient kube.Client) ModuleEventsRepository {
+	return &moduleEventsRepository{
+		kubeClient: kubeClient,
+	}
+}
+
+func (r *moduleEventsRepository) Watch(ctx context.Context) (<-chan struct{}, error) {
+	watchers, err := r.subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan struct{}, 1)
+	go func() {
+		defer close(events)
+		for {
+			r.forward(ctx, watchers, events)
+			stopWatchers(watchers)
+			if ctx.Err() != nil {
+				return
+			}
+
+			// watches are closed by the server from time to time so they are opened again
+			// and resources that appeared in the meantime are watched too
+			watchers, err = r.subscribe(ctx)
+			if err != nil {
+				out.Debugfln("failed to watch module resources: %v", err)
+			}
+			notify(events)
+		}
+	}()
+
+	return events, nil
+}
+
+// subscribe watches the default Kyma CR, and CRs and managers of all modules from module templates
+// resources that can't be watched, for example because their CRDs are not installed yet, are skipped
+func (r *moduleEventsRepository) subscribe(ctx context.Context) ([]watch.Interface, error) {
+	moduleTemplates, err := r.kubeClient.Kyma().ListModuleTemplate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []unstructured.Unstructured{defaultKymaResource()}
+	for _, moduleTemplate := range moduleTemplates.Items {
+		data := moduleTemplate.Spec.Data
+		if data.GetKind() != "" {
+			resources = append(resources, watchedResource(data.GetAPIVersion(), data.GetKind(), ""))
+		}
+
+		if manager := moduleTemplate.Spec.Manager; manager != nil {
+			resources = append(resources, watchedResource(manager.Group+"/"+manager.Version, manager.Kind, managerNamespace(manager)))
+		}
+	}
+
+	watchers := []watch.Interface{}
+	seen := map[string]bool{}
+	for i, resource := range resources {
+		key := resource.GetAPIVersion() + "/" + resource.GetKind() + "/" + resource.GetNamespace()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		watcher, err := r.watch(ctx, &resources[i])
+		if err != nil {
+			out.Debugfln("failed to watch %s %s: %v", resource.GetAPIVersion(), resource.GetKind(), err)
+			continue
+		}
+		watchers = append(watchers, watcher)
+	}
+
+	return watchers, nil
+}
+
+func (r *moduleEventsRepository) watch(ctx context.Context, resource *unstructured.Unstructured) (watch.Interface, error) {
+	if resource.GetName() != "" {
+		return r.kubeClient.RootlessDynamic().WatchSingleResource(ctx, resource)
+	}
+
+	return r.kubeClient.RootlessDynamic().Watch(ctx, resource, &rootlessdynamic.ListOptions{
+		AllNamespaces: resource.GetNamespace() == "",
+	})
+}
+
+// forward notifies about events from all watchers until the context is done or any watcher is closed
+func (r *moduleEventsRepository) forward(ctx context.Context, watchers []watch.Interface, events chan struct{}) {
+	if len(watchers) == 0 {
+		select {
+		case <-ctx.Done():
+		case <-time.After(resubscribeInterval):
+		}
+		return
+	}
+
+	closed := make(chan struct{}, len(watchers))
+	for _, watcher := range watchers {
+		go func(watcher watch.Interface) {
+			for range watcher.ResultChan() {
+				notify(events)
+			}
+			closed <- struct{}{}
+		}(watcher)
+	}
+
+	select {
+	case <-ctx.Done():
+	case <-closed:
+	}
+}
+
+// notify doesn't block if there is a pending notification because one notification is enough to list modules again
+func notify(events chan struct{}) {
+	select {
+	case events <- struct{}{}:
+	default:
+	}
+}
+
+func stopWatchers(watchers []watch.Interface) {
+	for _, watcher := range watchers {
+		watcher.Stop()
+	}
+}
+
+func defaultKymaResource() unstructured.Unstructured {
+	resource := watchedResource(kyma.GVRKyma.GroupVersion().String(), "Kyma", kyma.DefaultKymaNamespace)
+	resource.SetName(kyma.DefaultKymaName)
+	return resource
+}
+
+func watchedResource(apiVersion, kind, namespace string) unstructured.Unstructured {
+	resource := unstructured.Unstructured{}
+	resource.SetAPIVersion(apiVersion)
+	resource.SetKind(kind)
+	resource.SetNamespace(namespace)
+	return resource
+}
+
+func managerNamespace(manager *kyma.Manager) string {
+	if manager.Namespace != "" {
+		return manager.Namespace
+	}
+	return "kyma-system"
+}
@@ -0,0 +1,113 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	kubefake "github.com/kyma-project/cli.v3/internal/kube/fake"
+	"github.com/kyma-project/cli.v3/internal/kube/kyma"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/repository"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestModuleEventsRepository_Watch(t *testing.T) {
+	t.Run("notifies about changes of watched resources", func(t *testing.T) {
+		watcher := watch.NewFake()
+		rootlessDynamic := &kubefake.RootlessDynamicClient{
+			ReturnWatcher: watcher,
+		}
+		kubeClient := &kubefake.KubeClient{
+			TestKymaInterface: &kubefake.KymaClient{
+				ReturnModuleTemplateList: kyma.ModuleTemplateList{
+					Items: []kyma.ModuleTemplate{
+						fixWatchedModuleTemplate("1.0.0"),
+						fixWatchedModuleTemplate("1.1.0"),
+					},
+				},
+			},
+			TestRootlessDynamicInterface: rootlessDynamic,
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		events, err := repository.NewModuleEventsRepository(kubeClient).Watch(ctx)
+		require.NoError(t, err)
+
+		// resources of the same kind are watched once for all module versions
+		require.Len(t, rootlessDynamic.WatchObjs, 2)
+		require.Equal(t, "Sample", rootlessDynamic.WatchObjs[0].GetKind())
+		require.Equal(t, "", rootlessDynamic.WatchObjs[0].GetNamespace())
+		require.Equal(t, "Deployment", rootlessDynamic.WatchObjs[1].GetKind())
+		require.Equal(t, "apps/v1", rootlessDynamic.WatchObjs[1].GetAPIVersion())
+		require.Equal(t, "kyma-system", rootlessDynamic.WatchObjs[1].GetNamespace())
+
+		watcher.Modify(&unstructured.Unstructured{})
+		_, ok := <-events
+		require.True(t, ok)
+
+		cancel()
+		for range events {
+		}
+	})
+
+	t.Run("skips resources that can't be watched", func(t *testing.T) {
+		rootlessDynamic := &kubefake.RootlessDynamicClient{
+			ReturnWatchErr: errors.New("failed to discover API resource using discovery client"),
+		}
+		kubeClient := &kubefake.KubeClient{
+			TestKymaInterface: &kubefake.KymaClient{
+				ReturnModuleTemplateList: kyma.ModuleTemplateList{
+					Items: []kyma.ModuleTemplate{fixWatchedModuleTemplate("1.0.0")},
+				},
+			},
+			TestRootlessDynamicInterface: rootlessDynamic,
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		events, err := repository.NewModuleEventsRepository(kubeClient).Watch(ctx)
+		require.NoError(t, err)
+
+		cancel()
+		_, ok := <-events
+		require.False(t, ok)
+	})
+
+	t.Run("failed to list module templates", func(t *testing.T) {
+		kubeClient := &kubefake.KubeClient{
+			TestKymaInterface: &kubefake.KymaClient{
+				ReturnErr: errors.New("test error"),
+			},
+			TestRootlessDynamicInterface: &kubefake.RootlessDynamicClient{},
+		}
+
+		events, err := repository.NewModuleEventsRepository(kubeClient).Watch(context.Background())
+		require.ErrorContains(t, err, "test error")
+		require.Nil(t, events)
+	})
+}
+
+func fixWatchedModuleTemplate(version string) kyma.ModuleTemplate {
+	return kyma.ModuleTemplate{
+		Spec: kyma.ModuleTemplateSpec{
+			ModuleName: "sample",
+			Version:    version,
+			Data: unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "operator.kyma-project.io/v1alpha1",
+					"kind":       "Sample",
+				},
+			},
+			Manager: &kyma.Manager{
+				Name: "sample-manager",
+				GroupVersionKind: metav1.GroupVersionKind{
+					Group:   "apps",
+					Version: "v1",
+					Kind:    "Deployment",
+				},
+			},
+		},
+	}
+}
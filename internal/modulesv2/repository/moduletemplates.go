@@ -22,6 +22,7 @@ type ModuleTemplatesRepository interface {
 	ListLocalCommunity(ctx context.Context) ([]*entities.CommunityModuleTemplate, error)
 	ListExternalCommunity(ctx context.Context, urls []string, filterClause func(*entities.ExternalModuleTemplate) bool) ([]*entities.ExternalModuleTemplate, error)
 
+	GetLocal(ctx context.Context, name, namespace string) (*kyma.ModuleTemplate, error)
 	GetLocalCommunity(ctx context.Context, name, namespace string) (*entities.CommunityModuleTemplate, error)
 
 	SaveCommunityModule(ctx context.Context, externalModule *entities.ExternalModuleTemplate) error
@@ -112,6 +113,19 @@ func (r *moduleTemplatesRepository) ListExternalCommunity(ctx context.Context, u
 	return filteredCommunityEntities, nil
 }
 
+// GetLocal returns the raw module template from the cluster or nil if it doesn't exist
+func (r *moduleTemplatesRepository) GetLocal(ctx context.Context, name, namespace string) (*kyma.ModuleTemplate, error) {
+	rawModuleTemplate, err := r.client.Kyma().GetModuleTemplate(ctx, namespace, name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return rawModuleTemplate, nil
+}
+
 func (r *moduleTemplatesRepository) GetLocalCommunity(ctx context.Context, name, namespace string) (*entities.CommunityModuleTemplate, error) {
 	rawModuleTemplate, err := r.client.Kyma().GetModuleTemplate(ctx, namespace, name)
 	if apierrors.IsNotFound(err) {
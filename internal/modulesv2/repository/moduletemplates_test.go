@@ -238,6 +238,38 @@ func TestModuleTemplateRepository_GetLocalCommunity(t *testing.T) {
 	})
 }
 
+func TestModuleTemplateRepository_GetLocal(t *testing.T) {
+	t.Run("gets raw module template", func(t *testing.T) {
+		fakeKubeClient := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnModuleTemplate: testCommunityModuleTemplate,
+			},
+		}
+
+		repo := repository.NewModuleTemplatesRepository(&fakeKubeClient, &modulesfake.ExternalModuleTemplatesRepository{})
+
+		result, err := repo.GetLocal(context.Background(), testCommunityModuleTemplate.Name, testCommunityModuleTemplate.Namespace)
+
+		require.NoError(t, err)
+		require.Equal(t, &testCommunityModuleTemplate, result)
+	})
+
+	t.Run("returns error", func(t *testing.T) {
+		fakeKubeClient := fake.KubeClient{
+			TestKymaInterface: &fake.KymaClient{
+				ReturnGetModuleTemplateErr: errors.New("test error"),
+			},
+		}
+
+		repo := repository.NewModuleTemplatesRepository(&fakeKubeClient, &modulesfake.ExternalModuleTemplatesRepository{})
+
+		result, err := repo.GetLocal(context.Background(), "test-module-2", "test-module-namespace")
+
+		require.EqualError(t, err, "test error")
+		require.Nil(t, result)
+	})
+}
+
 func TestModuleTemplateRepository_SaveCommunityModule(t *testing.T) {
 	t.Run("saves external community module template", func(t *testing.T) {
 		fakeKymaClient := fake.KymaClient{}
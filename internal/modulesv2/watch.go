@@ -0,0 +1,90 @@
+package modulesv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/repository"
+)
+
+// defaultDebounceInterval groups events from resources changed at once, for example during a module rollout
+const defaultDebounceInterval = 500 * time.Millisecond
+
+type WatchService struct {
+	listService            *ListService
+	moduleEventsRepository repository.ModuleEventsRepository
+	debounceInterval       time.Duration
+}
+
+func NewWatchService(
+	installedModulesRepository repository.ModuleInstallationsRepository,
+	moduleEventsRepository repository.ModuleEventsRepository,
+) *WatchService {
+	return &WatchService{
+		listService:            NewListService(installedModulesRepository),
+		moduleEventsRepository: moduleEventsRepository,
+		debounceInterval:       defaultDebounceInterval,
+	}
+}
+
+// Run lists installed modules every time the default Kyma CR, module CRs, or module managers change
+// onChange is called with the first list and then only when the state of any module changes
+// it returns when the context is done
+func (s *WatchService) Run(ctx context.Context, onChange func(*dtos.WatchResult) error) error {
+	// start watching before the first list so no change is missed
+	events, err := s.moduleEventsRepository.Watch(ctx)
+	if err != nil {
+		return err
+	}
+
+	var previous *dtos.WatchResult
+	for {
+		modules, communityModules, err := s.listService.Run(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		result := dtos.NewWatchResult(previous, modules, communityModules)
+		if previous == nil || len(result.Events) > 0 {
+			if err := onChange(result); err != nil {
+				return err
+			}
+		}
+		previous = result
+
+		if !s.waitForChanges(ctx, events) {
+			return nil
+		}
+	}
+}
+
+// waitForChanges returns false if the context is done or the events channel is closed
+func (s *WatchService) waitForChanges(ctx context.Context, events <-chan struct{}) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case _, ok := <-events:
+		if !ok {
+			return false
+		}
+	}
+
+	timer := time.NewTimer(s.debounceInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			return true
+		case _, ok := <-events:
+			if !ok {
+				return false
+			}
+		}
+	}
+}
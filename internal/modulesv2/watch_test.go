@@ -0,0 +1,80 @@
+package modulesv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-project/cli.v3/internal/modulesv2/dtos"
+	"github.com/kyma-project/cli.v3/internal/modulesv2/entities"
+	modulesfake "github.com/kyma-project/cli.v3/internal/modulesv2/fake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchService_Run(t *testing.T) {
+	t.Run("reports installed modules and then only changes", func(t *testing.T) {
+		installedModulesRepo := &modulesfake.ModuleInstallationsRepository{
+			ListInstalledModulesResult: []entities.ModuleInstallation{
+				{Name: "keda", Version: "1.0.0", ModuleState: "Processing", InstallationState: "Ready"},
+			},
+		}
+		events := make(chan struct{}, 1)
+		svc := NewWatchService(installedModulesRepo, &modulesfake.ModuleEventsRepository{WatchResult: events})
+		svc.debounceInterval = 0
+
+		results := []*dtos.WatchResult{}
+		err := svc.Run(context.Background(), func(result *dtos.WatchResult) error {
+			results = append(results, result)
+			if len(results) == 1 {
+				installedModulesRepo.ListInstalledModulesResult[0].ModuleState = "Ready"
+				events <- struct{}{}
+				return nil
+			}
+
+			// an event without changes doesn't call onChange
+			events <- struct{}{}
+			close(events)
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Equal(t, []dtos.WatchEvent{
+			{Type: dtos.WatchEventAdded, Name: "keda", Version: "1.0.0", ModuleState: "Processing", InstallationState: "Ready"},
+		}, results[0].Events)
+		require.Equal(t, []dtos.WatchEvent{
+			{Type: dtos.WatchEventModified, Name: "keda", Version: "1.0.0", ModuleState: "Ready", InstallationState: "Ready"},
+		}, results[1].Events)
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		svc := NewWatchService(&modulesfake.ModuleInstallationsRepository{}, &modulesfake.ModuleEventsRepository{WatchResult: make(chan struct{})})
+
+		err := svc.Run(ctx, func(_ *dtos.WatchResult) error {
+			cancel()
+			return nil
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("failed to watch modules", func(t *testing.T) {
+		svc := NewWatchService(&modulesfake.ModuleInstallationsRepository{}, &modulesfake.ModuleEventsRepository{WatchError: errors.New("test error")})
+
+		err := svc.Run(context.Background(), func(_ *dtos.WatchResult) error { return nil })
+
+		require.ErrorContains(t, err, "test error")
+	})
+
+	t.Run("failed to list modules", func(t *testing.T) {
+		installedModulesRepo := &modulesfake.ModuleInstallationsRepository{
+			ListInstalledModulesError: errors.New("test error"),
+		}
+		svc := NewWatchService(installedModulesRepo, &modulesfake.ModuleEventsRepository{WatchResult: make(chan struct{})})
+
+		err := svc.Run(context.Background(), func(_ *dtos.WatchResult) error { return nil })
+
+		require.ErrorContains(t, err, "test error")
+	})
+}
@@ -0,0 +1,50 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// minStreamColumnWidth keeps short headers from squeezing columns with longer values
+const minStreamColumnWidth = 16
+
+// TableStream renders table rows one by one as they come
+// column widths are fixed when the header is rendered, so all rows stay aligned with it
+// values longer than the column overflow only their own row
+type TableStream struct {
+	writer io.Writer
+	widths []int
+}
+
+// NewTableStream renders the header and returns the stream for rows
+func NewTableStream(writer io.Writer, headers []interface{}) *TableStream {
+	stream := &TableStream{
+		writer: writer,
+		widths: make([]int, len(headers)),
+	}
+
+	upperHeaders := make([]interface{}, len(headers))
+	for i := range headers {
+		upperHeaders[i] = strings.ToUpper(fmt.Sprint(headers[i]))
+		stream.widths[i] = max(len(fmt.Sprint(headers[i])), minStreamColumnWidth)
+	}
+	stream.Row(upperHeaders)
+
+	return stream
+}
+
+// Row renders the single row aligned to the header
+func (s *TableStream) Row(row []interface{}) {
+	line := ""
+	for i := range row {
+		cell := fmt.Sprint(row[i])
+		if i < len(s.widths) && i < len(row)-1 {
+			// pad the cell the same way as the Table func and keep the gap after overflowing values
+			cell = fmt.Sprintf("%-*s", s.widths[i], cell) + space + space + space
+		}
+		line += cell
+	}
+
+	fmt.Fprintln(s.writer, line)
+}
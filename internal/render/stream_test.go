@@ -0,0 +1,34 @@
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableStream(t *testing.T) {
+	t.Run("align rows to the header", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+
+		stream := NewTableStream(buffer, []interface{}{"event", "name", "status"})
+		stream.Row([]interface{}{"ADDED", "test", "Pending"})
+		stream.Row([]interface{}{"MODIFIED", "test", "Ready"})
+
+		require.Equal(t, "EVENT              NAME               STATUS\n"+
+			"ADDED              test               Pending\n"+
+			"MODIFIED           test               Ready\n", buffer.String())
+	})
+
+	t.Run("overflow long values without moving next rows", func(t *testing.T) {
+		buffer := bytes.NewBuffer([]byte{})
+
+		stream := NewTableStream(buffer, []interface{}{"event", "name", "status"})
+		stream.Row([]interface{}{"ADDED", "very-long-resource-name", "Pending"})
+		stream.Row([]interface{}{"MODIFIED", "test", "Ready"})
+
+		require.Equal(t, "EVENT              NAME               STATUS\n"+
+			"ADDED              very-long-resource-name   Pending\n"+
+			"MODIFIED           test               Ready\n", buffer.String())
+	})
+}
@@ -0,0 +1,30 @@
+package render
+
+import (
+	"fmt"
+	"io"
+)
+
+// TreeNode is a node of the tree rendered by the Tree func
+type TreeNode struct {
+	Text     string
+	Children []TreeNode
+}
+
+// Tree renders the root node and its children indented under it
+func Tree(writer io.Writer, root TreeNode) {
+	fmt.Fprintln(writer, root.Text)
+	renderTreeChildren(writer, root.Children, "")
+}
+
+func renderTreeChildren(writer io.Writer, children []TreeNode, prefix string) {
+	for i, child := range children {
+		connector, childPrefix := "├── ", "│   "
+		if i == len(children)-1 {
+			connector, childPrefix = "└── ", "    "
+		}
+
+		fmt.Fprintln(writer, prefix+connector+child.Text)
+		renderTreeChildren(writer, child.Children, prefix+childPrefix)
+	}
+}